@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// conn is a WebSocket connection bound to a seat. Messages use binary protobuf frames.
+// send must only be called from one goroutine; received messages are delivered on incoming,
+// which is closed when the connection is lost.
+type conn struct {
+	ws       *websocket.Conn
+	seq      uint64 // seq of the last message sent
+	incoming chan *model.ServerMessage
+	err      error // why the connection was lost, valid after incoming is closed
+}
+
+// dial connects to the server and joins the seat of the session token. lastSeq is the last server message
+// received on a previous connection, so that the server replays what was missed.
+func dial(serverURL, sessionToken string, lastSeq uint64) (*conn, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/ws"
+	u.RawQuery = url.Values{
+		"token":    {sessionToken},
+		"last_seq": {strconv.FormatUint(lastSeq, 10)},
+		"encoding": {"binary"},
+	}.Encode()
+
+	ws, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", u.Redacted(), err)
+	}
+	c := &conn{ws: ws, incoming: make(chan *model.ServerMessage, 64)}
+	go c.readLoop()
+	return c, nil
+}
+
+// send assigns the next client seq to msg and writes it.
+func (c *conn) send(msg *model.ClientMessage) error {
+	c.seq++
+	msg.Seq = c.seq
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return c.ws.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// readLoop decodes server messages until the connection fails.
+func (c *conn) readLoop() {
+	defer close(c.incoming)
+	for {
+		frameType, data, err := c.ws.ReadMessage()
+		if err != nil {
+			c.err = err
+			return
+		}
+		msg := &model.ServerMessage{}
+		if frameType == websocket.TextMessage {
+			err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(data, msg)
+		} else {
+			err = proto.Unmarshal(data, msg)
+		}
+		if err != nil {
+			c.err = fmt.Errorf("invalid server message: %w", err)
+			return
+		}
+		c.incoming <- msg
+	}
+}
+
+// close closes the connection; readLoop then closes incoming.
+func (c *conn) close() {
+	_ = c.ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	_ = c.ws.Close()
+}
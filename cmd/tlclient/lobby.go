@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// lobbyClient calls the server's lobby HTTP endpoints on behalf of one seat.
+type lobbyClient struct {
+	baseURL string
+	http    *http.Client
+
+	gameID       string
+	playerID     int32
+	sessionToken string
+}
+
+func newLobbyClient(baseURL string) *lobbyClient {
+	return &lobbyClient{baseURL: strings.TrimSuffix(baseURL, "/"), http: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// seatResponse is the response of /create_room and /join_room.
+type seatResponse struct {
+	GameID       string `json:"game_id"`
+	PlayerID     int32  `json:"player_id"`
+	SessionToken string `json:"session_token"`
+}
+
+// createRoom creates a room with the caller as host, optionally choosing the script right away.
+func (l *lobbyClient) createRoom(name string, role model.PlayerRole, scriptID string, modelID int32, fillWithAI bool) error {
+	var resp seatResponse
+	err := l.post("/create_room", map[string]any{
+		"player_name":  name,
+		"player_role":  role,
+		"script_id":    scriptID,
+		"model_id":     modelID,
+		"fill_with_ai": fillWithAI,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+	l.gameID, l.playerID, l.sessionToken = resp.GameID, resp.PlayerID, resp.SessionToken
+	return nil
+}
+
+// joinRoom takes a seat in an existing room.
+func (l *lobbyClient) joinRoom(gameID, name string, role model.PlayerRole) error {
+	var resp seatResponse
+	err := l.post("/join_room", map[string]any{
+		"game_id":     gameID,
+		"player_name": name,
+		"player_role": role,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+	l.gameID, l.playerID, l.sessionToken = gameID, resp.PlayerID, resp.SessionToken
+	return nil
+}
+
+// configure chooses the script and model of the room. Only the host may do this.
+func (l *lobbyClient) configure(scriptID string, modelID int32, fillWithAI bool) error {
+	return l.post("/configure_room", map[string]any{
+		"session_token": l.sessionToken,
+		"script_id":     scriptID,
+		"model_id":      modelID,
+		"fill_with_ai":  fillWithAI,
+	}, nil)
+}
+
+// start starts the game. Only the host may do this.
+func (l *lobbyClient) start() error {
+	return l.post("/start_game", map[string]any{"session_token": l.sessionToken}, nil)
+}
+
+// post sends a JSON request and decodes the JSON response into out, if not nil.
+func (l *lobbyClient) post(path string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	resp, err := l.http.Post(l.baseURL+path, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("%s: %s: %s", path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%s: invalid response: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+// Command tlclient is an interactive terminal client for the game server.
+//
+// It creates or joins a room through the lobby HTTP API and then plays over the WebSocket protocol:
+// it renders the player view, prints the event feed, lists the actions available in the current phase
+// and submits the commands typed by the player. Type "help" once connected.
+//
+//	tlclient -name alice -role mastermind -script first_steps
+//	tlclient -name bob -role protagonist -game <game id>
+//	tlclient -token <session token>    # reconnect to an existing seat
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/termui"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// maxReconnects is how many times the client tries to reconnect after losing the connection.
+const maxReconnects = 5
+
+func main() {
+	serverURL := flag.String("server", "http://localhost:8080", "server base URL")
+	name := flag.String("name", os.Getenv("USER"), "player name")
+	roleName := flag.String("role", "protagonist", "seat role: mastermind or protagonist")
+	gameID := flag.String("game", "", "join this room instead of creating one")
+	scriptID := flag.String("script", "", "script to choose when creating a room")
+	modelID := flag.Int("model", 0, "script model to choose when creating a room")
+	fillWithAI := flag.Bool("fill-ai", true, "fill empty seats with AI players when the game starts")
+	token := flag.String("token", "", "session token of an existing seat to reconnect to")
+	flag.Parse()
+
+	role, ok := map[string]model.PlayerRole{
+		"mastermind":  model.PlayerRole_PLAYER_ROLE_MASTERMIND,
+		"protagonist": model.PlayerRole_PLAYER_ROLE_PROTAGONIST,
+	}[*roleName]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "invalid role %q\n", *roleName)
+		os.Exit(2)
+	}
+
+	lobby := newLobbyClient(*serverURL)
+	var err error
+	switch {
+	case *token != "":
+		lobby.sessionToken = *token
+	case *gameID != "":
+		err = lobby.joinRoom(*gameID, *name, role)
+	default:
+		err = lobby.createRoom(*name, role, *scriptID, int32(*modelID), *fillWithAI)
+		if err == nil {
+			fmt.Printf("Created room %s; others can join with -game %s\n", lobby.gameID, lobby.gameID)
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if *token == "" {
+		fmt.Printf("Seat token (reconnect with -token): %s\n", lobby.sessionToken)
+	}
+
+	s := &session{serverURL: *serverURL, lobby: lobby, fillWithAI: *fillWithAI, out: os.Stdout}
+	if err := s.run(os.Stdin); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// helpText lists the client commands; game actions are described by termui.ActionHelp.
+const helpText = `Commands:
+  view                                    show the board, your hand and the available actions
+  ready | unready                         set your ready state in the lobby
+  config <script id> [model id]           choose the script (host only)
+  start                                   start the game (host only)
+  say <text> | psay <text>                chat with everyone | with the protagonists only
+  help | quit
+`
+
+// session is a connected seat: it keeps the local view in sync and turns input lines into messages.
+type session struct {
+	serverURL  string
+	lobby      *lobbyClient
+	fillWithAI bool
+	out        io.Writer
+
+	conn    *conn
+	state   viewState
+	lastSeq uint64 // last server message seq received, acknowledged and used to resume after reconnecting
+}
+
+// run connects and processes server messages and input lines until the player quits or the connection is lost for good.
+func (s *session) run(input io.Reader) error {
+	var err error
+	if s.conn, err = dial(s.serverURL, s.lobby.sessionToken, 0); err != nil {
+		return err
+	}
+	defer s.conn.close()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(input)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	for {
+		select {
+		case msg, ok := <-s.conn.incoming:
+			if !ok {
+				if err := s.reconnect(); err != nil {
+					return err
+				}
+				continue
+			}
+			s.handleServerMessage(msg)
+		case line, ok := <-lines:
+			if !ok || s.handleLine(strings.TrimSpace(line)) {
+				return nil
+			}
+		}
+	}
+}
+
+// reconnect re-establishes a lost connection. The server replays missed events and sends a full view.
+func (s *session) reconnect() error {
+	cause := s.conn.err
+	for attempt := 1; attempt <= maxReconnects; attempt++ {
+		fmt.Fprintf(s.out, "Connection lost (%v), reconnecting (%d/%d)...\n", cause, attempt, maxReconnects)
+		time.Sleep(time.Duration(attempt) * time.Second)
+		c, err := dial(s.serverURL, s.lobby.sessionToken, s.lastSeq)
+		if err == nil {
+			s.conn = c
+			return nil
+		}
+		cause = err
+	}
+	return fmt.Errorf("giving up after %d reconnect attempts: %w", maxReconnects, cause)
+}
+
+// handleServerMessage updates the local state and prints what changed.
+func (s *session) handleServerMessage(msg *model.ServerMessage) {
+	if msg.GetSeq() > s.lastSeq {
+		s.lastSeq = msg.GetSeq()
+		s.send(&model.ClientMessage{Message: &model.ClientMessage_Ack{Ack: &model.Ack{Seq: s.lastSeq}}})
+	}
+
+	switch m := msg.GetMessage().(type) {
+	case *model.ServerMessage_Joined:
+		s.state.playerID, s.state.role = m.Joined.GetPlayerId(), m.Joined.GetRole()
+		s.lobby.gameID = m.Joined.GetGameId()
+		fmt.Fprintf(s.out, "Joined room %s as player %d (%s)\n", m.Joined.GetGameId(), m.Joined.GetPlayerId(), termui.EnumName(m.Joined.GetRole()))
+	case *model.ServerMessage_LobbyUpdate:
+		s.printLobby(m.LobbyUpdate)
+	case *model.ServerMessage_ViewUpdate:
+		phase := s.state.phase()
+		s.state.setView(m.ViewUpdate)
+		s.viewChanged(phase, true)
+	case *model.ServerMessage_ViewDelta:
+		phase := s.state.phase()
+		if !s.state.applyDelta(m.ViewDelta) {
+			// A message was lost or the versions diverged: drop the delta and ask for a full view.
+			s.send(&model.ClientMessage{Message: &model.ClientMessage_RequestView{RequestView: &model.RequestView{}}})
+			return
+		}
+		s.viewChanged(phase, false)
+	case *model.ServerMessage_Event:
+		s.state.observeEvent(m.Event)
+		fmt.Fprintln(s.out, termui.FormatEvent(m.Event, s.state.view))
+		if req := m.Event.GetPayload().GetChoiceRequired(); req != nil && req == s.state.choice {
+			termui.RenderChoice(s.out, req)
+		}
+	case *model.ServerMessage_Error:
+		fmt.Fprintf(s.out, "Error (%s): %s\n", termui.EnumName(m.Error.GetCode()), m.Error.GetMessage())
+	}
+}
+
+// viewChanged redraws the board when a full view arrives or the phase changes; other updates only show up in the event feed.
+func (s *session) viewChanged(oldPhase model.GamePhase, full bool) {
+	if s.state.phase() != oldPhase || full {
+		s.render()
+	}
+}
+
+func (s *session) render() {
+	if s.state.view == nil {
+		fmt.Fprintln(s.out, "The game has not started yet.")
+		return
+	}
+	termui.RenderView(s.out, s.state.view, s.state.playerID)
+	termui.RenderHints(s.out, s.state.view, s.state.choice)
+}
+
+func (s *session) printLobby(lobby *model.LobbyState) {
+	fmt.Fprintf(s.out, "Lobby %s [%s] script=%q model=%d fill_with_ai=%t\n", lobby.GetGameId(), termui.EnumName(lobby.GetState()),
+		lobby.GetScriptName(), lobby.GetModelId(), lobby.GetFillWithAi())
+	for _, seat := range lobby.GetSeats() {
+		var labels []string
+		for _, l := range []struct {
+			label string
+			set   bool
+		}{{"host", seat.GetHost()}, {"ready", seat.GetReady()}, {"ai", seat.GetIsLlm()}, {"offline", !seat.GetIsLlm() && !seat.GetConnected()}} {
+			if l.set {
+				labels = append(labels, l.label)
+			}
+		}
+		fmt.Fprintf(s.out, "  #%d %s (%s) %s\n", seat.GetPlayerId(), seat.GetName(), termui.EnumName(seat.GetRole()), strings.Join(labels, " "))
+	}
+}
+
+// handleLine runs one input line and reports whether the player wants to quit.
+func (s *session) handleLine(line string) bool {
+	cmd, rest, _ := strings.Cut(line, " ")
+	rest = strings.TrimSpace(rest)
+	switch cmd {
+	case "":
+	case "quit", "exit":
+		return true
+	case "help":
+		fmt.Fprint(s.out, helpText, termui.ActionHelp)
+	case "view":
+		s.render()
+	case "ready", "unready":
+		s.send(&model.ClientMessage{Message: &model.ClientMessage_SetReady{SetReady: &model.SetReady{Ready: cmd == "ready"}}})
+	case "config":
+		var scriptID string
+		var modelID int32
+		if _, err := fmt.Sscan(rest, &scriptID, &modelID); err != nil && scriptID == "" {
+			fmt.Fprintln(s.out, "usage: config <script id> [model id]")
+			return false
+		}
+		s.report(s.lobby.configure(scriptID, modelID, s.fillWithAI))
+	case "start":
+		s.report(s.lobby.start())
+	case "say", "psay":
+		channel := model.ChatChannel_CHAT_CHANNEL_ALL
+		if cmd == "psay" {
+			channel = model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS
+		}
+		s.send(&model.ClientMessage{Message: &model.ClientMessage_SendChat{SendChat: &model.SendChat{Channel: channel, Text: rest}}})
+	default:
+		action, err := termui.ParseAction(line, s.state.view, s.state.choice)
+		if errors.Is(err, termui.ErrNotAction) {
+			fmt.Fprintf(s.out, "Unknown command %q, type help\n", cmd)
+			return false
+		}
+		if err != nil {
+			fmt.Fprintln(s.out, err)
+			return false
+		}
+		if choose := action.GetChooseOption(); choose != nil {
+			s.state.choice = nil
+			s.send(&model.ClientMessage{Message: &model.ClientMessage_ChooseOption{ChooseOption: choose}})
+			return false
+		}
+		s.send(&model.ClientMessage{Message: &model.ClientMessage_SubmitAction{SubmitAction: &model.SubmitActionRequest{Action: action}}})
+	}
+	return false
+}
+
+// send writes a message; a failed write shows up as a lost connection in run.
+func (s *session) send(msg *model.ClientMessage) {
+	if err := s.conn.send(msg); err != nil {
+		fmt.Fprintf(s.out, "Failed to send: %v\n", err)
+	}
+}
+
+func (s *session) report(err error) {
+	if err != nil {
+		fmt.Fprintln(s.out, err)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"github.com/constellation39/tragedyLooper/internal/game/engine/viewdiff"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// viewState is the client's copy of its player view, kept in sync with ViewUpdate and ViewDelta messages.
+type viewState struct {
+	playerID int32
+	role     model.PlayerRole
+	view     *model.PlayerView
+	version  uint64
+	// choice is the pending choice request addressed to this player, if any.
+	choice *model.ChoiceRequiredEvent
+}
+
+// setView replaces the view with a full ViewUpdate.
+func (s *viewState) setView(update *model.ViewUpdate) {
+	s.view = update.GetView()
+	s.version = update.GetVersion()
+}
+
+// applyDelta applies a ViewDelta. It returns false if the delta doesn't apply to the local version, in which case
+// the client must request a full view; the local view is left untouched.
+func (s *viewState) applyDelta(delta *model.ViewDelta) bool {
+	if s.view == nil || delta.GetBaseVersion() != s.version {
+		return false
+	}
+	if err := viewdiff.Apply(s.view, delta); err != nil {
+		return false
+	}
+	s.version = delta.GetVersion()
+	return true
+}
+
+// phase returns the current phase, or GAME_PHASE_UNSPECIFIED before the first view.
+func (s *viewState) phase() model.GamePhase {
+	return s.view.GetCurrentPhase()
+}
+
+// observeEvent tracks choice requests addressed to this player. The choice stays pending until the player
+// answers it or a new one replaces it; events of the same tick may arrive before the view of a new phase.
+func (s *viewState) observeEvent(event *model.GameEvent) {
+	if req := event.GetPayload().GetChoiceRequired(); req != nil && req.GetPlayerId() == s.playerID {
+		s.choice = req
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine/viewdiff"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewStateApplyDelta(t *testing.T) {
+	v1 := &model.PlayerView{Tick: 1, CurrentPhase: model.GamePhase_GAME_PHASE_DAY_START}
+	v2 := &model.PlayerView{Tick: 2, CurrentPhase: model.GamePhase_GAME_PHASE_MASTERMIND_CARD_PLAY}
+	delta := viewdiff.Diff(v1, v2)
+	delta.BaseVersion, delta.Version = 1, 2
+
+	var s viewState
+	assert.False(t, s.applyDelta(delta), "a delta needs a full view first")
+
+	s.setView(&model.ViewUpdate{View: &model.PlayerView{Tick: 1, CurrentPhase: model.GamePhase_GAME_PHASE_DAY_START}, Version: 1})
+	assert.True(t, s.applyDelta(delta))
+	assert.Equal(t, uint64(2), s.version)
+	assert.Equal(t, model.GamePhase_GAME_PHASE_MASTERMIND_CARD_PLAY, s.phase())
+
+	assert.False(t, s.applyDelta(delta), "the delta no longer matches the local version")
+	assert.Equal(t, int64(2), s.view.GetTick())
+}
+
+func TestViewStateTracksOwnChoices(t *testing.T) {
+	s := viewState{playerID: 2}
+	choiceFor := func(playerID int32) *model.GameEvent {
+		return &model.GameEvent{Payload: &model.EventPayload{Payload: &model.EventPayload_ChoiceRequired{
+			ChoiceRequired: &model.ChoiceRequiredEvent{RequestId: "r", PlayerId: playerID},
+		}}}
+	}
+	s.observeEvent(choiceFor(1))
+	assert.Nil(t, s.choice)
+	s.observeEvent(choiceFor(2))
+	assert.Equal(t, "r", s.choice.GetRequestId())
+}
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
+	"github.com/constellation39/tragedyLooper/internal/termui"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// clearScreen clears an ANSI terminal and moves the cursor to the top left corner.
+const clearScreen = "\033[H\033[2J"
+
+const helpText = `Commands:
+  view                 show your board, hand and available actions again
+  switch <player id>   hand the terminal to another human player
+  help | quit
+`
+
+// humanSeat is a human player sharing the terminal.
+type humanSeat struct {
+	player *model.Player
+	viewer visibility.Viewer
+	view   *model.PlayerView // latest published view, read-only
+	feed   []string          // events the player has not seen yet
+	choice *model.ChoiceRequiredEvent
+}
+
+// hotseat drives the human seats of an in-process game. It only runs on the goroutine that calls run.
+type hotseat struct {
+	ge     *engine.GameEngine
+	out    io.Writer
+	humans []*humanSeat // in seat order
+	byID   map[int32]*humanSeat
+
+	current *humanSeat // player who currently holds the terminal
+	handTo  *humanSeat // player the terminal is being handed to, waiting for them to press Enter
+	phase   model.GamePhase
+	acted   map[int32]bool // humans who have acted in the current phase
+}
+
+func newHotseat(ge *engine.GameEngine, players []*model.Player, out io.Writer) *hotseat {
+	h := &hotseat{ge: ge, out: out, byID: make(map[int32]*humanSeat), acted: make(map[int32]bool)}
+	for _, p := range players {
+		if p.IsLlm {
+			continue
+		}
+		seat := &humanSeat{player: p, viewer: visibility.ViewerFor(p)}
+		h.humans = append(h.humans, seat)
+		h.byID[p.Id] = seat
+	}
+	return h
+}
+
+// run processes game events, view updates and input lines until the game engine stops or the players quit.
+func (h *hotseat) run(input io.Reader) {
+	events := h.ge.GetGameEvents()
+	views := h.ge.ViewUpdates()
+	lines := readLines(input)
+	h.handOver(h.humans[0])
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			h.handleEvent(event)
+		case batch, ok := <-views:
+			if !ok {
+				fmt.Fprintln(h.out, "The game engine has stopped.")
+				return
+			}
+			h.handleViews(batch)
+		case line, ok := <-lines:
+			if !ok || h.handleLine(strings.TrimSpace(line)) {
+				return
+			}
+		}
+		h.switchToActor()
+	}
+}
+
+// handleEvent queues the event for every human who may see it and prints it right away for the player at the terminal.
+func (h *hotseat) handleEvent(event *model.GameEvent) {
+	for _, seat := range h.humans {
+		redacted := h.ge.RedactEvent(seat.viewer, event)
+		if redacted == nil {
+			continue
+		}
+		if req := redacted.GetPayload().GetChoiceRequired(); req != nil && req.GetPlayerId() == seat.player.Id {
+			seat.choice = req
+		}
+		line := termui.FormatEvent(redacted, seat.view)
+		if seat == h.current && h.handTo == nil {
+			fmt.Fprintln(h.out, line)
+		} else {
+			seat.feed = append(seat.feed, line)
+		}
+	}
+	if event.GetType() == model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED {
+		fmt.Fprintln(h.out, "The game is over. Type quit to exit, or switch <player id> to review a player's final view.")
+	}
+}
+
+// handleViews records the new views and redraws the board of the player at the terminal when the phase changes.
+func (h *hotseat) handleViews(batch *engine.ViewBatch) {
+	phase := h.phase
+	for id, pv := range batch.Views {
+		phase = pv.View.GetCurrentPhase()
+		if seat, ok := h.byID[id]; ok {
+			seat.view = pv.View
+		}
+	}
+	if phase == h.phase {
+		return
+	}
+	h.phase = phase
+	clear(h.acted)
+	if h.current != nil && h.handTo == nil {
+		h.render()
+	}
+}
+
+// nextActor returns the human who has to act now, or nil if the game is waiting for AI players or itself.
+func (h *hotseat) nextActor() *humanSeat {
+	for _, seat := range h.humans {
+		if seat.choice != nil {
+			return seat
+		}
+	}
+	for _, seat := range h.humans {
+		if len(seat.view.GetLegalActions()) > 0 && !h.acted[seat.player.Id] {
+			return seat
+		}
+	}
+	return nil
+}
+
+// switchToActor hands the terminal to the human who has to act, if that is someone else.
+func (h *hotseat) switchToActor() {
+	if next := h.nextActor(); next != nil && next != h.current && h.handTo == nil {
+		h.handOver(next)
+	}
+}
+
+// handOver clears the screen and waits for the next player to confirm they have the terminal.
+func (h *hotseat) handOver(seat *humanSeat) {
+	h.handTo = seat
+	fmt.Fprint(h.out, clearScreen)
+	fmt.Fprintf(h.out, "Pass the terminal to %s (%s), then press Enter.\n", seat.player.Name, termui.EnumName(seat.player.Role))
+}
+
+// render shows the current player's unseen events, board and available actions.
+func (h *hotseat) render() {
+	seat := h.current
+	for _, line := range seat.feed {
+		fmt.Fprintln(h.out, line)
+	}
+	seat.feed = nil
+	if seat.view == nil {
+		fmt.Fprintln(h.out, "Waiting for the game to start...")
+		return
+	}
+	termui.RenderView(h.out, seat.view, seat.player.Id)
+	termui.RenderHints(h.out, seat.view, seat.choice)
+}
+
+// handleLine runs one input line and reports whether the players want to quit.
+func (h *hotseat) handleLine(line string) bool {
+	if h.handTo != nil {
+		// Any line confirms the hand-over.
+		h.current, h.handTo = h.handTo, nil
+		fmt.Fprint(h.out, clearScreen)
+		fmt.Fprintf(h.out, "%s (%s), it's your turn. Type help for commands.\n", h.current.player.Name, termui.EnumName(h.current.player.Role))
+		h.render()
+		return false
+	}
+
+	cmd, rest, _ := strings.Cut(line, " ")
+	switch cmd {
+	case "":
+	case "quit", "exit":
+		return true
+	case "help":
+		fmt.Fprint(h.out, helpText, termui.ActionHelp)
+	case "view":
+		h.render()
+	case "switch":
+		id, err := strconv.Atoi(strings.TrimSpace(rest))
+		seat, ok := h.byID[int32(id)]
+		if err != nil || !ok {
+			fmt.Fprintf(h.out, "No human player %q\n", rest)
+			return false
+		}
+		h.handOver(seat)
+	default:
+		seat := h.current
+		action, err := termui.ParseAction(line, seat.view, seat.choice)
+		if errors.Is(err, termui.ErrNotAction) {
+			fmt.Fprintf(h.out, "Unknown command %q, type help\n", cmd)
+			return false
+		}
+		if err != nil {
+			fmt.Fprintln(h.out, err)
+			return false
+		}
+		if action.GetChooseOption() != nil {
+			seat.choice = nil
+		} else {
+			h.acted[seat.player.Id] = true
+		}
+		h.ge.SubmitPlayerAction(seat.player.Id, action)
+	}
+	return false
+}
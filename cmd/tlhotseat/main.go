@@ -0,0 +1,138 @@
+// Command tlhotseat plays a game on a single terminal without the HTTP server.
+//
+// It runs one GameEngine in-process with one or more human seats sharing the terminal, plus AI seats.
+// Whenever a different human has to act, the screen is cleared and the terminal waits until it has been
+// handed over, so that players don't see each other's hands or the Mastermind's private information.
+// It is meant for play-testing the scripts in data/scripts.
+//
+//	tlhotseat -mastermind alice -protagonist bob -protagonist carol
+//	tlhotseat -script basic_tragedy_x -model 8002 -protagonist bob -ai-protagonists 2
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	"github.com/constellation39/tragedyLooper/internal/llm"
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+func main() {
+	dataDir := flag.String("data", "data", "directory containing the script data")
+	scriptID := flag.String("script", "basic_tragedy_x", "script to play")
+	modelID := flag.Int("model", 8001, "script model to play")
+	mastermind := flag.String("mastermind", "", "name of the human Mastermind; empty for an AI Mastermind")
+	var protagonists []string
+	flag.Func("protagonist", "name of a human Protagonist (repeatable)", func(name string) error {
+		protagonists = append(protagonists, name)
+		return nil
+	})
+	aiProtagonists := flag.Int("ai-protagonists", 0, "number of AI Protagonists; at least one Protagonist is always seated")
+	verbose := flag.Bool("v", false, "log engine and AI output to stderr")
+	flag.Parse()
+
+	engineLogger := zap.NewNop()
+	if *verbose {
+		engineLogger = logger.New()
+	} else {
+		// The mock LLM client logs every prompt through the standard logger.
+		log.SetOutput(io.Discard)
+	}
+
+	players, err := seatPlayers(*mastermind, protagonists, *aiProtagonists)
+	if err == nil && !hasHuman(players) {
+		err = fmt.Errorf("at least one human seat is required; use -mastermind or -protagonist")
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	gameConfig, err := loader.LoadConfig(*dataDir, *scriptID, int32(*modelID))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	ge, err := engine.NewGameEngine(engineLogger, players, llm.NewLLMActionGenerator(llm.NewMockLLMClient(), engineLogger), gameConfig)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to create game engine:", err)
+		os.Exit(1)
+	}
+
+	h := newHotseat(ge, players, os.Stdout)
+	ge.Start()
+	defer func() {
+		ge.Stop()
+		<-ge.Done()
+	}()
+	h.run(os.Stdin)
+}
+
+// seatPlayers creates the players: the Mastermind first, then human and AI Protagonists.
+func seatPlayers(mastermind string, protagonists []string, aiProtagonists int) ([]*model.Player, error) {
+	if aiProtagonists < 0 {
+		return nil, fmt.Errorf("-ai-protagonists must not be negative")
+	}
+	if len(protagonists)+aiProtagonists == 0 {
+		aiProtagonists = 1
+	}
+	if n := len(protagonists) + aiProtagonists; n > engine.MaxProtagonists {
+		return nil, fmt.Errorf("%d protagonists requested, at most %d can play", n, engine.MaxProtagonists)
+	}
+
+	var players []*model.Player
+	add := func(name string, role model.PlayerRole, isLLM bool) {
+		id := int32(len(players) + 1)
+		if name == "" && role == model.PlayerRole_PLAYER_ROLE_MASTERMIND {
+			name = "AI Mastermind"
+		} else if name == "" {
+			name = fmt.Sprintf("AI Protagonist %d", id)
+		}
+		players = append(players, &model.Player{
+			Id:                 id,
+			Name:               name,
+			Role:               role,
+			IsLlm:              isLLM,
+			DeductionKnowledge: &model.PlayerDeductionKnowledge{},
+		})
+	}
+	add(mastermind, model.PlayerRole_PLAYER_ROLE_MASTERMIND, mastermind == "")
+	for _, name := range protagonists {
+		add(name, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	}
+	for i := 0; i < aiProtagonists; i++ {
+		add("", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, true)
+	}
+	return players, nil
+}
+
+func hasHuman(players []*model.Player) bool {
+	for _, p := range players {
+		if !p.IsLlm {
+			return true
+		}
+	}
+	return false
+}
+
+// readLines sends the lines read from r on the returned channel, which is closed at EOF.
+func readLines(r io.Reader) <-chan string {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	return lines
+}
@@ -1,56 +1,152 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
+	"github.com/constellation39/tragedyLooper/internal/config"
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
 	"github.com/constellation39/tragedyLooper/internal/llm"
 	"github.com/constellation39/tragedyLooper/internal/logger"
 	"github.com/constellation39/tragedyLooper/internal/server"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
 
 	"go.uber.org/zap"
 )
 
 func main() {
-	logger := logger.New()
+	cfg, printOnly, err := config.Load(os.Args[1:], os.LookupEnv, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if printOnly {
+		if err := cfg.WriteYAML(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	logger := logger.NewWithOptions(logger.Options{Level: cfg.Log.Level, Production: cfg.Log.Production, File: cfg.Log.File})
 	defer func() {
 		_ = logger.Sync() // Flushes buffer, important for production
 	}()
 
-	// Initialize LLM client (e.g., OpenAI, Google Gemini)
-	// This would typically involve getting API keys from environment variables.
-	llmClient := llm.NewMockLLMClient() // Using a mock client for demonstration
-	// llmClient := llm.NewOpenAIClient(os.Getenv("OPENAI_API_KEY")) // For actual OpenAI integration
+	// Initialize LLM clients for AI seats; the Mastermind and the Protagonists may use different backends.
+	mastermindLLM, err := newLLMClient(cfg.LLM.Mastermind)
+	if err != nil {
+		logger.Fatal("Failed to create Mastermind LLM client", zap.Error(err))
+	}
+	protagonistLLM, err := newLLMClient(cfg.LLM.Protagonist)
+	if err != nil {
+		logger.Fatal("Failed to create Protagonist LLM client", zap.Error(err))
+	}
 
 	// 2. Initialize the game server
-	gameServer := server.NewServer("data", llmClient, logger)
+	gameServer := server.NewServer(cfg.DataDir, protagonistLLM, logger)
+	gameServer.SetRoleLLMClient(model.PlayerRole_PLAYER_ROLE_MASTERMIND, mastermindLLM)
+	gameServer.SetRoomLimits(server.RoomLimits{
+		MaxRooms:          cfg.Rooms.MaxRooms,
+		IdleTimeout:       time.Duration(cfg.Rooms.IdleTimeout),
+		FinishedRetention: time.Duration(cfg.Rooms.FinishedRetention),
+	})
+	gameServer.SetConnectionLimits(server.ConnectionLimits{
+		MaxMessageSize: cfg.Connections.MaxMessageSize,
+		WriteWait:      time.Duration(cfg.Connections.WriteWait),
+		PongWait:       time.Duration(cfg.Connections.PongWait),
+		PingPeriod:     time.Duration(cfg.Connections.PingPeriod),
+		ActionRate:     cfg.Connections.ActionRate,
+		ActionBurst:    cfg.Connections.ActionBurst,
+	})
+	gameServer.SetGameOptions(server.GameOptions{
+		PhaseTimeouts:    cfg.PhaseTimeouts(),
+		DisconnectPolicy: disconnectPolicies[cfg.Game.DisconnectPolicy],
+		DisconnectGrace:  time.Duration(cfg.Game.DisconnectGrace),
+		AIActionTimeout:  time.Duration(cfg.Game.AIActionTimeout),
+	})
+	if len(cfg.AllowedOrigins) > 0 {
+		gameServer.SetAllowedOrigins(cfg.AllowedOrigins)
+	}
+	// A fixed secret keeps seat tokens valid across restarts; otherwise a random one is generated.
+	if cfg.SeatSecret != "" {
+		gameServer.SetSeatTokenSecret([]byte(cfg.SeatSecret))
+	}
+	// Operators can inspect and unblock games through /admin/ with this bearer token; the admin API is disabled without it.
+	if cfg.Admin.Token != "" {
+		gameServer.SetAdminToken(cfg.Admin.Token)
+	}
+	// Persist rooms and games so that unfinished games survive restarts.
+	if cfg.StoreDir != "" {
+		store, err := server.NewFSStore(cfg.StoreDir)
+		if err != nil {
+			logger.Fatal("Failed to open store", zap.Error(err))
+		}
+		if cfg.SeatSecret == "" {
+			logger.Warn("No seat secret is configured; players of restored rooms cannot reconnect with their old seat tokens")
+		}
+		gameServer.SetStore(store)
+		restored, err := gameServer.LoadRooms(context.Background())
+		if err != nil {
+			logger.Fatal("Failed to restore rooms", zap.Error(err))
+		}
+		logger.Info("Rooms restored from store", zap.Int("count", restored), zap.String("dir", cfg.StoreDir))
+	}
 
 	// Create a new ServeMux to apply middleware
 	mux := http.NewServeMux()
 	mux.HandleFunc("/ws", gameServer.HandleWebSocket)
 	mux.HandleFunc("/create_room", gameServer.HandleCreateRoom)
 	mux.HandleFunc("/join_room", gameServer.HandleJoinRoom)
+	mux.HandleFunc("/configure_room", gameServer.HandleConfigureRoom)
+	mux.HandleFunc("/set_ready", gameServer.HandleSetReady)
+	mux.HandleFunc("/start_game", gameServer.HandleStartGame)
 	mux.HandleFunc("/list_rooms", gameServer.HandleListRooms)
+	mux.HandleFunc("/room", gameServer.HandleGetRoom)
+	mux.HandleFunc("/player_view", gameServer.HandlePlayerView)
+	mux.HandleFunc("/event_log", gameServer.HandleEventLog)
+	mux.HandleFunc("/replay", gameServer.HandleReplay)
+	// Server-Sent Events fallback for clients whose proxies break WebSockets.
+	mux.HandleFunc("/events", gameServer.HandleEvents)
+	mux.HandleFunc("/submit_action", gameServer.HandleSubmitAction)
+	mux.HandleFunc("/send_chat", gameServer.HandleSendChat)
+	// Prometheus text-format metrics for the server, game engines and LLM clients.
+	mux.HandleFunc("/metrics", gameServer.HandleMetrics)
+	// Typed GameService API (Connect protocol), see proto/tragedylooper/v1/service.proto.
+	mux.Handle(gameServer.GameServiceHandler())
+	// Operator endpoints, see Server.AdminHandler.
+	mux.Handle(gameServer.AdminHandler())
 
 	// Apply the logging middleware
 	loggedMux := gameServer.LoggingMiddleware(mux)
 
-	port := ":8080"
-	logger.Info("Server starting on port " + port)
+	logger.Info("Server starting", zap.String("addr", cfg.Listen), zap.Bool("tls", cfg.TLS.Enabled()))
 
 	// In a goroutine, start the HTTP server
 	server := &http.Server{
-		Addr:              port,
+		Addr:              cfg.Listen,
 		Handler:           loggedMux,
 		ReadHeaderTimeout: 20 * time.Second, // Mitigate Slowloris attacks
 	}
 	go func() {
-		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatal("HTTP server failed", zap.Error(err))
+		var err error
+		if cfg.TLS.Enabled() {
+			err = server.ListenAndServeTLS(cfg.TLS.CertFile, cfg.TLS.KeyFile)
+		} else {
+			err = server.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			logger.Fatal("HTTP server failed", zap.Error(err))
 		}
 	}()
 
@@ -59,6 +155,40 @@ func main() {
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	<-sigChan // Block until a signal is received
 	logger.Info("Shutting down server...")
-	gameServer.Shutdown() // Perform any cleanup
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Warn("HTTP server shutdown", zap.Error(err))
+	}
+	// Stop all rooms and wait for their game engines and broadcasters to exit.
+	if err := gameServer.Shutdown(ctx); err != nil {
+		logger.Warn("Some rooms did not stop in time", zap.Error(err))
+	}
 	logger.Info("Server gracefully stopped.")
 }
+
+// disconnectPolicies maps config.DisconnectPolicies to engine policies.
+var disconnectPolicies = map[string]engine.DisconnectPolicy{
+	"pause":   engine.DisconnectPolicyPause,
+	"timeout": engine.DisconnectPolicyTimeout,
+}
+
+// newLLMClient creates the LLM client for a seat type. The backend has been validated by config.Load.
+func newLLMClient(cfg config.LLMBackend) (llm.Client, error) {
+	switch cfg.Backend {
+	case "mock":
+		return llm.NewMockLLMClient(), nil
+	case "openai":
+		return llm.NewOpenAIClient(llm.OpenAIConfig{
+			BaseURL:     cfg.BaseURL,
+			APIKey:      cfg.APIKey,
+			Model:       cfg.Model,
+			Temperature: cfg.Temperature,
+			MaxTokens:   cfg.MaxTokens,
+			Timeout:     time.Duration(cfg.Timeout),
+			JSONMode:    cfg.JSONMode,
+		})
+	default:
+		return nil, fmt.Errorf("unknown LLM backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,316 @@
+// Package config 加载游戏服务器的配置。
+//
+// 配置依次来自默认值、YAML 配置文件、环境变量和命令行参数，后者覆盖前者。
+// 配置文件的格式见 Config 的 yaml 标签，可以用 --print-config 输出当前生效的配置作为模板。
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LLMBackends 是可用的 LLM 后端名称。
+var LLMBackends = []string{"mock", "openai"}
+
+// LogLevels 是可用的日志级别。
+var LogLevels = []string{"debug", "info", "warn", "error"}
+
+// DisconnectPolicies 是可用的断线策略，含义见 engine.DisconnectPolicy。
+var DisconnectPolicies = []string{"pause", "timeout"}
+
+// Config 是游戏服务器的完整配置。
+type Config struct {
+	// Listen 是 HTTP 服务监听的地址。
+	Listen string `yaml:"listen"`
+	// TLS 的证书和私钥都设置时使用 HTTPS。
+	TLS TLS `yaml:"tls"`
+	// DataDir 是剧本数据所在的目录。
+	DataDir string `yaml:"data_dir"`
+	// StoreDir 是持久化房间和游戏的目录，为空时不持久化。
+	StoreDir string `yaml:"store_dir"`
+	// SeatSecret 是签发席位令牌的密钥；为空时每次启动随机生成，重启后旧令牌失效。
+	SeatSecret string `yaml:"seat_secret"`
+	// AllowedOrigins 是允许建立 WebSocket 连接的来源，见 server.Server.SetAllowedOrigins。
+	AllowedOrigins []string `yaml:"allowed_origins"`
+
+	Log         Log         `yaml:"log"`
+	Admin       Admin       `yaml:"admin"`
+	Rooms       Rooms       `yaml:"rooms"`
+	Connections Connections `yaml:"connections"`
+	Game        Game        `yaml:"game"`
+	LLM         LLM         `yaml:"llm"`
+}
+
+// TLS 是 HTTPS 的证书配置。
+type TLS struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+// Enabled 报告是否配置了 TLS。
+func (t TLS) Enabled() bool {
+	return t.CertFile != "" || t.KeyFile != ""
+}
+
+// Log 是日志配置。
+type Log struct {
+	// Level 是最低输出级别：debug、info、warn 或 error。
+	Level string `yaml:"level"`
+	// Production 为 true 时使用生产环境的编码格式，否则输出彩色的开发格式。
+	Production bool `yaml:"production"`
+	// File 是额外写入的 JSON 日志文件，按大小轮转；为空时只输出到标准输出。
+	File string `yaml:"file"`
+}
+
+// Admin 是管理接口的配置。
+type Admin struct {
+	// Token 是管理接口的访问令牌，为空时禁用管理接口。
+	Token string `yaml:"token"`
+}
+
+// Rooms 是房间数量和生命周期的限制，含义见 server.RoomLimits。
+type Rooms struct {
+	MaxRooms          int      `yaml:"max_rooms"`
+	IdleTimeout       Duration `yaml:"idle_timeout"`
+	FinishedRetention Duration `yaml:"finished_retention"`
+}
+
+// Connections 是单个连接和席位的资源限制，含义见 server.ConnectionLimits。0 表示不限制。
+type Connections struct {
+	// MaxMessageSize 是客户端通过 WebSocket 发送的单条消息的最大字节数。
+	MaxMessageSize int64 `yaml:"max_message_size"`
+	// WriteWait 是向客户端写入一条消息的超时。
+	WriteWait Duration `yaml:"write_wait"`
+	// PongWait 是等待客户端任何消息（包括 pong）的超时。
+	PongWait Duration `yaml:"pong_wait"`
+	// PingPeriod 是服务器发送 ping 的间隔，必须小于 PongWait。
+	PingPeriod Duration `yaml:"ping_period"`
+	// ActionRate 是每个席位每秒可以提交的操作和聊天消息数量。
+	ActionRate float64 `yaml:"action_rate"`
+	// ActionBurst 是席位可以连续提交的操作数量上限，限制频率时至少为 1。
+	ActionBurst int `yaml:"action_burst"`
+}
+
+// Game 是新游戏的默认设置。
+type Game struct {
+	// PhaseTimeouts 是各阶段的默认超时，以阶段名为键，例如 mastermind_card_play；
+	// 未列出的阶段不超时。阶段自身定义了超时时优先使用阶段的设置。
+	PhaseTimeouts map[string]Duration `yaml:"phase_timeouts"`
+	// DisconnectPolicy 是人类玩家断线时的处理方式：pause 或 timeout。
+	DisconnectPolicy string `yaml:"disconnect_policy"`
+	// DisconnectGrace 是 timeout 策略下等待断线玩家重连的时间，0 表示使用引擎的默认值。
+	DisconnectGrace Duration `yaml:"disconnect_grace"`
+	// AIActionTimeout 是 AI 玩家做出一次决定的期限，超过期限时由引擎代为跳过，0 表示使用引擎的默认值。
+	AIActionTimeout Duration `yaml:"ai_action_timeout"`
+}
+
+// LLM 是 AI 玩家使用的 LLM 配置，主谋和主角可以使用不同的后端。
+type LLM struct {
+	Mastermind  LLMBackend `yaml:"mastermind"`
+	Protagonist LLMBackend `yaml:"protagonist"`
+}
+
+// LLMBackend 是一类席位使用的 LLM 后端和参数。
+type LLMBackend struct {
+	// Backend 是后端名称，见 LLMBackends。
+	Backend string `yaml:"backend"`
+	// BaseURL 是 API 的地址，为空时使用后端的默认地址。
+	BaseURL string `yaml:"base_url"`
+	// APIKey 是 API 的访问密钥。
+	APIKey string `yaml:"api_key"`
+	// Model 是模型名称。
+	Model string `yaml:"model"`
+	// Temperature 是采样温度，范围 [0, 2]。
+	Temperature float64 `yaml:"temperature"`
+	// MaxTokens 是单次回复的最大 token 数，0 表示使用后端的默认值。
+	MaxTokens int `yaml:"max_tokens"`
+	// Timeout 是单次请求的超时，0 表示不限制。
+	Timeout Duration `yaml:"timeout"`
+	// JSONMode 要求后端以 JSON 对象回复，需要后端支持 response_format。
+	JSONMode bool `yaml:"json_mode"`
+}
+
+// Default 返回默认配置。
+func Default() *Config {
+	return &Config{
+		Listen:  ":8080",
+		DataDir: "data",
+		Log:     Log{Level: "info"},
+		Rooms: Rooms{
+			MaxRooms:          100,
+			IdleTimeout:       Duration(30 * time.Minute),
+			FinishedRetention: Duration(10 * time.Minute),
+		},
+		Connections: Connections{
+			MaxMessageSize: 64 << 10,
+			WriteWait:      Duration(10 * time.Second),
+			PongWait:       Duration(60 * time.Second),
+			PingPeriod:     Duration(54 * time.Second),
+			ActionRate:     5,
+			ActionBurst:    10,
+		},
+		Game: Game{DisconnectPolicy: "pause"},
+		LLM: LLM{
+			Mastermind:  LLMBackend{Backend: "mock", Timeout: Duration(30 * time.Second)},
+			Protagonist: LLMBackend{Backend: "mock", Timeout: Duration(30 * time.Second)},
+		},
+	}
+}
+
+// LoadFile 读取 YAML 配置文件，覆盖 c 中文件里出现的字段。文件中的未知字段视为错误。
+func (c *Config) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, c); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate 检查配置是否完整且取值合法，返回所有发现的问题。
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(c.Listen != "", "listen address is required")
+	check(c.TLS.CertFile != "" || c.TLS.KeyFile == "", "tls.cert_file is required when tls.key_file is set")
+	check(c.TLS.KeyFile != "" || c.TLS.CertFile == "", "tls.key_file is required when tls.cert_file is set")
+	check(c.DataDir != "", "data_dir is required")
+	check(slices.Contains(LogLevels, c.Log.Level), "log.level must be one of %s, got %q", strings.Join(LogLevels, ", "), c.Log.Level)
+
+	check(c.Rooms.MaxRooms >= 0, "rooms.max_rooms must not be negative")
+	check(c.Rooms.IdleTimeout >= 0, "rooms.idle_timeout must not be negative")
+	check(c.Rooms.FinishedRetention >= 0, "rooms.finished_retention must not be negative")
+
+	check(c.Connections.MaxMessageSize >= 0, "connections.max_message_size must not be negative")
+	check(c.Connections.WriteWait >= 0, "connections.write_wait must not be negative")
+	check(c.Connections.PongWait >= 0, "connections.pong_wait must not be negative")
+	check(c.Connections.PingPeriod >= 0, "connections.ping_period must not be negative")
+	check(c.Connections.PongWait == 0 || c.Connections.PingPeriod < c.Connections.PongWait,
+		"connections.ping_period must be shorter than connections.pong_wait")
+	check(c.Connections.ActionRate >= 0, "connections.action_rate must not be negative")
+	check(c.Connections.ActionRate == 0 || c.Connections.ActionBurst >= 1,
+		"connections.action_burst must be at least 1 when connections.action_rate is set")
+
+	for name, timeout := range c.Game.PhaseTimeouts {
+		_, err := ParsePhase(name)
+		check(err == nil, "game.phase_timeouts: %v", err)
+		check(timeout >= 0, "game.phase_timeouts.%s must not be negative", name)
+	}
+	check(slices.Contains(DisconnectPolicies, c.Game.DisconnectPolicy),
+		"game.disconnect_policy must be one of %s, got %q", strings.Join(DisconnectPolicies, ", "), c.Game.DisconnectPolicy)
+	check(c.Game.DisconnectGrace >= 0, "game.disconnect_grace must not be negative")
+	check(c.Game.AIActionTimeout >= 0, "game.ai_action_timeout must not be negative")
+
+	for _, seat := range []struct {
+		name string
+		b    LLMBackend
+	}{{"mastermind", c.LLM.Mastermind}, {"protagonist", c.LLM.Protagonist}} {
+		check(slices.Contains(LLMBackends, seat.b.Backend),
+			"llm.%s.backend must be one of %s, got %q", seat.name, strings.Join(LLMBackends, ", "), seat.b.Backend)
+		check(seat.b.Temperature >= 0 && seat.b.Temperature <= 2, "llm.%s.temperature must be between 0 and 2", seat.name)
+		check(seat.b.MaxTokens >= 0, "llm.%s.max_tokens must not be negative", seat.name)
+		check(seat.b.Timeout >= 0, "llm.%s.timeout must not be negative", seat.name)
+		check(seat.b.Backend != "openai" || seat.b.Model != "", "llm.%s.model is required for the openai backend", seat.name)
+	}
+	return errors.Join(errs...)
+}
+
+// PhaseTimeouts 返回以阶段为键的默认超时。配置必须已经通过 Validate。
+func (c *Config) PhaseTimeouts() map[model.GamePhase]time.Duration {
+	timeouts := make(map[model.GamePhase]time.Duration, len(c.Game.PhaseTimeouts))
+	for name, timeout := range c.Game.PhaseTimeouts {
+		if phase, err := ParsePhase(name); err == nil {
+			timeouts[phase] = time.Duration(timeout)
+		}
+	}
+	return timeouts
+}
+
+// ParsePhase 解析阶段名。阶段名不区分大小写，可以省略 GAME_PHASE_ 前缀，例如 mastermind_card_play。
+func ParsePhase(name string) (model.GamePhase, error) {
+	upper := strings.ToUpper(name)
+	if !strings.HasPrefix(upper, "GAME_PHASE_") {
+		upper = "GAME_PHASE_" + upper
+	}
+	value, ok := model.GamePhase_value[upper]
+	if !ok || value == int32(model.GamePhase_GAME_PHASE_UNSPECIFIED) {
+		return model.GamePhase_GAME_PHASE_UNSPECIFIED, fmt.Errorf("unknown phase %q", name)
+	}
+	return model.GamePhase(value), nil
+}
+
+// redacted 替换输出配置中的密钥。
+const redacted = "<redacted>"
+
+// Redacted 返回隐藏了密钥的配置副本，用于输出或记录日志。
+func (c *Config) Redacted() *Config {
+	r := *c
+	redact := func(s *string) {
+		if *s != "" {
+			*s = redacted
+		}
+	}
+	redact(&r.SeatSecret)
+	redact(&r.Admin.Token)
+	redact(&r.LLM.Mastermind.APIKey)
+	redact(&r.LLM.Protagonist.APIKey)
+	return &r
+}
+
+// WriteYAML 将隐藏了密钥的配置以 YAML 格式写入 w。
+func (c *Config) WriteYAML(w io.Writer) error {
+	data, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// Duration 是在 YAML、环境变量和命令行中以 time.ParseDuration 格式（例如 "30s"、"10m"）表示的时长。
+type Duration time.Duration
+
+// String 实现 flag.Value。
+func (d *Duration) String() string {
+	return time.Duration(*d).String()
+}
+
+// Set 实现 flag.Value。
+func (d *Duration) Set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// MarshalYAML 实现 yaml.Marshaler。
+func (d Duration) MarshalYAML() (interface{}, error) {
+	return time.Duration(d).String(), nil
+}
+
+// UnmarshalYAML 实现 yaml.Unmarshaler。
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return d.Set(s)
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func envFrom(env map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		v, ok := env[name]
+		return v, ok
+	}
+}
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, printOnly, err := Load(nil, envFrom(nil), io.Discard)
+	require.NoError(t, err)
+	assert.False(t, printOnly)
+	assert.Equal(t, Default(), cfg)
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	path := writeConfig(t, `
+listen: ":9000"
+data_dir: /srv/data
+admin:
+  token: from-file
+rooms:
+  max_rooms: 5
+  idle_timeout: 5m
+game:
+  phase_timeouts:
+    mastermind_card_play: 90s
+    GAME_PHASE_PROTAGONIST_GUESS: 2m
+llm:
+  protagonist:
+    model: small
+    temperature: 0.5
+`)
+	env := envFrom(map[string]string{
+		EnvListen:     ":9001",
+		EnvAdminToken: "from-env",
+		EnvMaxRooms:   "7",
+	})
+	cfg, _, err := Load([]string{"--config", path, "--listen", ":9002", "--print-config"}, env, io.Discard)
+	require.NoError(t, err)
+
+	assert.Equal(t, ":9002", cfg.Listen, "flags override env and file")
+	assert.Equal(t, "from-env", cfg.Admin.Token, "env overrides file")
+	assert.Equal(t, 7, cfg.Rooms.MaxRooms)
+	assert.Equal(t, "/srv/data", cfg.DataDir, "file overrides defaults")
+	assert.Equal(t, Duration(5*time.Minute), cfg.Rooms.IdleTimeout)
+	assert.Equal(t, Duration(10*time.Minute), cfg.Rooms.FinishedRetention, "unset fields keep their defaults")
+	assert.Equal(t, "small", cfg.LLM.Protagonist.Model)
+	assert.Equal(t, "mock", cfg.LLM.Protagonist.Backend)
+	assert.Equal(t, map[model.GamePhase]time.Duration{
+		model.GamePhase_GAME_PHASE_MASTERMIND_CARD_PLAY: 90 * time.Second,
+		model.GamePhase_GAME_PHASE_PROTAGONIST_GUESS:    2 * time.Minute,
+	}, cfg.PhaseTimeouts())
+}
+
+func TestLoadRejectsInvalidConfig(t *testing.T) {
+	_, _, err := Load([]string{"--config", writeConfig(t, "listne: :80\n")}, envFrom(nil), io.Discard)
+	assert.ErrorContains(t, err, "listne", "unknown keys are rejected")
+
+	path := writeConfig(t, `
+tls:
+  cert_file: cert.pem
+game:
+  phase_timeouts:
+    lunch: 1m
+llm:
+  mastermind:
+    backend: nope
+  protagonist:
+    backend: openai
+`)
+	_, _, err = Load([]string{"--config", path, "--log-level", "loud"}, envFrom(nil), io.Discard)
+	require.Error(t, err)
+	for _, want := range []string{"tls.key_file", `unknown phase "lunch"`, "llm.mastermind.backend", "llm.protagonist.model", "log.level"} {
+		assert.ErrorContains(t, err, want)
+	}
+}
+
+func TestLoadConnections(t *testing.T) {
+	path := writeConfig(t, `
+connections:
+  max_message_size: 1024
+  ping_period: 20s
+`)
+	env := envFrom(map[string]string{EnvPongWait: "30s", EnvMaxMessageSize: "2048", EnvActionRate: "0.5"})
+	cfg, _, err := Load([]string{"--config", path, "--action-burst", "3"}, env, io.Discard)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2048), cfg.Connections.MaxMessageSize, "env overrides file")
+	assert.Equal(t, Duration(20*time.Second), cfg.Connections.PingPeriod)
+	assert.Equal(t, Duration(30*time.Second), cfg.Connections.PongWait)
+	assert.Equal(t, Duration(10*time.Second), cfg.Connections.WriteWait, "unset fields keep their defaults")
+	assert.Equal(t, 0.5, cfg.Connections.ActionRate)
+	assert.Equal(t, 3, cfg.Connections.ActionBurst)
+
+	_, _, err = Load([]string{"--config", path}, envFrom(map[string]string{EnvPongWait: "10s"}), io.Discard)
+	assert.ErrorContains(t, err, "connections.ping_period")
+	_, _, err = Load(nil, envFrom(map[string]string{EnvWriteWait: "soon"}), io.Discard)
+	assert.ErrorContains(t, err, EnvWriteWait)
+	_, _, err = Load([]string{"--action-burst", "0"}, envFrom(nil), io.Discard)
+	assert.ErrorContains(t, err, "connections.action_burst")
+	_, _, err = Load([]string{"--action-rate", "0", "--action-burst", "0"}, envFrom(nil), io.Discard)
+	assert.NoError(t, err, "the burst does not matter when actions are not rate limited")
+}
+
+func TestWriteYAMLRedactsSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Admin.Token = "admin-secret"
+	cfg.LLM.Mastermind.APIKey = "sk-secret"
+
+	var b strings.Builder
+	require.NoError(t, cfg.WriteYAML(&b))
+	assert.NotContains(t, b.String(), "admin-secret")
+	assert.NotContains(t, b.String(), "sk-secret")
+	assert.Contains(t, b.String(), redacted)
+	assert.Contains(t, b.String(), "idle_timeout: 30m0s")
+	assert.Equal(t, "admin-secret", cfg.Admin.Token, "the original config is not modified")
+
+	// 输出的配置可以重新加载。
+	cfg.Admin.Token = ""
+	cfg.LLM.Mastermind.APIKey = ""
+	b.Reset()
+	require.NoError(t, cfg.WriteYAML(&b))
+	loaded, _, err := Load([]string{"--config", writeConfig(t, b.String())}, envFrom(nil), io.Discard)
+	require.NoError(t, err)
+	var reloaded strings.Builder
+	require.NoError(t, loaded.WriteYAML(&reloaded))
+	assert.Equal(t, b.String(), reloaded.String())
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 环境变量。密钥只能通过配置文件或环境变量设置，避免出现在进程列表中。
+const (
+	EnvConfigFile     = "TRAGEDYLOOPER_CONFIG"
+	EnvListen         = "TRAGEDYLOOPER_LISTEN"
+	EnvTLSCert        = "TRAGEDYLOOPER_TLS_CERT"
+	EnvTLSKey         = "TRAGEDYLOOPER_TLS_KEY"
+	EnvDataDir        = "TRAGEDYLOOPER_DATA_DIR"
+	EnvStoreDir       = "TRAGEDYLOOPER_STORE_DIR"
+	EnvSeatSecret     = "TRAGEDYLOOPER_SEAT_SECRET"
+	EnvAdminToken     = "TRAGEDYLOOPER_ADMIN_TOKEN"
+	EnvAllowedOrigins = "TRAGEDYLOOPER_ALLOWED_ORIGINS" // 以逗号分隔
+	EnvMaxRooms       = "TRAGEDYLOOPER_MAX_ROOMS"
+	EnvMaxMessageSize = "TRAGEDYLOOPER_MAX_MESSAGE_SIZE"
+	EnvWriteWait      = "TRAGEDYLOOPER_WRITE_WAIT"
+	EnvPongWait       = "TRAGEDYLOOPER_PONG_WAIT"
+	EnvPingPeriod     = "TRAGEDYLOOPER_PING_PERIOD"
+	EnvActionRate     = "TRAGEDYLOOPER_ACTION_RATE"
+	EnvActionBurst    = "TRAGEDYLOOPER_ACTION_BURST"
+	EnvLogFile        = "TRAGEDYLOOPER_LOG_FILE"
+	EnvLLMBackend     = "TRAGEDYLOOPER_LLM_BACKEND" // 同时设置主谋和主角的后端
+	EnvLLMAPIKey      = "TRAGEDYLOOPER_LLM_API_KEY" // 同时设置主谋和主角的密钥
+	// 以下两个变量与 logger.New 保持一致。
+	EnvLogEnv   = "LOG_ENV" // "production" 启用生产环境日志，未设置日志文件时写入 logs/app.log
+	EnvLogLevel = "LOG_LEVEL"
+)
+
+// defaultProductionLogFile 是 LOG_ENV=production 且未设置日志文件时使用的文件，与 logger.New 一致。
+const defaultProductionLogFile = "logs/app.log"
+
+// Load 按默认值、配置文件、环境变量、命令行参数的顺序加载配置并检查。
+// args 不包含程序名；lookupEnv 通常为 os.LookupEnv。配置文件由 --config 或 TRAGEDYLOOPER_CONFIG 指定。
+// printOnly 表示指定了 --print-config，调用者应输出配置后退出。
+// 参数中包含 -h 或 --help 时返回 flag.ErrHelp。
+func Load(args []string, lookupEnv func(string) (string, bool), output io.Writer) (cfg *Config, printOnly bool, err error) {
+	// 先解析一次命令行得到配置文件路径，再在文件和环境变量之上重新解析，使命令行参数优先。
+	var configFile string
+	fs := newFlagSet(Default(), &configFile, &printOnly, output)
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+	if configFile == "" {
+		configFile, _ = lookupEnv(EnvConfigFile)
+	}
+
+	cfg = Default()
+	if configFile != "" {
+		if err := cfg.LoadFile(configFile); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := cfg.applyEnv(lookupEnv); err != nil {
+		return nil, false, err
+	}
+	if err := newFlagSet(cfg, new(string), new(bool), output).Parse(args); err != nil {
+		return nil, false, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, false, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, printOnly, nil
+}
+
+// newFlagSet 定义绑定到 cfg 字段的命令行参数。
+func newFlagSet(cfg *Config, configFile *string, printConfig *bool, output io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("tragedylooper", flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.StringVar(configFile, "config", "", "path to a YAML config file (env "+EnvConfigFile+")")
+	fs.BoolVar(printConfig, "print-config", false, "print the effective configuration with secrets redacted and exit")
+	fs.StringVar(&cfg.Listen, "listen", cfg.Listen, "HTTP listen address")
+	fs.StringVar(&cfg.TLS.CertFile, "tls-cert", cfg.TLS.CertFile, "TLS certificate file; enables HTTPS together with -tls-key")
+	fs.StringVar(&cfg.TLS.KeyFile, "tls-key", cfg.TLS.KeyFile, "TLS private key file")
+	fs.StringVar(&cfg.DataDir, "data-dir", cfg.DataDir, "directory containing the script data")
+	fs.StringVar(&cfg.StoreDir, "store-dir", cfg.StoreDir, "directory to persist rooms and games in; empty disables persistence")
+	fs.StringVar(&cfg.Log.Level, "log-level", cfg.Log.Level, "minimum log level: "+strings.Join(LogLevels, ", "))
+	fs.BoolVar(&cfg.Log.Production, "log-production", cfg.Log.Production, "use the production log encoding")
+	fs.StringVar(&cfg.Log.File, "log-file", cfg.Log.File, "also write JSON logs to this file, rotated by size")
+	fs.IntVar(&cfg.Rooms.MaxRooms, "max-rooms", cfg.Rooms.MaxRooms, "maximum number of rooms; 0 means unlimited")
+	fs.Var(&cfg.Rooms.IdleTimeout, "room-idle-timeout", "how long an idle room is kept; 0 means forever")
+	fs.Int64Var(&cfg.Connections.MaxMessageSize, "max-message-size", cfg.Connections.MaxMessageSize, "maximum size in bytes of a WebSocket message from a client; 0 means unlimited")
+	fs.Float64Var(&cfg.Connections.ActionRate, "action-rate", cfg.Connections.ActionRate, "actions and chat messages each seat may submit per second; 0 means unlimited")
+	fs.IntVar(&cfg.Connections.ActionBurst, "action-burst", cfg.Connections.ActionBurst, "actions a seat may submit in a burst")
+	fs.StringVar(&cfg.Game.DisconnectPolicy, "disconnect-policy", cfg.Game.DisconnectPolicy, "what to do when a human player disconnects: "+strings.Join(DisconnectPolicies, ", "))
+	fs.Func("llm-backend", "LLM backend for all AI seats: "+strings.Join(LLMBackends, ", "), func(s string) error {
+		cfg.LLM.Mastermind.Backend = s
+		cfg.LLM.Protagonist.Backend = s
+		return nil
+	})
+	return fs
+}
+
+// applyEnv 用设置了的环境变量覆盖配置。
+func (c *Config) applyEnv(lookupEnv func(string) (string, bool)) error {
+	str := func(name string, dst ...*string) {
+		if v, ok := lookupEnv(name); ok && v != "" {
+			for _, d := range dst {
+				*d = v
+			}
+		}
+	}
+	str(EnvListen, &c.Listen)
+	str(EnvTLSCert, &c.TLS.CertFile)
+	str(EnvTLSKey, &c.TLS.KeyFile)
+	str(EnvDataDir, &c.DataDir)
+	str(EnvStoreDir, &c.StoreDir)
+	str(EnvSeatSecret, &c.SeatSecret)
+	str(EnvAdminToken, &c.Admin.Token)
+	str(EnvLogFile, &c.Log.File)
+	str(EnvLLMBackend, &c.LLM.Mastermind.Backend, &c.LLM.Protagonist.Backend)
+	str(EnvLLMAPIKey, &c.LLM.Mastermind.APIKey, &c.LLM.Protagonist.APIKey)
+
+	if v, ok := lookupEnv(EnvAllowedOrigins); ok && v != "" {
+		c.AllowedOrigins = nil
+		for _, origin := range strings.Split(v, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				c.AllowedOrigins = append(c.AllowedOrigins, origin)
+			}
+		}
+	}
+	if v, ok := lookupEnv(EnvMaxRooms); ok && v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvMaxRooms, err)
+		}
+		c.Rooms.MaxRooms = n
+	}
+	if v, ok := lookupEnv(EnvMaxMessageSize); ok && v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvMaxMessageSize, err)
+		}
+		c.Connections.MaxMessageSize = n
+	}
+	if v, ok := lookupEnv(EnvActionRate); ok && v != "" {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvActionRate, err)
+		}
+		c.Connections.ActionRate = rate
+	}
+	if v, ok := lookupEnv(EnvActionBurst); ok && v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", EnvActionBurst, err)
+		}
+		c.Connections.ActionBurst = n
+	}
+	for _, env := range []struct {
+		name string
+		dst  *Duration
+	}{{EnvWriteWait, &c.Connections.WriteWait}, {EnvPongWait, &c.Connections.PongWait}, {EnvPingPeriod, &c.Connections.PingPeriod}} {
+		if v, ok := lookupEnv(env.name); ok && v != "" {
+			if err := env.dst.Set(v); err != nil {
+				return fmt.Errorf("invalid %s: %w", env.name, err)
+			}
+		}
+	}
+	if v, ok := lookupEnv(EnvLogEnv); ok && strings.EqualFold(v, "production") {
+		c.Log.Production = true
+		if c.Log.File == "" {
+			c.Log.File = defaultProductionLogFile
+		}
+	}
+	if v, ok := lookupEnv(EnvLogLevel); ok && v != "" {
+		c.Log.Level = strings.ToLower(v)
+	}
+	return nil
+}
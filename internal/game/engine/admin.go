@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"fmt"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// adminRequest is a request to run an operator command inside the game loop.
+type adminRequest struct {
+	apply        func() error
+	responseChan chan error
+}
+
+// runAdmin 在游戏主循环中执行运维命令并等待其结果。引擎停止后返回 ErrEngineStopped。
+func (ge *GameEngine) runAdmin(apply func() error) error {
+	responseChan := make(chan error, 1)
+	select {
+	case ge.engineChan <- &adminRequest{apply: apply, responseChan: responseChan}:
+	case <-ge.stopChan:
+		return ErrEngineStopped
+	}
+	select {
+	case err := <-responseChan:
+		return err
+	case <-ge.stopChan:
+		return ErrEngineStopped
+	}
+}
+
+// SetAdminPaused 由运维人员暂停或恢复游戏。暂停期间时钟停止，阶段超时不会触发，
+// 但仍会处理视图查询和玩家操作。运维暂停与断线暂停相互独立：恢复后如果仍有断线玩家，游戏保持暂停。
+func (ge *GameEngine) SetAdminPaused(paused bool) error {
+	return ge.runAdmin(func() error {
+		ge.adminPaused = paused
+		ge.logger.Info("Admin pause changed", zap.Bool("paused", paused))
+		ge.updatePaused()
+		return nil
+	})
+}
+
+// ForceAdvance 不等待当前阶段完成，直接进入流程图中的下一个阶段，并返回进入的阶段。
+// 用于解除卡住的游戏，例如一直等待不会到来的 AI 操作的阶段。
+func (ge *GameEngine) ForceAdvance() (model.GamePhase, error) {
+	var phase model.GamePhase
+	err := ge.runAdmin(func() error {
+		from := ge.phaseManager.CurrentPhase().Type()
+		ge.phaseManager.Advance()
+		phase = ge.phaseManager.CurrentPhase().Type()
+		if phase == from {
+			return fmt.Errorf("phase %s has no next phase", from)
+		}
+		ge.logger.Warn("Phase advanced by admin", zap.String("from", from.String()), zap.String("to", phase.String()))
+		return nil
+	})
+	return phase, err
+}
+
+// SetPlayerAI 将人类玩家交给 AI 控制，并立即请求 AI 做出决定。
+// 玩家不再被视为断线，因此不会因为该玩家而暂停游戏或触发断线超时。
+func (ge *GameEngine) SetPlayerAI(playerID int32) error {
+	return ge.runAdmin(func() error {
+		player, ok := ge.GameState.Players[playerID]
+		if !ok {
+			return fmt.Errorf("unknown player %d", playerID)
+		}
+		if player.IsLlm {
+			return fmt.Errorf("player %d is already controlled by AI", playerID)
+		}
+		player.IsLlm = true
+		delete(ge.GameState.DisconnectedPlayers, playerID)
+		delete(ge.disconnectDeadlines, playerID)
+		ge.updatePaused()
+		ge.logger.Warn("Player handed over to AI by admin", zap.Int32("playerID", playerID))
+		ge.RequestAIAction(playerID)
+		return nil
+	})
+}
@@ -0,0 +1,34 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngine_AdminPause(t *testing.T) {
+	engine := helper_NewGameEngineForTest(t)
+	engine.Start()
+	defer engine.Stop()
+
+	assert.NoError(t, engine.SetAdminPaused(true))
+	assert.True(t, engine.Snapshot().Paused)
+
+	// 断线暂停和运维暂停相互独立。
+	engine.SetPlayerConnected(2, false)
+	assert.NoError(t, engine.SetAdminPaused(false))
+	assert.True(t, engine.Snapshot().Paused, "a disconnected player still pauses the game")
+	engine.SetPlayerConnected(2, true)
+	assert.False(t, engine.Snapshot().Paused)
+
+	assert.Error(t, engine.SetPlayerAI(99))
+	engine.SetPlayerConnected(3, false)
+	assert.NoError(t, engine.SetPlayerAI(3))
+	snapshot := engine.Snapshot()
+	assert.True(t, snapshot.Players[3].IsLlm)
+	assert.False(t, snapshot.Paused, "a player handed to AI no longer counts as disconnected")
+	assert.Error(t, engine.SetPlayerAI(3))
+
+	engine.Stop()
+	assert.ErrorIs(t, engine.SetAdminPaused(true), ErrEngineStopped)
+}
@@ -7,14 +7,16 @@ import (
 )
 
 // ActionGenerator defines the interface for an AI to generate a player action.
+// ctx is canceled when the phase ends or the engine stops, and carries the engine's AI action deadline;
+// implementations must return promptly once it is done.
 type ActionGenerator interface {
 	GenerateAction(ctx context.Context, data *ActionGeneratorContext) (*model.PlayerActionPayload, error)
 }
 
 // ActionGeneratorContext provides all necessary information for an AI to make a decision.
+// AI 玩家只能通过 PlayerView 获取信息，它与同一身份的人类玩家看到的内容完全相同。
 type ActionGeneratorContext struct {
-	Player        *model.Player
-	PlayerView    *model.PlayerView
-	Script        *model.ScriptConfig
-	AllCharacters map[int32]*model.Character
+	Player     *model.Player
+	PlayerView *model.PlayerView
+	Script     *model.ScriptConfig
 }
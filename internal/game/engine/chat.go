@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxChatLength 是一条聊天消息的最大字符数。
+const MaxChatLength = 500
+
+var (
+	// ErrInvalidChat 表示聊天消息为空、过长或频道无效。
+	ErrInvalidChat = errors.New("invalid chat message")
+	// ErrChatForbidden 表示玩家不能在该频道或当前阶段发言。
+	ErrChatForbidden = errors.New("chat not allowed")
+)
+
+// postChatRequest is a request to post a chat message on behalf of a player.
+type postChatRequest struct {
+	playerID     int32
+	channel      model.ChatChannel
+	text         string
+	responseChan chan error
+}
+
+// DiscussionAllowed 报告身份为 role 的玩家能否在 phase 阶段发言。
+// 剧本不允许讨论时（can_discuss 为 false），主角只能在游戏开始前、循环之间和游戏结束后交流；
+// 主谋不受此限制。
+func DiscussionAllowed(canDiscuss bool, role model.PlayerRole, phase model.GamePhase) bool {
+	if canDiscuss || role != model.PlayerRole_PLAYER_ROLE_PROTAGONIST {
+		return true
+	}
+	switch phase {
+	case model.GamePhase_GAME_PHASE_SETUP,
+		model.GamePhase_GAME_PHASE_MASTERMIND_SETUP,
+		model.GamePhase_GAME_PHASE_LOOP_END,
+		model.GamePhase_GAME_PHASE_GAME_OVER:
+		return true
+	default:
+		return false
+	}
+}
+
+// PostChat 以玩家的身份发送聊天消息。消息作为 CHAT_MESSAGE 事件记录在游戏日志中并推送给频道中的玩家；
+// 它不改变游戏状态，也不影响阶段流程。
+// 消息不合法时返回 ErrInvalidChat，玩家不能发言时返回 ErrChatForbidden，引擎停止后返回 ErrEngineStopped。
+func (ge *GameEngine) PostChat(playerID int32, channel model.ChatChannel, text string) error {
+	text = strings.TrimSpace(text)
+	switch {
+	case text == "":
+		return fmt.Errorf("%w: empty text", ErrInvalidChat)
+	case utf8.RuneCountInString(text) > MaxChatLength:
+		return fmt.Errorf("%w: longer than %d characters", ErrInvalidChat, MaxChatLength)
+	case channel != model.ChatChannel_CHAT_CHANNEL_ALL && channel != model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS:
+		return fmt.Errorf("%w: unknown channel %s", ErrInvalidChat, channel)
+	}
+
+	responseChan := make(chan error, 1)
+	req := &postChatRequest{playerID: playerID, channel: channel, text: text, responseChan: responseChan}
+	select {
+	case ge.engineChan <- req:
+	case <-ge.stopChan:
+		return ErrEngineStopped
+	}
+	select {
+	case err := <-responseChan:
+		return err
+	case <-ge.stopChan:
+		return ErrEngineStopped
+	}
+}
+
+// handlePostChat 检查发言规则并发布聊天事件。
+// 此方法必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) handlePostChat(r *postChatRequest) error {
+	player, ok := ge.GameState.Players[r.playerID]
+	if !ok {
+		return fmt.Errorf("%w: unknown player %d", ErrChatForbidden, r.playerID)
+	}
+	if r.channel == model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS && player.Role != model.PlayerRole_PLAYER_ROLE_PROTAGONIST {
+		return fmt.Errorf("%w: only protagonists can use the protagonist channel", ErrChatForbidden)
+	}
+	phase := ge.GameState.CurrentPhase
+	if !DiscussionAllowed(ge.scriptConfig.GetCanDiscuss(), player.Role, phase) {
+		return fmt.Errorf("%w: protagonists may not discuss during %s in this script", ErrChatForbidden, phase)
+	}
+
+	event := &model.GameEvent{
+		Type:      model.GameEventType_GAME_EVENT_TYPE_CHAT_MESSAGE,
+		Timestamp: timestamppb.Now(),
+		Payload: &model.EventPayload{Payload: &model.EventPayload_ChatMessage{ChatMessage: &model.ChatMessageEvent{
+			PlayerId:   player.Id,
+			PlayerName: player.Name,
+			Channel:    r.channel,
+			Text:       r.text,
+		}}},
+		Loop: ge.GameState.CurrentLoop,
+		Day:  ge.GameState.CurrentDay,
+	}
+	ge.recordEvent(event)
+	ge.eventManager.Dispatch(event)
+	ge.logger.Debug("Chat message posted", zap.Int32("playerID", player.Id), zap.String("channel", r.channel.String()))
+	return nil
+}
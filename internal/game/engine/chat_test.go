@@ -0,0 +1,21 @@
+package engine
+
+import (
+	"testing"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscussionAllowed(t *testing.T) {
+	protagonist := model.PlayerRole_PLAYER_ROLE_PROTAGONIST
+	mastermind := model.PlayerRole_PLAYER_ROLE_MASTERMIND
+
+	assert.True(t, DiscussionAllowed(true, protagonist, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY))
+	assert.False(t, DiscussionAllowed(false, protagonist, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY))
+	assert.False(t, DiscussionAllowed(false, protagonist, model.GamePhase_GAME_PHASE_DAY_START))
+	assert.True(t, DiscussionAllowed(false, protagonist, model.GamePhase_GAME_PHASE_LOOP_END), "protagonists may meet between loops")
+	assert.True(t, DiscussionAllowed(false, protagonist, model.GamePhase_GAME_PHASE_GAME_OVER))
+	assert.True(t, DiscussionAllowed(false, mastermind, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY), "the rule only restricts protagonists")
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"fmt"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// pendingChoice 是等待玩家回应的选择请求，以及玩家回应后继续应用的效果。
+type pendingChoice struct {
+	event   *model.ChoiceRequiredEvent
+	effect  *model.Effect
+	ability *model.Ability
+	payload *model.UseAbilityPayload
+}
+
+// requireChoice 向需要做出选择的玩家发出选择请求，见 choosingPlayer。选择请求只对该玩家可见；
+// 玩家回应后，引擎以玩家的选择继续应用效果，见 answerChoice。
+// 此方法不是线程安全的，必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) requireChoice(effect *model.Effect, ability *model.Ability, payload *model.UseAbilityPayload, choices []*model.Choice) {
+	ge.choiceSeq++
+	playerID := ge.choosingPlayer()
+	choiceEvent := &model.ChoiceRequiredEvent{
+		RequestId: fmt.Sprintf("choice_%d", ge.choiceSeq),
+		PlayerId:  playerID,
+		Choices:   choices,
+	}
+	ge.pendingChoices[playerID] = &pendingChoice{event: choiceEvent, effect: effect, ability: ability, payload: payload}
+	ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_CHOICE_REQUIRED, &model.EventPayload{
+		Payload: &model.EventPayload_ChoiceRequired{ChoiceRequired: choiceEvent},
+	})
+	ge.RequestAIAction(playerID)
+}
+
+// choosingPlayer 返回需要为效果做出选择的玩家：能力和卡牌的效果由执行操作的玩家选择；
+// 不由玩家操作引起的效果（例如事件）由主谋选择。
+func (ge *GameEngine) choosingPlayer() int32 {
+	if ge.actingPlayerID != 0 {
+		return ge.actingPlayerID
+	}
+	return ge.mastermindPlayerID
+}
+
+// answerChoice 移除玩家待回应的选择请求，并以玩家的选择继续应用等待选择的效果。
+// 回应在此之前已经由 checkAction 检查过。
+func (ge *GameEngine) answerChoice(player *model.Player, answer *model.ChooseOptionPayload) {
+	pending := ge.pendingChoices[player.Id]
+	delete(ge.pendingChoices, player.Id)
+	if pending == nil || pending.effect == nil {
+		return
+	}
+	ge.actingPlayerID = player.Id
+	defer func() { ge.actingPlayerID = 0 }()
+	if err := ge.ApplyEffect(pending.effect, pending.ability, pending.payload, answer); err != nil {
+		ge.logger.Error("Failed to apply effect after choice", zap.String("requestID", answer.GetRequestId()), zap.Error(err))
+	}
+}
+
+// ClearPendingChoices 丢弃所有待回应的选择请求。阶段管理器在阶段超时和离开阶段时调用，
+// 此后对这些请求的回应不再合法。
+func (ge *GameEngine) ClearPendingChoices() {
+	clear(ge.pendingChoices)
+}
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"testing"
+
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// choiceRequests 返回玩家视图中的选择请求。
+func choiceRequests(view *v1.PlayerView) []*v1.ChoiceRequiredEvent {
+	var requests []*v1.ChoiceRequiredEvent
+	for _, event := range view.GetPublicEvents() {
+		if req := event.GetPayload().GetChoiceRequired(); req != nil {
+			requests = append(requests, req)
+		}
+	}
+	return requests
+}
+
+func TestEngine_ChoiceRequestVisibleOnlyToChooser(t *testing.T) {
+	engine := helper_NewGameEngineForTest(t)
+	choices := []*v1.Choice{
+		{Id: "target_char_1", Value: &v1.Choice_CharacterId{CharacterId: 1}},
+		{Id: "target_char_2", Value: &v1.Choice_CharacterId{CharacterId: 2}},
+	}
+
+	// 能力的效果由使用能力的玩家选择。
+	engine.actingPlayerID = 2
+	engine.requireChoice(nil, nil, nil, choices)
+	engine.actingPlayerID = 0
+
+	requests := choiceRequests(engine.GeneratePlayerView(2))
+	require.Len(t, requests, 1)
+	assert.Equal(t, int32(2), requests[0].GetPlayerId())
+	assert.NotEmpty(t, requests[0].GetRequestId())
+	assert.Len(t, requests[0].GetChoices(), 2)
+	assert.Empty(t, choiceRequests(engine.GeneratePlayerView(1)))
+	assert.Empty(t, choiceRequests(engine.GeneratePlayerView(3)))
+
+	// 不由玩家操作引起的效果由主谋选择，每个请求有不同的 ID。
+	engine.requireChoice(nil, nil, nil, choices)
+	mastermindRequests := choiceRequests(engine.GeneratePlayerView(1))
+	require.Len(t, mastermindRequests, 1)
+	assert.Equal(t, int32(1), mastermindRequests[0].GetPlayerId())
+	assert.NotEqual(t, requests[0].GetRequestId(), mastermindRequests[0].GetRequestId())
+	assert.Len(t, choiceRequests(engine.GeneratePlayerView(2)), 1)
+}
+
+func chooseAction(requestID, optionID string) *v1.PlayerActionPayload {
+	return &v1.PlayerActionPayload{Payload: &v1.PlayerActionPayload_ChooseOption{
+		ChooseOption: &v1.ChooseOptionPayload{RequestId: requestID, ChosenOptionId: optionID},
+	}}
+}
+
+func TestEngine_ChooseOptionRoundTrip(t *testing.T) {
+	engine := helper_NewGameEngineForTest(t)
+	engine.Start()
+	defer engine.Stop()
+
+	choices := []*v1.Choice{{Id: "target_char_1"}, {Id: "target_char_2"}}
+	require.NoError(t, engine.runAdmin(func() error {
+		engine.actingPlayerID = 2
+		engine.requireChoice(nil, nil, nil, choices)
+		engine.actingPlayerID = 0
+		return nil
+	}))
+
+	// 有待回应的选择时只能回应它。
+	legal := engine.LegalActions(2)
+	require.Len(t, legal, 2)
+	requestID := legal[0].GetChooseOption().GetRequestId()
+	require.NotEmpty(t, requestID)
+	assert.Equal(t, "target_char_2", legal[1].GetChooseOption().GetChosenOptionId())
+	assert.NoError(t, engine.CheckAction(2, chooseAction(requestID, "target_char_2")))
+	assert.ErrorIs(t, engine.CheckAction(2, chooseAction(requestID, "target_char_3")), ErrIllegalAction)
+	assert.ErrorIs(t, engine.CheckAction(3, chooseAction(requestID, "target_char_2")), ErrIllegalAction, "only the chooser may answer")
+
+	engine.SubmitPlayerAction(2, chooseAction(requestID, "target_char_2"))
+	for _, action := range engine.LegalActions(2) {
+		assert.Nil(t, action.GetChooseOption(), "the answered choice is no longer pending")
+	}
+	assert.ErrorIs(t, engine.CheckAction(2, chooseAction(requestID, "target_char_2")), ErrIllegalAction, "a choice can only be answered once")
+}
+
+func TestEngine_PendingChoicesClearedOnPhaseChange(t *testing.T) {
+	engine := helper_NewGameEngineForTest(t)
+	engine.requireChoice(nil, nil, nil, []*v1.Choice{{Id: "target_char_1"}})
+	require.Len(t, engine.legalActions(engine.GetMastermindPlayer()), 1)
+
+	engine.ClearPendingChoices()
+	for _, action := range engine.legalActions(engine.GetMastermindPlayer()) {
+		assert.Nil(t, action.GetChooseOption())
+	}
+}
@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"github.com/constellation39/tragedyLooper/internal/game/ticker"
+
+	"go.uber.org/zap"
+)
+
+// DisconnectPolicy 决定人类玩家断开连接时引擎如何推进游戏。
+type DisconnectPolicy int
+
+const (
+	// DisconnectPolicyPause 在任一人类玩家断线时暂停游戏：时钟停止，阶段超时不会触发，
+	// 直到所有断线玩家重新连接。
+	DisconnectPolicyPause DisconnectPolicy = iota
+	// DisconnectPolicyTimeout 让游戏继续进行；断线玩家在宽限期内没有重连时，
+	// 对当前阶段应用超时处理，之后每个阶段都重新计算宽限期。
+	DisconnectPolicyTimeout
+)
+
+// DefaultDisconnectGraceTicks 是 DisconnectPolicyTimeout 的默认宽限期。
+const DefaultDisconnectGraceTicks = 30 * ticker.TicksPerSecond
+
+// setPlayerConnectedRequest is a request to update a player's connection state.
+type setPlayerConnectedRequest struct {
+	playerID  int32
+	connected bool
+}
+
+// SetDisconnectPolicy 设置断线策略。必须在 Start 之前调用。
+// graceTicks 仅用于 DisconnectPolicyTimeout，小于等于 0 时使用 DefaultDisconnectGraceTicks。
+func (ge *GameEngine) SetDisconnectPolicy(policy DisconnectPolicy, graceTicks int64) {
+	if graceTicks <= 0 {
+		graceTicks = DefaultDisconnectGraceTicks
+	}
+	ge.disconnectPolicy = policy
+	ge.disconnectGraceTicks = graceTicks
+}
+
+// SetPlayerConnected 通知引擎玩家的连接状态发生了变化。
+func (ge *GameEngine) SetPlayerConnected(playerID int32, connected bool) {
+	select {
+	case ge.engineChan <- &setPlayerConnectedRequest{playerID: playerID, connected: connected}:
+	case <-ge.stopChan:
+	}
+}
+
+// handleConnectionChange 更新玩家的连接状态，并重新计算暂停状态。
+// 此方法必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) handleConnectionChange(r *setPlayerConnectedRequest) {
+	player, ok := ge.GameState.Players[r.playerID]
+	if !ok || player.IsLlm {
+		return
+	}
+
+	if r.connected {
+		delete(ge.GameState.DisconnectedPlayers, r.playerID)
+		delete(ge.disconnectDeadlines, r.playerID)
+		ge.logger.Info("Player reconnected", zap.Int32("playerID", r.playerID))
+	} else {
+		ge.GameState.DisconnectedPlayers[r.playerID] = true
+		ge.disconnectDeadlines[r.playerID] = ge.GameState.Tick + ge.disconnectGraceTicks
+		ge.logger.Info("Player disconnected", zap.Int32("playerID", r.playerID), zap.Int("policy", int(ge.disconnectPolicy)))
+	}
+
+	ge.updatePaused()
+}
+
+// updatePaused 根据运维暂停和断线策略重新计算游戏是否暂停。
+// 此方法必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) updatePaused() {
+	paused := ge.adminPaused || (ge.disconnectPolicy == DisconnectPolicyPause && len(ge.GameState.DisconnectedPlayers) > 0)
+	if paused != ge.GameState.Paused {
+		ge.GameState.Paused = paused
+		ge.logger.Info("Game pause state changed", zap.Bool("paused", paused))
+	}
+}
+
+// applyDisconnectTimeouts 对宽限期已过的断线玩家应用当前阶段的超时处理。
+// 此方法必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) applyDisconnectTimeouts() {
+	if ge.disconnectPolicy != DisconnectPolicyTimeout {
+		return
+	}
+	for playerID, deadline := range ge.disconnectDeadlines {
+		if ge.GameState.Tick < deadline {
+			continue
+		}
+		ge.logger.Info("Disconnected player timed out, applying phase timeout",
+			zap.Int32("playerID", playerID), zap.String("phase", ge.GameState.CurrentPhase.String()))
+		// 每个阶段只触发一次超时：重置所有断线玩家的宽限期。
+		for id := range ge.disconnectDeadlines {
+			ge.disconnectDeadlines[id] = ge.GameState.Tick + ge.disconnectGraceTicks
+		}
+		ge.phaseManager.HandleTimeout()
+		return
+	}
+}
@@ -0,0 +1,57 @@
+package effecthandler
+
+import (
+	"fmt"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// init 函数在包加载时自动执行，注册 RevealRole 效果处理器。
+func init() {
+	Register[*model.Effect_RevealRole](&RevealRoleHandler{})
+}
+
+// RevealRoleHandler 实现处理 RevealRole 效果的逻辑。
+// RevealRole 效果将目标角色的隐藏身份公开给所有玩家。
+type RevealRoleHandler struct{}
+
+func (h *RevealRoleHandler) ResolveChoices(ge GameEngine, effect *model.Effect, ctx *EffectContext) ([]*model.Choice, error) {
+	revealRoleEffect := effect.GetRevealRole()
+	if revealRoleEffect == nil {
+		return nil, fmt.Errorf("effect is not of type RevealRole")
+	}
+	return CreateChoicesFromSelector(ge, revealRoleEffect.Target, ctx, "Select character to reveal")
+}
+
+func (h *RevealRoleHandler) Apply(ge GameEngine, effect *model.Effect, ctx *EffectContext) error {
+	revealRoleEffect := effect.GetRevealRole()
+	if revealRoleEffect == nil {
+		return fmt.Errorf("effect is not of type RevealRole")
+	}
+
+	state := ge.GetGameState()
+	targetIDs, err := ge.ResolveSelectorToCharacters(state, revealRoleEffect.Target, ctx)
+	if err != nil {
+		return err
+	}
+
+	// 为每个目标角色发布 RoleRevealed 事件，由事件处理器记录公开状态。
+	for _, targetID := range targetIDs {
+		char := ge.GetCharacterByID(targetID)
+		if char == nil {
+			continue
+		}
+		event := &model.RoleRevealedEvent{CharacterId: targetID, RoleId: char.HiddenRoleId}
+		ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_ROLE_REVEALED, &model.EventPayload{
+			Payload: &model.EventPayload_RoleRevealed{RoleRevealed: event},
+		})
+	}
+	return nil
+}
+
+func (h *RevealRoleHandler) GetDescription(effect *model.Effect) string {
+	if effect.GetRevealRole() == nil {
+		return "(Invalid RevealRole effect)"
+	}
+	return "Reveal role"
+}
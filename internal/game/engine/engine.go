@@ -2,7 +2,10 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/constellation39/tragedyLooper/internal/game/engine/ai"
@@ -12,14 +15,25 @@ import (
 	"github.com/constellation39/tragedyLooper/internal/game/engine/instantiator"
 	"github.com/constellation39/tragedyLooper/internal/game/engine/phasehandler"
 	"github.com/constellation39/tragedyLooper/internal/game/engine/target"
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
 	"github.com/constellation39/tragedyLooper/internal/game/loader"
 	"github.com/constellation39/tragedyLooper/internal/game/ticker"
 	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
 
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// MaxProtagonists 是一局游戏中主角玩家的最大数量。
+const MaxProtagonists = 3
+
+// DefaultAIActionTimeout 是 AI 玩家生成一次操作的默认期限，见 SetAIActionTimeout。
+const DefaultAIActionTimeout = 60 * time.Second
+
+// ErrEngineStopped 表示游戏引擎已经停止，runGameLoop 不再处理请求。
+var ErrEngineStopped = errors.New("game engine stopped")
+
 // engineAction 是一个空接口，用于标记所有可以发送到游戏引擎主循环的请求类型。
 type engineAction interface{}
 
@@ -33,6 +47,15 @@ type getPlayerViewRequest struct {
 type actionCompleteRequest struct {
 	playerID int32
 	action   *model.PlayerActionPayload
+	// fromAI 表示操作由 RequestAIAction 生成；aiEpoch 是请求发出时的 AI 请求代数，用于丢弃过期的结果。
+	fromAI  bool
+	aiEpoch uint64
+}
+
+// getSpectatorViewRequest is a request to get the view of a spectator without a seat.
+type getSpectatorViewRequest struct {
+	viewer       visibility.Viewer
+	responseChan chan *model.PlayerView
 }
 
 // getCurrentPhaseRequest is a request to safely get the current game phase.
@@ -40,6 +63,24 @@ type getCurrentPhaseRequest struct {
 	responseChan chan model.GamePhase
 }
 
+// getProgressRequest is a request to get the current phase, loop and day.
+type getProgressRequest struct {
+	responseChan chan Progress
+}
+
+// getEventLogRequest is a request to get the full, unredacted event history.
+type getEventLogRequest struct {
+	responseChan chan []*model.GameEvent
+}
+
+// Progress 是游戏进度的公开概要。
+type Progress struct {
+	Phase     model.GamePhase
+	Loop      int32
+	Day       int32
+	LoopCount int32
+}
+
 // GameEngine manages the state and logic of a single game instance.
 type GameEngine struct {
 	GameState *model.GameState
@@ -52,11 +93,59 @@ type GameEngine struct {
 
 	engineChan chan engineAction
 	stopChan   chan struct{}
+	stopOnce   sync.Once
+	doneChan   chan struct{} // 游戏主循环退出后关闭
 
 	playerReady map[int32]bool
 
 	mastermindPlayerID   int32
 	protagonistPlayerIDs []int32
+
+	visibility *visibility.Filter
+
+	// eventLog 是本局游戏的完整事件历史，playerEvents 是按玩家身份过滤后的事件历史。
+	// 两者都只在 runGameLoop goroutine 中追加。
+	eventLog     []*model.GameEvent
+	playerEvents map[int32][]*model.GameEvent
+	// spectatorEvents 是每种旁观者（见 visibility.SpectatorViewer）可见的事件历史，同样只在 runGameLoop goroutine 中追加。
+	spectatorEvents map[visibility.Viewer][]*model.GameEvent
+
+	// pendingChoices 是每个玩家待回应的选择请求，在玩家回应、新的请求到达或离开阶段前一直有效，见 LegalActions。
+	pendingChoices map[int32]*pendingChoice
+	// actingPlayerID 是正在处理其操作的玩家，操作之外为 0，见 choosingPlayer。choiceSeq 用于生成选择请求的 ID。
+	actingPlayerID int32
+	choiceSeq      int
+
+	// restored 表示引擎由 RestoreGameEngine 从快照恢复，启动时继续快照所在的阶段，见 resumeAIPlayers。
+	restored bool
+
+	// 断线处理，见 DisconnectPolicy。disconnectDeadlines 是断线玩家触发阶段超时的 tick。
+	disconnectPolicy     DisconnectPolicy
+	disconnectGraceTicks int64
+	disconnectDeadlines  map[int32]int64
+
+	// adminPaused 表示运维人员暂停了游戏，见 SetAdminPaused。
+	adminPaused bool
+
+	// AI 请求，见 RequestAIAction。aiCtx 在 Stop 时取消；aiPhaseCtx 是当前阶段发出的请求共用的上下文，
+	// 离开阶段时由 CancelAIRequests 取消，同时 aiEpoch 加一，之前发出的请求的结果将被丢弃。
+	// 除 aiCtx 和 aiStop 外只在 runGameLoop goroutine 中访问。
+	aiCtx           context.Context
+	aiStop          context.CancelFunc
+	aiPhaseCtx      context.Context
+	aiPhaseCancel   context.CancelFunc
+	aiEpoch         uint64
+	aiActionTimeout time.Duration
+
+	// 视图发布，见 publishViews。viewsDirty 表示本 tick 中状态可能发生了变化；publishedViews 是每个玩家
+	// 最新发布的视图，viewVersion 是最新发布的版本号。
+	viewChan       chan *ViewBatch
+	viewsDirty     bool
+	publishedPhase model.GamePhase
+	publishedViews map[int32]*PublishedView
+	viewVersion    uint64
+	// spectatorViews 是每种旁观者最新发布的视图。
+	spectatorViews map[visibility.Viewer]*model.PlayerView
 }
 
 // NewGameEngine creates a new game engine instance.
@@ -67,34 +156,86 @@ func NewGameEngine(logger *zap.Logger, players []*model.Player, actionGenerator
 		scriptConfig:         gameConfig,
 		engineChan:           make(chan engineAction, 100),
 		stopChan:             make(chan struct{}),
+		doneChan:             make(chan struct{}),
 		playerReady:          make(map[int32]bool),
 		mastermindPlayerID:   0,
 		protagonistPlayerIDs: nil,
+		visibility:           visibility.NewFilter(gameConfig),
+		playerEvents:         make(map[int32][]*model.GameEvent),
+		spectatorEvents: map[visibility.Viewer][]*model.GameEvent{
+			visibility.SpectatorViewer(false): nil,
+			visibility.SpectatorViewer(true):  nil,
+		},
+		pendingChoices:       make(map[int32]*pendingChoice),
+		disconnectPolicy:     DisconnectPolicyPause,
+		disconnectGraceTicks: DefaultDisconnectGraceTicks,
+		disconnectDeadlines:  make(map[int32]int64),
+		viewChan:             make(chan *ViewBatch, viewChanSize),
+		viewsDirty:           true,
+		publishedViews:       make(map[int32]*PublishedView),
+		spectatorViews:       make(map[visibility.Viewer]*model.PlayerView),
+		aiActionTimeout:      DefaultAIActionTimeout,
 	}
+	ge.aiCtx, ge.aiStop = context.WithCancel(context.Background())
 	ge.phaseManager = phasehandler.NewManager(ge)
 	ge.eventManager = eventhandler.NewManager(ge)
 	ge.GameState = instantiator.NewGameState(players, gameConfig)
+	if ge.GameState == nil {
+		return nil, fmt.Errorf("failed to instantiate game state: script or model missing")
+	}
+	for _, player := range players {
+		if err := ge.addPlayer(player); err != nil {
+			return nil, err
+		}
+	}
 
 	return ge, nil
 }
 
-func (ge *GameEngine) initializePlayers(players []*model.Player) map[int32]*model.Player {
-	playerMap := make(map[int32]*model.Player, len(players))
-	ge.protagonistPlayerIDs = make([]int32, 0, len(players)-1)
-
-	for _, player := range players {
-		switch player.Role {
-		case model.PlayerRole_PLAYER_ROLE_MASTERMIND:
-			ge.mastermindPlayerID = player.Id
-		case model.PlayerRole_PLAYER_ROLE_PROTAGONIST:
-			ge.protagonistPlayerIDs = append(ge.protagonistPlayerIDs, player.Id)
-		default:
-			ge.logger.Warn("Unknown player role", zap.Int32("playerID", player.Id))
+// addPlayer 将玩家加入游戏状态，并拒绝重复的玩家 ID 和身份冲突。
+// 玩家只能在创建引擎时加入，见 NewGameEngine。
+func (ge *GameEngine) addPlayer(player *model.Player) error {
+	if _, exists := ge.GameState.Players[player.Id]; exists {
+		return fmt.Errorf("player %d already exists", player.Id)
+	}
+	switch player.Role {
+	case model.PlayerRole_PLAYER_ROLE_MASTERMIND:
+		if mastermind := ge.GetMastermindPlayer(); mastermind != nil && mastermind.Role == model.PlayerRole_PLAYER_ROLE_MASTERMIND {
+			return fmt.Errorf("mastermind seat is already taken by player %d", ge.mastermindPlayerID)
 		}
+		ge.mastermindPlayerID = player.Id
+	case model.PlayerRole_PLAYER_ROLE_PROTAGONIST:
+		if len(ge.protagonistPlayerIDs) >= MaxProtagonists {
+			return fmt.Errorf("all %d protagonist seats are taken", MaxProtagonists)
+		}
+		ge.protagonistPlayerIDs = append(ge.protagonistPlayerIDs, player.Id)
+	default:
+		return fmt.Errorf("player %d has invalid role %s", player.Id, player.Role)
+	}
 
-		playerMap[player.Id] = player
+	if len(player.GetHand().GetCards()) == 0 {
+		player.Hand = instantiator.NewHand(player.Role, ge.scriptConfig)
+	}
+	if player.DeductionKnowledge == nil {
+		player.DeductionKnowledge = &model.PlayerDeductionKnowledge{}
 	}
-	return playerMap
+	ge.GameState.Players[player.Id] = player
+	return nil
+}
+
+// SetPhaseTimeouts 设置没有自定义超时的阶段的默认超时，未列出的阶段不超时。必须在 Start 之前调用。
+func (ge *GameEngine) SetPhaseTimeouts(timeouts map[model.GamePhase]time.Duration) {
+	ticks := make(map[model.GamePhase]int64, len(timeouts))
+	for phase, d := range timeouts {
+		ticks[phase] = ticker.FromDuration(d)
+	}
+	ge.phaseManager.SetDefaultTimeouts(ticks)
+}
+
+// SetAIActionTimeout 设置 AI 玩家生成一次操作的期限，超过期限时引擎代为提交跳过操作；0 表示不限制。
+// 必须在 Start 之前调用。
+func (ge *GameEngine) SetAIActionTimeout(d time.Duration) {
+	ge.aiActionTimeout = d
 }
 
 // Start 启动游戏主循环。
@@ -102,9 +243,17 @@ func (ge *GameEngine) Start() {
 	go ge.runGameLoop()
 }
 
-// Stop 停止游戏主循环。
+// Stop 停止游戏主循环。可以重复调用。
 func (ge *GameEngine) Stop() {
-	close(ge.stopChan)
+	ge.stopOnce.Do(func() {
+		close(ge.stopChan)
+		ge.aiStop()
+	})
+}
+
+// Done 返回一个在游戏主循环退出、事件通道关闭后关闭的通道。
+func (ge *GameEngine) Done() <-chan struct{} {
+	return ge.doneChan
 }
 
 // SubmitPlayerAction 提交玩家操作到游戏引擎。
@@ -116,6 +265,7 @@ func (ge *GameEngine) SubmitPlayerAction(playerID int32, action *model.PlayerAct
 	select {
 	case ge.engineChan <- &actionCompleteRequest{playerID: playerID, action: action}:
 	default:
+		actionsDropped.Inc()
 		ge.logger.Warn("Request channel full, dropping action", zap.Int32("playerID", playerID))
 	}
 }
@@ -125,7 +275,7 @@ func (ge *GameEngine) GetGameEvents() <-chan *model.GameEvent {
 	return ge.eventManager.EventsChannel()
 }
 
-// GetPlayerView 获取指定玩家的游戏状态视图。
+// GetPlayerView 获取指定玩家的游戏状态视图。引擎停止后返回 nil。
 func (ge *GameEngine) GetPlayerView(playerID int32) *model.PlayerView {
 	responseChan := make(chan *model.PlayerView)
 	req := &getPlayerViewRequest{
@@ -133,19 +283,94 @@ func (ge *GameEngine) GetPlayerView(playerID int32) *model.PlayerView {
 		responseChan: responseChan,
 	}
 
-	ge.engineChan <- req
-	view := <-responseChan
-	return view
+	select {
+	case ge.engineChan <- req:
+	case <-ge.stopChan:
+		return nil
+	}
+	select {
+	case view := <-responseChan:
+		return view
+	case <-ge.stopChan:
+		return nil
+	}
 }
 
-// GetCurrentPhase 安全地从引擎获取当前游戏阶段。
+// GetSpectatorView 获取旁观者的游戏状态视图，见 visibility.SpectatorViewer。引擎停止后返回 nil。
+func (ge *GameEngine) GetSpectatorView(v visibility.Viewer) *model.PlayerView {
+	responseChan := make(chan *model.PlayerView)
+	req := &getSpectatorViewRequest{
+		viewer:       v,
+		responseChan: responseChan,
+	}
+	select {
+	case ge.engineChan <- req:
+	case <-ge.stopChan:
+		return nil
+	}
+	select {
+	case view := <-responseChan:
+		return view
+	case <-ge.stopChan:
+		return nil
+	}
+}
+
+// GetCurrentPhase 安全地从引擎获取当前游戏阶段。引擎停止后返回 GAME_PHASE_UNSPECIFIED。
 func (ge *GameEngine) GetCurrentPhase() model.GamePhase {
 	responseChan := make(chan model.GamePhase)
 	req := &getCurrentPhaseRequest{
 		responseChan: responseChan,
 	}
-	ge.engineChan <- req
-	return <-responseChan
+	select {
+	case ge.engineChan <- req:
+	case <-ge.stopChan:
+		return model.GamePhase_GAME_PHASE_UNSPECIFIED
+	}
+	select {
+	case phase := <-responseChan:
+		return phase
+	case <-ge.stopChan:
+		return model.GamePhase_GAME_PHASE_UNSPECIFIED
+	}
+}
+
+// GetProgress 获取当前阶段、循环和天数。引擎停止后返回零值。
+func (ge *GameEngine) GetProgress() Progress {
+	responseChan := make(chan Progress)
+	select {
+	case ge.engineChan <- &getProgressRequest{responseChan: responseChan}:
+	case <-ge.stopChan:
+		return Progress{}
+	}
+	select {
+	case progress := <-responseChan:
+		return progress
+	case <-ge.stopChan:
+		return Progress{}
+	}
+}
+
+// GetEventLog 获取本局游戏未经过滤的完整事件历史，包含所有隐藏信息。引擎停止后返回 nil。
+func (ge *GameEngine) GetEventLog() []*model.GameEvent {
+	responseChan := make(chan []*model.GameEvent)
+	select {
+	case ge.engineChan <- &getEventLogRequest{responseChan: responseChan}:
+	case <-ge.stopChan:
+		return nil
+	}
+	select {
+	case events := <-responseChan:
+		return events
+	case <-ge.stopChan:
+		return nil
+	}
+}
+
+// RedactEvent 返回观察者可见的事件副本；如果事件对观察者完全不可见则返回 nil。
+// 过滤器不持有可变状态，因此可以在任意 goroutine 中调用。
+func (ge *GameEngine) RedactEvent(v visibility.Viewer, event *model.GameEvent) *model.GameEvent {
+	return ge.visibility.Event(v, event)
 }
 
 // runGameLoop 是游戏引擎的核心。它是一个单线程循环，按顺序处理所有游戏事件
@@ -153,9 +378,14 @@ func (ge *GameEngine) GetCurrentPhase() model.GamePhase {
 func (ge *GameEngine) runGameLoop() {
 	ge.logger.Info("Game loop started.")
 	defer ge.logger.Info("Game loop stopped.")
+	defer close(ge.doneChan)
+	defer close(ge.viewChan)
 
 	// 阶段管理器已启动，它将启动第一个阶段转换。
 	ge.phaseManager.Start()
+	if ge.restored {
+		ge.resumeAIPlayers()
+	}
 	ge.ResetPlayerReadiness()
 	defer ge.eventManager.Close()
 
@@ -168,9 +398,17 @@ func (ge *GameEngine) runGameLoop() {
 		case <-ge.stopChan:
 			return
 		case <-ticker.C:
+			if ge.GameState.Paused {
+				// 暂停时时钟停止，但仍需处理请求（例如视图查询和重连）。
+				ge.processPendingRequests()
+				ge.publishViews()
+				continue
+			}
 			ge.GameState.Tick++
 			ge.processPendingRequests()
 			ge.phaseManager.OnTick()
+			ge.applyDisconnectTimeouts()
+			ge.publishViews()
 		}
 	}
 }
@@ -197,14 +435,67 @@ func (ge *GameEngine) handleEngineRequest(req engineAction) {
 			ge.logger.Warn("Action from unknown player", zap.Int32("playerID", r.playerID))
 			return
 		}
+		if r.fromAI && r.aiEpoch != ge.aiEpoch {
+			aiActionsStale.Inc()
+			ge.logger.Info("Discarding AI action requested in an earlier phase", zap.Int32("playerID", r.playerID))
+			return
+		}
+		if err := ge.checkAction(player, r.action); err != nil {
+			fallback := defaultAction(ge.legalActions(player))
+			if !r.fromAI || fallback == nil {
+				actionsRejected.Inc()
+				ge.logger.Warn("Rejecting illegal action", zap.Int32("playerID", r.playerID), zap.Error(err))
+				return
+			}
+			ge.logger.Warn("AI chose an illegal action, taking the default action instead", zap.Int32("playerID", r.playerID), zap.Error(err))
+			r.action = fallback
+		}
 		ge.SetPlayerReady(r.playerID)
-		if ge.phaseManager.HandleAction(player, r.action) == phasehandler.PhaseComplete {
+		ge.markViewsDirty()
+		if answer := r.action.GetChooseOption(); answer != nil {
+			// 选择请求由引擎而不是阶段处理。
+			ge.answerChoice(player, answer)
+			return
+		}
+		ge.actingPlayerID = r.playerID
+		state := ge.phaseManager.HandleAction(player, r.action)
+		ge.actingPlayerID = 0
+		if state == phasehandler.PhaseComplete {
 			ge.phaseManager.Advance()
 		}
 	case *getPlayerViewRequest:
 		r.responseChan <- ge.GeneratePlayerView(r.playerID)
+	case *legalActionsRequest:
+		var actions []*model.PlayerActionPayload
+		if player, ok := ge.GameState.Players[r.playerID]; ok {
+			actions = ge.legalActions(player)
+		}
+		r.responseChan <- actions
+	case *getSpectatorViewRequest:
+		r.responseChan <- ge.GenerateSpectatorView(r.viewer)
+	case *getPublishedViewRequest:
+		r.responseChan <- ge.publishedViews[r.playerID]
 	case *getCurrentPhaseRequest:
 		r.responseChan <- ge.phaseManager.CurrentPhase().Type()
+	case *setPlayerConnectedRequest:
+		ge.handleConnectionChange(r)
+		ge.markViewsDirty()
+	case *getProgressRequest:
+		r.responseChan <- Progress{
+			Phase:     ge.GameState.CurrentPhase,
+			Loop:      ge.GameState.CurrentLoop,
+			Day:       ge.GameState.CurrentDay,
+			LoopCount: ge.GameState.LoopCount,
+		}
+	case *getEventLogRequest:
+		r.responseChan <- slices.Clone(ge.eventLog)
+	case *getSnapshotRequest:
+		r.responseChan <- proto.Clone(ge.GameState).(*model.GameState)
+	case *postChatRequest:
+		r.responseChan <- ge.handlePostChat(r)
+	case *adminRequest:
+		r.responseChan <- r.apply()
+		ge.markViewsDirty()
 	default:
 		ge.logger.Warn("Unhandled request type in engine channel")
 	}
@@ -215,6 +506,8 @@ func (ge *GameEngine) TriggerEvent(eventType model.GameEventType, payload *model
 		Type:      eventType,
 		Timestamp: timestamppb.Now(),
 		Payload:   payload,
+		Loop:      ge.GameState.CurrentLoop,
+		Day:       ge.GameState.CurrentDay,
 	}
 
 	// Step 1: Apply the event to the game state through the appropriate handler.
@@ -235,11 +528,28 @@ func (ge *GameEngine) TriggerEvent(eventType model.GameEventType, payload *model
 	gs := ge.GetGameState()
 	gs.DayEvents = append(gs.DayEvents, event)
 	gs.LoopEvents = append(gs.LoopEvents, event)
+	ge.recordEvent(event)
 
 	// Step 4: Publish the event to external listeners.
 	ge.eventManager.Dispatch(event)
 }
 
+// recordEvent 将事件追加到完整历史，并为每个玩家追加其可见的版本。
+func (ge *GameEngine) recordEvent(event *model.GameEvent) {
+	ge.markViewsDirty()
+	ge.eventLog = append(ge.eventLog, event)
+	for playerID, player := range ge.GameState.Players {
+		if redacted := ge.visibility.Event(visibility.ViewerFor(player), event); redacted != nil {
+			ge.playerEvents[playerID] = append(ge.playerEvents[playerID], redacted)
+		}
+	}
+	for v, events := range ge.spectatorEvents {
+		if redacted := ge.visibility.Event(v, event); redacted != nil {
+			ge.spectatorEvents[v] = append(events, redacted)
+		}
+	}
+}
+
 // ResetPlayerReadiness 重置所有玩家的准备状态。
 func (ge *GameEngine) ResetPlayerReadiness() {
 	for playerID := range ge.GameState.Players {
@@ -347,10 +657,7 @@ func (ge *GameEngine) ApplyEffect(effect *model.Effect, ability *model.Ability,
 	}
 
 	if len(choices) > 0 && choice == nil {
-		choiceEvent := &model.ChoiceRequiredEvent{Choices: choices}
-		ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_CHOICE_REQUIRED, &model.EventPayload{
-			Payload: &model.EventPayload_ChoiceRequired{ChoiceRequired: choiceEvent},
-		})
+		ge.requireChoice(effect, ability, payload, choices)
 		return nil // 停止处理，直到做出选择
 	}
 
@@ -364,97 +671,99 @@ func (ge *GameEngine) ApplyEffect(effect *model.Effect, ability *model.Ability,
 }
 
 // RequestAIAction 请求 AI 玩家做出决定。
+// 请求在离开当前阶段或引擎停止时被取消；超过 AI 操作期限或生成失败时，引擎代为提交跳过操作以免阻塞游戏。
 func (ge *GameEngine) RequestAIAction(playerID int32) {
 	player := ge.getPlayerByID(playerID)
 	if player == nil || !player.IsLlm { // TODO: 使此检查更通用（例如，IsAI）
 		return
 	}
 
+	if ge.actionGenerator == nil {
+		ge.logger.Warn("No action generator configured, AI player cannot act", zap.String("player", player.Name))
+		return
+	}
+
 	ge.logger.Info("Triggering AI for player", zap.String("player", player.Name))
 
 	// 为动作生成器创建上下文
-	ctx := &ai.ActionGeneratorContext{
-		Player:        player,
-		PlayerView:    ge.GeneratePlayerView(playerID),
-		AllCharacters: ge.GameState.Characters,
+	// 生成在另一个 goroutine 中进行，因此传入玩家的副本。
+	data := &ai.ActionGeneratorContext{
+		Player:     proto.Clone(player).(*model.Player),
+		PlayerView: ge.GeneratePlayerView(playerID),
 	}
 
+	if ge.aiPhaseCtx == nil {
+		ge.aiPhaseCtx, ge.aiPhaseCancel = context.WithCancel(ge.aiCtx)
+	}
+	ctx, cancel := ge.aiPhaseCtx, context.CancelFunc(func() {})
+	if ge.aiActionTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, ge.aiActionTimeout)
+	}
+	epoch := ge.aiEpoch
+
 	go func() {
-		action, err := ge.actionGenerator.GenerateAction(context.Background(), ctx)
+		defer cancel()
+		start := time.Now()
+		action, err := ge.actionGenerator.GenerateAction(ctx, data)
+		aiActionDuration.Observe(time.Since(start).Seconds())
 		if err != nil {
-			ge.logger.Error("AI action generation failed", zap.String("player", player.Name), zap.Error(err))
-			// 提交默认操作以解锁游戏
-			ge.engineChan <- &actionCompleteRequest{
-				playerID: playerID,
-				action:   &model.PlayerActionPayload{},
+			if errors.Is(ctx.Err(), context.Canceled) {
+				// 阶段已经结束或引擎已经停止，没有人在等待这个操作。
+				ge.logger.Info("AI action request canceled", zap.String("player", player.Name))
+				return
 			}
-			return
+			aiActionFailures.Inc()
+			ge.logger.Error("AI action generation failed", zap.String("player", player.Name), zap.Error(err))
+			// 提交跳过操作以解锁游戏
+			action = &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PassTurn{PassTurn: &model.PassTurnAction{}}}
 		}
 
-		// 将经过验证的操作发送回主循环进行处理。
-		ge.engineChan <- &actionCompleteRequest{
-			playerID: playerID,
-			action:   action,
+		// 将操作发送回主循环进行处理。
+		select {
+		case ge.engineChan <- &actionCompleteRequest{playerID: playerID, action: action, fromAI: true, aiEpoch: epoch}:
+		case <-ge.stopChan:
 		}
 	}()
 }
 
-// GeneratePlayerView 为特定玩家创建游戏状态的过滤视图。
+// CancelAIRequests 取消当前阶段发出的所有 AI 请求，之后到达的结果将被丢弃。阶段管理器在离开阶段时调用。
+func (ge *GameEngine) CancelAIRequests() {
+	if ge.aiPhaseCancel != nil {
+		ge.aiPhaseCancel()
+		ge.aiPhaseCtx, ge.aiPhaseCancel = nil, nil
+	}
+	ge.aiEpoch++
+}
+
+// GeneratePlayerView 为特定玩家创建游戏状态的过滤视图，包括玩家当前可以执行的操作。
+// 过滤规则见 visibility.Policy。视图不与游戏状态共享可变数据，可以交给其他 goroutine 读取；
+// 事件历史只会追加，因此视图与引擎共享已有的事件，不必复制。
 // 此方法不是线程安全的，必须仅在 runGameLoop goroutine 中调用。
 func (ge *GameEngine) GeneratePlayerView(playerID int32) *model.PlayerView {
 	player := ge.GameState.Players[playerID]
 	if player == nil {
 		return &model.PlayerView{}
 	}
+	view := ge.visibility.View(visibility.ViewerFor(player), ge.GameState)
+	events := ge.playerEvents[playerID]
+	view.PublicEvents = events[:len(events):len(events)]
+	view.LegalActions = ge.legalActions(player)
+	return view
+}
 
-	view := &model.PlayerView{
-		GameId:         ge.GameState.GameId,
-		Tick:           ge.GameState.Tick,
-		CurrentLoop:    ge.GameState.CurrentLoop,
-		CurrentDay:     ge.GameState.CurrentDay,
-		CurrentPhase:   ge.GameState.CurrentPhase,
-		Characters:     map[int32]*model.PlayerViewCharacter{},
-		Players:        make(map[int32]*model.PlayerViewPlayer),
-		YourHand:       nil,
-		YourDeductions: nil,
-	}
-
-	// Filter character information based on player role
-	view.Characters = make(map[int32]*model.PlayerViewCharacter, len(ge.GameState.Characters))
-	for id, char := range ge.GameState.Characters {
-		playerViewChar := &model.PlayerViewCharacter{
-			Id:              id,
-			Name:            char.Config.Name,
-			Traits:          char.Traits,
-			CurrentLocation: char.CurrentLocation,
-			Stats:           map[int32]int32{},
-			Abilities:       char.Abilities,
-			IsAlive:         char.IsAlive,
-			InPanicMode:     char.InPanicMode,
-			Rules:           char.Config.Rules,
-			RevealedRole:    0,
-		}
-		if player.Role == model.PlayerRole_PLAYER_ROLE_PROTAGONIST {
-			// Hide the true role from protagonists, show as unknown.
-		}
-		view.Characters[id] = playerViewChar
+// GenerateSpectatorView 为没有席位的旁观者创建游戏状态的过滤视图，与 GeneratePlayerView 一样不共享可变数据。
+// visibility.SpectatorViewer 的事件历史随事件记录，其他观察者的事件历史在请求时从完整事件日志中按其身份过滤。
+// 此方法不是线程安全的，必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) GenerateSpectatorView(v visibility.Viewer) *model.PlayerView {
+	view := ge.visibility.View(v, ge.GameState)
+	if events, ok := ge.spectatorEvents[v]; ok {
+		view.PublicEvents = events[:len(events):len(events)]
+		return view
 	}
-
-	// Filter player information
-	view.Players = make(map[int32]*model.PlayerViewPlayer, len(ge.GameState.Players))
-	for id, p := range ge.GameState.Players {
-		view.Players[id] = &model.PlayerViewPlayer{
-			Id:   id,
-			Name: p.Name,
-			Role: p.Role,
+	for _, event := range ge.eventLog {
+		if redacted := ge.visibility.Event(v, event); redacted != nil {
+			view.PublicEvents = append(view.PublicEvents, redacted)
 		}
 	}
-
-	// Add player-specific information
-	view.YourHand = player.Hand.Cards
-	if player.Role == model.PlayerRole_PLAYER_ROLE_PROTAGONIST {
-		view.YourDeductions = player.DeductionKnowledge
-	}
-
 	return view
 }
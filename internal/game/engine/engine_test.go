@@ -11,30 +11,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func helper_NewGameEngineForTest(t *testing.T) *GameEngine {
-	t.Helper()
-
-	log := logger.New()
-
-	gameConfig, err := loader.LoadConfig("../../../data", "first_steps")
-	if err != nil {
-		t.Fatalf("failed to load game data: %v", err)
-	}
-
-	players := []*v1.Player{
-		{Id: 1, Name: "Mastermind", Role: v1.PlayerRole_PLAYER_ROLE_MASTERMIND, IsLlm: false},
-		{Id: 2, Name: "Protagonist 1", Role: v1.PlayerRole_PLAYER_ROLE_PROTAGONIST, IsLlm: false},
-		{Id: 3, Name: "Protagonist 2", Role: v1.PlayerRole_PLAYER_ROLE_PROTAGONIST, IsLlm: false},
-	}
-
-	engine, err := NewGameEngine(log, players, nil, gameConfig) // AI is not needed for these tests
-	if err != nil {
-		t.Fatalf("failed to create game engine: %v", err)
-	}
-
-	return engine
-}
-
 // TestEngine_Integration_CardPlayAndIncidentTrigger 验证整个数据流：
 // 1. 从 JSON 文件加载游戏数据。
 // 2. 初始化引擎。
@@ -222,3 +198,23 @@ func waitForEvent(t *testing.T, engine *GameEngine, targetEvent v1.GameEventType
 		}
 	}
 }
+
+func TestEngine_RestoreFromSnapshot(t *testing.T) {
+	original := helper_NewGameEngineForTest(t)
+	original.GameState.CurrentPhase = v1.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY
+	original.GameState.CurrentLoop = 2
+	event := &v1.GameEvent{Type: v1.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED}
+
+	restored, err := RestoreGameEngine(logger.New(), original.GameState, []*v1.GameEvent{event}, nil, original.scriptConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), restored.GameState.CurrentLoop)
+	assert.Equal(t, v1.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY, restored.phaseManager.CurrentPhase().Type())
+	assert.Equal(t, int32(1), restored.GetMastermindPlayer().Id)
+	assert.Len(t, restored.GetProtagonistPlayers(), 2)
+	assert.Len(t, restored.eventLog, 1)
+	assert.Len(t, restored.playerEvents[2], 1)
+	assert.NotSame(t, original.GameState, restored.GameState, "restored engine must not share state with the snapshot")
+
+	_, err = RestoreGameEngine(logger.New(), nil, nil, nil, original.scriptConfig)
+	assert.Error(t, err)
+}
@@ -1,11 +1,19 @@
 package eventhandler
 
 import (
+	"github.com/constellation39/tragedyLooper/internal/metrics"
 	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
 
 	"go.uber.org/zap"
 )
 
+var (
+	eventsDispatched = metrics.Default.CounterVec("tragedylooper_events_dispatched_total",
+		"Game events published to listeners, by event type.", "type")
+	eventsDropped = metrics.Default.CounterVec("tragedylooper_events_dropped_total",
+		"Game events dropped because the dispatch channel was full, by event type.", "type")
+)
+
 // Manager is responsible for creating, processing, and dispatching all game events.
 // It decouples the event lifecycle from the main GameEngine, ensuring a clear and maintainable flow.
 type Manager struct {
@@ -40,8 +48,9 @@ func (em *Manager) ApplyEvent(event *model.GameEvent) error {
 func (em *Manager) Dispatch(event *model.GameEvent) {
 	select {
 	case em.dispatchGameEvent <- event:
-		// Event successfully dispatched.
+		eventsDispatched.WithLabelValues(event.Type.String()).Inc()
 	default:
+		eventsDropped.WithLabelValues(event.Type.String()).Inc()
 		em.logger.Warn("Game event channel full, dropping event", zap.String("eventType", event.Type.String()))
 	}
 }
@@ -0,0 +1,27 @@
+package eventhandler
+
+import (
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+func init() {
+	Register(model.GameEventType_GAME_EVENT_TYPE_ROLE_REVEALED, &RoleRevealedHandler{})
+}
+
+// RoleRevealedHandler 处理 RoleRevealedEvent。
+type RoleRevealedHandler struct{}
+
+// Handle 将角色标记为身份已公开，此后所有玩家的视图都会显示其身份。
+func (h *RoleRevealedHandler) Handle(ge GameEngine, event *model.GameEvent) error {
+	e, ok := event.Payload.Payload.(*model.EventPayload_RoleRevealed)
+	if !ok {
+		return nil
+	}
+
+	state := ge.GetGameState()
+	if state.RevealedRoles == nil {
+		state.RevealedRoles = make(map[int32]bool)
+	}
+	state.RevealedRoles[e.RoleRevealed.CharacterId] = true
+	return nil
+}
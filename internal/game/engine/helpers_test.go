@@ -0,0 +1,34 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// helper_NewGameEngineForTest 创建一个有一名主谋（ID 1）和两名主角（ID 2、3）的游戏引擎，引擎尚未启动。
+func helper_NewGameEngineForTest(t *testing.T) *GameEngine {
+	t.Helper()
+
+	log := logger.New()
+
+	gameConfig, err := loader.LoadConfig("../../../data", "basic_tragedy_x", 101)
+	if err != nil {
+		t.Fatalf("failed to load game data: %v", err)
+	}
+
+	players := []*v1.Player{
+		{Id: 1, Name: "Mastermind", Role: v1.PlayerRole_PLAYER_ROLE_MASTERMIND, IsLlm: false},
+		{Id: 2, Name: "Protagonist 1", Role: v1.PlayerRole_PLAYER_ROLE_PROTAGONIST, IsLlm: false},
+		{Id: 3, Name: "Protagonist 2", Role: v1.PlayerRole_PLAYER_ROLE_PROTAGONIST, IsLlm: false},
+	}
+
+	engine, err := NewGameEngine(log, players, nil, gameConfig) // AI is not needed for these tests
+	if err != nil {
+		t.Fatalf("failed to create game engine: %v", err)
+	}
+
+	return engine
+}
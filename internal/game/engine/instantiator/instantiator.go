@@ -30,24 +30,43 @@ func NewGameState(players []*pb.Player, gameConfig loader.ScriptConfig) *pb.Game
 	}
 
 	for _, player := range players {
-		switch player.Role {
-		case pb.PlayerRole_PLAYER_ROLE_PROTAGONIST:
-			player.Hand = &pb.CardList{Cards: newCardsFromConfig(script.ProtagonistCards)}
-		case pb.PlayerRole_PLAYER_ROLE_MASTERMIND:
-			player.Hand = &pb.CardList{Cards: newCardsFromConfig(script.MastermindCards)}
+		if hand := NewHand(player.Role, gameConfig); hand != nil {
+			player.Hand = hand
 		}
 	}
 
 	return &pb.GameState{
-		GameId:             uuid.New().String(),
-		Tick:               0,
-		CurrentLoop:        1, // Game starts on Loop 1
-		DaysPerLoop:        model.PublicConfig.DaysPerLoop,
-		CurrentDay:         0, // Starts before Day 1 begins
-		CurrentPhase:       pb.GamePhase_GAME_PHASE_SETUP,
-		Characters:         characters,
-		Players:            make(map[int32]*pb.Player), // Players will be added later
-		TriggeredIncidents: make(map[int32]bool),
+		GameId:              uuid.New().String(),
+		Tick:                0,
+		CurrentLoop:         1, // Game starts on Loop 1
+		DaysPerLoop:         model.PublicConfig.DaysPerLoop,
+		LoopCount:           gameConfig.GetLoopCount(),
+		DifficultySet:       gameConfig.GetDifficultySet(),
+		CurrentDay:          0, // Starts before Day 1 begins
+		CurrentPhase:        pb.GamePhase_GAME_PHASE_SETUP,
+		Characters:          characters,
+		Players:             make(map[int32]*pb.Player), // Players will be added later
+		TriggeredIncidents:  make(map[int32]bool),
+		PlayedCardsThisDay:  make(map[int32]*pb.CardList),
+		PlayedCardsThisLoop: make(map[int32]bool),
+		RevealedRoles:       make(map[int32]bool),
+		DisconnectedPlayers: make(map[int32]bool),
+	}
+}
+
+// NewHand creates the starting hand for a player of the given role, or nil if the role has no cards.
+func NewHand(role pb.PlayerRole, gameConfig loader.ScriptConfig) *pb.CardList {
+	script := gameConfig.GetScript()
+	if script == nil {
+		return nil
+	}
+	switch role {
+	case pb.PlayerRole_PLAYER_ROLE_PROTAGONIST:
+		return &pb.CardList{Cards: newCardsFromConfig(script.ProtagonistCards)}
+	case pb.PlayerRole_PLAYER_ROLE_MASTERMIND:
+		return &pb.CardList{Cards: newCardsFromConfig(script.MastermindCards)}
+	default:
+		return nil
 	}
 }
 
@@ -0,0 +1,240 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine/phasehandler"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrIllegalAction 表示玩家在当前阶段不能执行该操作。
+var ErrIllegalAction = errors.New("illegal action")
+
+// legalActionsRequest is a request to list the actions a player can take now.
+type legalActionsRequest struct {
+	playerID     int32
+	responseChan chan []*model.PlayerActionPayload
+}
+
+// LegalActions 返回玩家当前可以执行的操作模板，按以下顺序排列：
+//   - 待回应的选择请求的每个选项（ChooseOption）；有待回应的选择时只能回应它；
+//   - 手牌中每张可以打出的卡牌与每个合法目标的组合（PlayCard，目标在 PreChosenOptions 的
+//     phasehandler.CardTargetRequestID 键中）；
+//   - 每个可以使用的能力（UseAbility），需要选择目标的能力在使用后通过 ChoiceRequiredEvent 询问；
+//   - 猜测（MakeGuess），GuessedRoles 列出所有角色，身份 ID 由玩家填写；
+//   - 跳过（PassTurn）。
+//
+// 不轮到该玩家时返回空列表，引擎停止后返回 nil。玩家视图的 legal_actions 字段包含同样的内容。
+func (ge *GameEngine) LegalActions(playerID int32) []*model.PlayerActionPayload {
+	responseChan := make(chan []*model.PlayerActionPayload)
+	select {
+	case ge.engineChan <- &legalActionsRequest{playerID: playerID, responseChan: responseChan}:
+	case <-ge.stopChan:
+		return nil
+	}
+	select {
+	case actions := <-responseChan:
+		return actions
+	case <-ge.stopChan:
+		return nil
+	}
+}
+
+// CheckAction 检查玩家现在能否执行该操作，不能时返回包装了 ErrIllegalAction 的错误，引擎停止后返回 ErrEngineStopped。
+// 引擎在处理操作时还会再次检查，因此在两次检查之间阶段发生变化的操作仍会被拒绝。
+func (ge *GameEngine) CheckAction(playerID int32, action *model.PlayerActionPayload) error {
+	legal := ge.LegalActions(playerID)
+	select {
+	case <-ge.stopChan:
+		return ErrEngineStopped
+	default:
+	}
+	for _, l := range legal {
+		if actionMatches(l, action) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: cannot %s now", ErrIllegalAction, actionName(action))
+}
+
+// legalActions 返回玩家当前可以执行的操作模板，见 LegalActions。
+// 此方法不是线程安全的，必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) legalActions(player *model.Player) []*model.PlayerActionPayload {
+	if pending := ge.pendingChoices[player.Id]; pending != nil {
+		req := pending.event
+		actions := make([]*model.PlayerActionPayload, 0, len(req.GetChoices()))
+		for _, choice := range req.GetChoices() {
+			actions = append(actions, &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_ChooseOption{
+				ChooseOption: &model.ChooseOptionPayload{RequestId: req.GetRequestId(), ChosenOptionId: choice.GetId()},
+			}})
+		}
+		return actions
+	}
+
+	var actions []*model.PlayerActionPayload
+	kinds := ge.phaseManager.AllowedActions(player)
+	for _, kind := range kinds {
+		switch kind {
+		case phasehandler.ActionPlayCard:
+			actions = append(actions, ge.cardActions(player)...)
+		case phasehandler.ActionUseAbility:
+			actions = append(actions, ge.abilityActions(player)...)
+		case phasehandler.ActionMakeGuess:
+			guess := &model.MakeGuessPayload{GuessedRoles: make(map[int32]int32, len(ge.GameState.Characters))}
+			for id := range ge.GameState.Characters {
+				guess.GuessedRoles[id] = 0
+			}
+			actions = append(actions, &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_MakeGuess{MakeGuess: guess}})
+		}
+	}
+	// 跳过总是最后一个选项。
+	if slices.Contains(kinds, phasehandler.ActionPass) {
+		actions = append(actions, &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PassTurn{PassTurn: &model.PassTurnAction{}}})
+	}
+	return actions
+}
+
+// cardActions 返回玩家手牌中每张可以打出的卡牌与每个合法目标的组合。
+// 每循环只能打出一次的卡牌在本循环打出过之后不能再打出。
+func (ge *GameEngine) cardActions(player *model.Player) []*model.PlayerActionPayload {
+	var actions []*model.PlayerActionPayload
+	for _, card := range player.GetHand().GetCards() {
+		id := card.GetConfig().GetId()
+		if card.GetConfig().GetOncePerLoop() && ge.GameState.PlayedCardsThisLoop[id] {
+			continue
+		}
+		for _, target := range phasehandler.CardTargets(ge, player, card) {
+			actions = append(actions, &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PlayCard{PlayCard: &model.PlayCardPayload{
+				CardId:           id,
+				PreChosenOptions: map[string]string{phasehandler.CardTargetRequestID: target.GetId()},
+			}}})
+		}
+	}
+	return actions
+}
+
+// abilityActions 返回玩家现在可以使用的角色能力。主谋使用没有好感等级要求的主动能力；
+// 主角使用好感等级要求已经达到的好感能力。能力的拥有者必须存活、位于能力允许的地点，
+// 每循环一次的能力在本循环中不能已经使用过。
+func (ge *GameEngine) abilityActions(player *model.Player) []*model.PlayerActionPayload {
+	ids := make([]int32, 0, len(ge.GameState.Characters))
+	for id := range ge.GameState.Characters {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	var actions []*model.PlayerActionPayload
+	for _, charID := range ids {
+		char := ge.GameState.Characters[charID]
+		if !char.GetIsAlive() {
+			continue
+		}
+		for _, ability := range char.GetAbilities() {
+			config := ability.GetConfig()
+			if config.GetAbilityType() != model.TriggerType_ABILITY_TYPE_ACTIVE || config.GetIsPassive() {
+				continue
+			}
+			if config.GetOncePerLoop() && ability.GetUsedThisLoop() {
+				continue
+			}
+			if locs := config.GetRestrictedToLocations(); len(locs) > 0 && !slices.Contains(locs, char.GetCurrentLocation()) {
+				continue
+			}
+			rank := config.GetGoodwillRank()
+			switch player.Role {
+			case model.PlayerRole_PLAYER_ROLE_MASTERMIND:
+				if rank > 0 {
+					continue
+				}
+			case model.PlayerRole_PLAYER_ROLE_PROTAGONIST:
+				if rank <= 0 || char.GetStats()[int32(model.StatType_STAT_TYPE_GOODWILL)] < rank {
+					continue
+				}
+			}
+			actions = append(actions, &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_UseAbility{UseAbility: &model.UseAbilityPayload{
+				CharacterId: charID,
+				AbilityId:   config.GetId(),
+			}}})
+		}
+	}
+	return actions
+}
+
+// checkAction 检查操作是否与玩家当前的某个操作模板相符。卡牌可以不预选目标；
+// 预选了目标时目标必须合法。猜测只检查当前是否可以猜测，不检查猜测的内容。
+func (ge *GameEngine) checkAction(player *model.Player, action *model.PlayerActionPayload) error {
+	for _, legal := range ge.legalActions(player) {
+		if actionMatches(legal, action) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: player %d cannot %s in phase %s", ErrIllegalAction, player.Id, actionName(action), ge.GameState.CurrentPhase)
+}
+
+// actionMatches 报告 action 是否与模板 legal 相符，见 checkAction。
+func actionMatches(legal, action *model.PlayerActionPayload) bool {
+	switch a := action.GetPayload().(type) {
+	case *model.PlayerActionPayload_PlayCard:
+		l := legal.GetPlayCard()
+		if l == nil || l.GetCardId() != a.PlayCard.GetCardId() {
+			return false
+		}
+		target, ok := a.PlayCard.GetPreChosenOptions()[phasehandler.CardTargetRequestID]
+		return !ok || target == l.GetPreChosenOptions()[phasehandler.CardTargetRequestID]
+	case *model.PlayerActionPayload_UseAbility:
+		l := legal.GetUseAbility()
+		return l != nil && l.GetCharacterId() == a.UseAbility.GetCharacterId() && l.GetAbilityId() == a.UseAbility.GetAbilityId()
+	case *model.PlayerActionPayload_ChooseOption:
+		l := legal.GetChooseOption()
+		return l != nil && l.GetRequestId() == a.ChooseOption.GetRequestId() && l.GetChosenOptionId() == a.ChooseOption.GetChosenOptionId()
+	case *model.PlayerActionPayload_MakeGuess:
+		return legal.GetMakeGuess() != nil
+	case *model.PlayerActionPayload_PassTurn:
+		return legal.GetPassTurn() != nil
+	default:
+		return false
+	}
+}
+
+// defaultAction 返回 AI 玩家无法给出合法操作时代为执行的操作：能跳过时跳过，否则执行第一个完整的操作模板。
+// 没有可以代为执行的操作时返回 nil。
+func defaultAction(legal []*model.PlayerActionPayload) *model.PlayerActionPayload {
+	var fallback *model.PlayerActionPayload
+	for _, action := range legal {
+		switch {
+		case action.GetPassTurn() != nil:
+			return action
+		case action.GetMakeGuess() != nil:
+			// 猜测模板需要填写身份，不能代为执行。
+		case fallback == nil:
+			fallback = action
+		}
+	}
+	if fallback != nil {
+		return proto.Clone(fallback).(*model.PlayerActionPayload)
+	}
+	return nil
+}
+
+// actionName 返回操作类型的名称，用于日志和错误信息。
+func actionName(action *model.PlayerActionPayload) string {
+	switch action.GetPayload().(type) {
+	case *model.PlayerActionPayload_PlayCard:
+		return "play a card"
+	case *model.PlayerActionPayload_UseAbility:
+		return "use an ability"
+	case *model.PlayerActionPayload_MakeGuess:
+		return "make a guess"
+	case *model.PlayerActionPayload_ChooseOption:
+		return "choose an option"
+	case *model.PlayerActionPayload_PassTurn:
+		return "pass"
+	default:
+		return "take an empty action"
+	}
+}
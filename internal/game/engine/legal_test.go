@@ -0,0 +1,41 @@
+package engine
+
+import (
+	"testing"
+
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func playCardAction(cardID int32, target string) *v1.PlayerActionPayload {
+	card := &v1.PlayCardPayload{CardId: cardID}
+	if target != "" {
+		card.PreChosenOptions = map[string]string{"target": target}
+	}
+	return &v1.PlayerActionPayload{Payload: &v1.PlayerActionPayload_PlayCard{PlayCard: card}}
+}
+
+func passAction() *v1.PlayerActionPayload {
+	return &v1.PlayerActionPayload{Payload: &v1.PlayerActionPayload_PassTurn{PassTurn: &v1.PassTurnAction{}}}
+}
+
+func TestActionMatches(t *testing.T) {
+	legal := playCardAction(3, "target_char_1")
+	assert.True(t, actionMatches(legal, playCardAction(3, "target_char_1")))
+	assert.True(t, actionMatches(legal, playCardAction(3, "")), "the target may be chosen later")
+	assert.False(t, actionMatches(legal, playCardAction(3, "target_char_2")))
+	assert.False(t, actionMatches(legal, playCardAction(4, "target_char_1")))
+	assert.False(t, actionMatches(legal, passAction()))
+	assert.True(t, actionMatches(passAction(), passAction()))
+	assert.False(t, actionMatches(passAction(), &v1.PlayerActionPayload{}))
+}
+
+func TestDefaultAction(t *testing.T) {
+	guess := &v1.PlayerActionPayload{Payload: &v1.PlayerActionPayload_MakeGuess{MakeGuess: &v1.MakeGuessPayload{}}}
+
+	assert.Nil(t, defaultAction(nil))
+	assert.Nil(t, defaultAction([]*v1.PlayerActionPayload{guess}), "a guess template can't be submitted as is")
+	assert.NotNil(t, defaultAction([]*v1.PlayerActionPayload{playCardAction(3, "target_char_1"), passAction()}).GetPassTurn())
+	assert.Equal(t, int32(3), defaultAction([]*v1.PlayerActionPayload{guess, playCardAction(3, "target_char_1")}).GetPlayCard().GetCardId())
+}
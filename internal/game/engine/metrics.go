@@ -0,0 +1,24 @@
+package engine
+
+import "github.com/constellation39/tragedyLooper/internal/metrics"
+
+var (
+	// actionsDropped 统计因引擎请求通道已满而被丢弃的玩家操作。
+	actionsDropped = metrics.Default.Counter("tragedylooper_engine_actions_dropped_total",
+		"Player actions dropped because the engine request channel was full.")
+	// actionsRejected 统计因不是当前合法操作而被拒绝的玩家操作，见 LegalActions。
+	actionsRejected = metrics.Default.Counter("tragedylooper_engine_actions_rejected_total",
+		"Player actions rejected because they were not legal in the current phase.")
+	// aiActionDuration 统计 AI 玩家生成一次操作的耗时，包括失败的请求。
+	aiActionDuration = metrics.Default.Histogram("tragedylooper_ai_action_duration_seconds",
+		"Time taken by AI players to generate an action.", nil)
+	// aiActionFailures 统计 AI 玩家生成操作失败或超时、由引擎提交跳过操作的次数。
+	aiActionFailures = metrics.Default.Counter("tragedylooper_ai_action_failures_total",
+		"AI action generations that failed or timed out and were replaced by a pass.")
+	// aiActionsStale 统计在请求之后阶段已经结束、因而被丢弃的 AI 操作。
+	aiActionsStale = metrics.Default.Counter("tragedylooper_ai_actions_stale_total",
+		"AI actions discarded because the phase they were requested in had ended.")
+	// viewBatchesDropped 统计因视图通道已满而被丢弃的视图批次。
+	viewBatchesDropped = metrics.Default.Counter("tragedylooper_engine_view_batches_dropped_total",
+		"View update batches dropped because the view channel was full.")
+)
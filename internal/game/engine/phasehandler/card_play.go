@@ -1,11 +1,89 @@
 package phasehandler
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
 
 	"go.uber.org/zap"
 )
 
+// CardTargetRequestID is the key of a card's target in PlayCardPayload.PreChosenOptions.
+// The value is the ID of one of the choices returned by CardTargets.
+const CardTargetRequestID = "target"
+
+// boardLocations are the locations cards can be placed on, in board order.
+var boardLocations = []model.LocationType{
+	model.LocationType_LOCATION_TYPE_HOSPITAL,
+	model.LocationType_LOCATION_TYPE_SHRINE,
+	model.LocationType_LOCATION_TYPE_CITY,
+	model.LocationType_LOCATION_TYPE_SCHOOL,
+}
+
+// CardTargets returns the targets the player may place the card on today: living characters, and for intrigue
+// cards also the board locations. A player can't place two cards on the same target on one day.
+func CardTargets(ge GameEngine, player *model.Player, card *model.Card) []*model.Choice {
+	gs := ge.GetGameState()
+	taken := make(map[string]bool)
+	for _, played := range gs.GetPlayedCardsThisDay()[player.Id].GetCards() {
+		if target := played.GetResolvedTarget(); target != nil {
+			taken[target.GetId()] = true
+		}
+	}
+
+	var targets []*model.Choice
+	ids := make([]int32, 0, len(gs.GetCharacters()))
+	for id, char := range gs.GetCharacters() {
+		if char.GetIsAlive() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		choice := &model.Choice{
+			Id:          fmt.Sprintf("target_char_%d", id),
+			Description: gs.GetCharacters()[id].GetConfig().GetName(),
+			Value:       &model.Choice_CharacterId{CharacterId: id},
+		}
+		if !taken[choice.Id] {
+			targets = append(targets, choice)
+		}
+	}
+
+	switch card.GetConfig().GetCardType() {
+	case model.CardType_CARD_TYPE_INTRIGUE_PLUS, model.CardType_CARD_TYPE_FORBID_INTRIGUE_INCREASE:
+		for _, loc := range boardLocations {
+			name := strings.ToLower(strings.TrimPrefix(loc.String(), "LOCATION_TYPE_"))
+			choice := &model.Choice{
+				Id:          "target_loc_" + name,
+				Description: name,
+				Value:       &model.Choice_Location{Location: loc},
+			}
+			if !taken[choice.Id] {
+				targets = append(targets, choice)
+			}
+		}
+	}
+	return targets
+}
+
+// resolveCardTarget returns the target chosen in the payload, nil if none was chosen, or an error if the
+// chosen target is not one of CardTargets.
+func resolveCardTarget(ge GameEngine, player *model.Player, card *model.Card, payload *model.PlayCardPayload) (*model.Choice, error) {
+	id, ok := payload.GetPreChosenOptions()[CardTargetRequestID]
+	if !ok {
+		return nil, nil
+	}
+	for _, target := range CardTargets(ge, player, card) {
+		if target.Id == id {
+			return target, nil
+		}
+	}
+	return nil, fmt.Errorf("%q is not a legal target for card %s", id, card.GetConfig().GetName())
+}
+
 func handlePlayCardAction(ge GameEngine, player *model.Player, payload *model.PlayCardPayload) {
 	gs := ge.GetGameState()
 
@@ -25,11 +103,18 @@ func handlePlayCardAction(ge GameEngine, player *model.Player, payload *model.Pl
 		return
 	}
 
-	if _, ok := gs.PlayedCardsThisLoop[card.Config.Id]; ok && card.Config.Type != model.CardType_CARD_TYPE_UNSPECIFIED {
+	if _, ok := gs.PlayedCardsThisLoop[card.Config.Id]; ok && card.Config.CardType != model.CardType_CARD_TYPE_UNSPECIFIED {
 		ge.Logger().Warn("Player tried to play a card that has already been played this loop", zap.Int32("player_id", player.Id), zap.String("card_name", card.Config.Name))
 		return
 	}
 
+	target, err := resolveCardTarget(ge, player, card, payload)
+	if err != nil {
+		ge.Logger().Warn("Player tried to play a card on an illegal target", zap.Int32("player_id", player.Id), zap.Error(err))
+		return
+	}
+	card.ResolvedTarget = target
+
 	// Add card to played cards for the day
 	if _, ok := gs.PlayedCardsThisDay[player.Id]; !ok {
 		gs.PlayedCardsThisDay[player.Id] = &model.CardList{}
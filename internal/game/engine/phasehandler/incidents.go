@@ -13,7 +13,6 @@ type IncidentsPhase struct {
 
 func (p *IncidentsPhase) Enter(ge GameEngine) PhaseState {
 	gs := ge.GetGameState()
-	script := ge.GetGameRepo().GetScript()
 
 	// Check for day-ending tragedies or other conditions here.
 	// For now, we'll just advance the day.
@@ -23,7 +22,7 @@ func (p *IncidentsPhase) Enter(ge GameEngine) PhaseState {
 		Payload: &model.EventPayload_DayAdvanced{DayAdvanced: &model.DayAdvancedEvent{Day: gs.CurrentDay, Loop: gs.CurrentLoop}},
 	})
 
-	if gs.CurrentDay > script.GetDaysPerLoop() {
+	if gs.CurrentDay > gs.DaysPerLoop {
 		// End of the loop
 		ge.Logger().Info("Loop has ended. Resetting for the next loop.")
 		gs.CurrentLoop++
@@ -32,7 +31,7 @@ func (p *IncidentsPhase) Enter(ge GameEngine) PhaseState {
 		})
 
 		// Check for game over condition after loop reset
-		if gs.CurrentLoop > script.GetLoopCount() {
+		if gs.CurrentLoop > gs.LoopCount {
 			ge.Logger().Info("Final loop has ended. Game over.")
 			ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED, &model.EventPayload{})
 			return PhaseComplete
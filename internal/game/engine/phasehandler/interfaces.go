@@ -29,6 +29,27 @@ type Phase interface {
 	TimeoutTicks() int64
 }
 
+// ActionKind is a kind of player action, see ActionPhase.
+type ActionKind int
+
+const (
+	// ActionPlayCard plays a card from the player's hand.
+	ActionPlayCard ActionKind = iota + 1
+	// ActionUseAbility uses a character's ability.
+	ActionUseAbility
+	// ActionMakeGuess guesses the hidden roles of the characters.
+	ActionMakeGuess
+	// ActionPass passes the player's turn.
+	ActionPass
+)
+
+// ActionPhase is implemented by phases in which players take actions.
+// Phases that don't implement it accept no player actions.
+type ActionPhase interface {
+	// AllowedActions returns the kinds of actions the player may take now, or nil if it isn't the player's turn.
+	AllowedActions(ge GameEngine, player *model.Player) []ActionKind
+}
+
 // PhaseState indicates the state of a phase after an operation.
 type PhaseState bool
 
@@ -57,4 +78,8 @@ type GameEngine interface {
 	GetProtagonistPlayers() []*model.Player
 	ApplyEffect(effect *model.Effect, ability *model.Ability, payload *model.UseAbilityPayload, choice *model.ChooseOptionPayload) error
 	RequestAIAction(playerID int32)
+	// CancelAIRequests cancels the AI requests made in the current phase and discards their results.
+	CancelAIRequests()
+	// ClearPendingChoices discards the choice requests players haven't answered yet.
+	ClearPendingChoices()
 }
@@ -12,9 +12,8 @@ type LoopEndPhase struct {
 func (p *LoopEndPhase) Type() model.GamePhase { return model.GamePhase_GAME_PHASE_LOOP_END }
 func (p *LoopEndPhase) Enter(ge GameEngine) PhaseState {
 	gs := ge.GetGameState()
-	script := ge.GetGameRepo().GetScript()
 
-	if gs.CurrentLoop >= script.LoopCount {
+	if gs.CurrentLoop >= gs.LoopCount {
 		// Final loop has ended. Check for protagonist win condition.
 		// This is a simplification. A real game would have more complex win/loss checks.
 		ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_LOOP_WIN, &model.EventPayload{
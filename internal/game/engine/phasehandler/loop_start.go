@@ -12,9 +12,8 @@ type LoopStartPhase struct {
 func (p *LoopStartPhase) Type() model.GamePhase { return model.GamePhase_GAME_PHASE_LOOP_START }
 func (p *LoopStartPhase) Enter(ge GameEngine) PhaseState {
 	gs := ge.GetGameState()
-	script := ge.GetGameRepo().GetScript()
 
-	if gs.CurrentLoop >= script.LoopCount {
+	if gs.CurrentLoop >= gs.LoopCount {
 		ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED, &model.EventPayload{
 			Payload: &model.EventPayload_GameEnded{GameEnded: &model.GameEndedEvent{Reason: "Max loops reached"}},
 		})
@@ -1,11 +1,19 @@
 package phasehandler
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/metrics"
 	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
 
 	"go.uber.org/zap"
 )
 
+// phaseDuration records how long each phase lasted in wall-clock time, including time spent paused.
+var phaseDuration = metrics.Default.HistogramVec("tragedylooper_phase_duration_seconds",
+	"Wall-clock duration of game phases, by phase.", nil, "phase")
+
 // Manager orchestrates the game's lifecycle by executing phases determined by a flowchart.
 type Manager struct {
 	engine        GameEngine
@@ -13,7 +21,11 @@ type Manager struct {
 	currentPhase  Phase
 	timeoutTarget int64 // The tick count at which the current phase will time out.
 	gameStarted   bool
+	resumed       bool      // Set by ResumeAt: Start continues the current phase instead of entering it.
+	enteredAt     time.Time // When the current phase was entered, for phaseDuration.
 	flowchart     *FlowchartManager
+	// defaultTimeouts are used for phases that don't define their own timeout.
+	defaultTimeouts map[model.GamePhase]int64
 }
 
 // NewManager creates a new phase manager.
@@ -28,10 +40,37 @@ func NewManager(engine GameEngine) *Manager {
 }
 
 // Start begins the phase lifecycle by transitioning to the initial phase.
+// After ResumeAt it continues the restored phase without calling its Enter,
+// so progress kept in the game state is not reset.
 func (pm *Manager) Start() {
+	if pm.resumed && !pm.gameStarted {
+		pm.logger.Info("Resuming phase", zap.String("phase", pm.currentPhase.Type().String()))
+		pm.gameStarted = true
+		pm.enteredAt = time.Now()
+		pm.startTimer()
+		return
+	}
 	pm.transitionTo(pm.currentPhase.Type())
 }
 
+// ResumeAt makes Start continue the given phase instead of entering the setup phase.
+// It is used when restoring a game from a snapshot and must be called before Start.
+func (pm *Manager) ResumeAt(phase model.GamePhase) error {
+	p, ok := phases[phase]
+	if !ok {
+		return fmt.Errorf("cannot resume at unknown phase %s", phase)
+	}
+	pm.currentPhase = p
+	pm.resumed = true
+	return nil
+}
+
+// SetDefaultTimeouts sets the timeout in ticks of phases whose TimeoutTicks is 0.
+// Phases that are not in the map don't time out. It must be called before Start.
+func (pm *Manager) SetDefaultTimeouts(timeouts map[model.GamePhase]int64) {
+	pm.defaultTimeouts = timeouts
+}
+
 // OnTick is called periodically by the game engine to check for phase timeouts.
 func (pm *Manager) OnTick() {
 	if pm.timeoutTarget > 0 && pm.engine.GetGameState().Tick >= pm.timeoutTarget {
@@ -49,6 +88,14 @@ func (pm *Manager) HandleAction(player *model.Player, action *model.PlayerAction
 	return pm.currentPhase.HandleAction(pm.engine, player, action)
 }
 
+// AllowedActions returns the kinds of actions the player may take in the current phase, see ActionPhase.
+func (pm *Manager) AllowedActions(player *model.Player) []ActionKind {
+	if p, ok := pm.currentPhase.(ActionPhase); ok {
+		return p.AllowedActions(pm.engine, player)
+	}
+	return nil
+}
+
 // HandleEvent delegates the event to the current phase and then attempts a transition if the phase is ready.
 func (pm *Manager) HandleEvent(event *model.GameEvent) PhaseState {
 	return pm.currentPhase.HandleEvent(pm.engine, event)
@@ -56,6 +103,8 @@ func (pm *Manager) HandleEvent(event *model.GameEvent) PhaseState {
 
 // HandleTimeout handles a phase timeout and then attempts a transition.
 func (pm *Manager) HandleTimeout() {
+	// Choices nobody answered in time can't be answered any more.
+	pm.engine.ClearPendingChoices()
 	pm.currentPhase.HandleTimeout(pm.engine)
 	pm.transitionToNext()
 }
@@ -82,22 +131,24 @@ func (pm *Manager) transitionTo(nextPhaseType model.GamePhase) bool {
 	if pm.gameStarted {
 		pm.logger.Info("Transitioning phase", zap.String("from", pm.currentPhase.Type().String()), zap.String("to", nextPhase.Type().String()))
 		pm.currentPhase.Exit(pm.engine)
+		// AI players deciding for the phase we leave can't act any more, and neither can players
+		// who still have to answer a choice made in it.
+		pm.engine.CancelAIRequests()
+		pm.engine.ClearPendingChoices()
+		phaseDuration.WithLabelValues(pm.currentPhase.Type().String()).Observe(time.Since(pm.enteredAt).Seconds())
 	} else {
 		pm.logger.Info("Entering initial phase", zap.String("to", nextPhase.Type().String()))
 		pm.gameStarted = true
 	}
 
 	pm.currentPhase = nextPhase
+	pm.enteredAt = time.Now()
 	pm.engine.GetGameState().CurrentPhase = nextPhase.Type()
 
 	// Enter the new phase.
 	phaseState := pm.currentPhase.Enter(pm.engine)
 
-	// Set the timer for the new phase.
-	ticks := pm.currentPhase.TimeoutTicks()
-	if ticks > 0 {
-		pm.timeoutTarget = pm.engine.GetGameState().Tick + ticks
-	}
+	pm.startTimer()
 
 	// After entering, immediately check if we should transition again.
 	// This handles auto-advancing phases.
@@ -107,6 +158,17 @@ func (pm *Manager) transitionTo(nextPhaseType model.GamePhase) bool {
 	return true
 }
 
+// startTimer sets the timeout of the current phase, counted from the current tick.
+func (pm *Manager) startTimer() {
+	ticks := pm.currentPhase.TimeoutTicks()
+	if ticks <= 0 {
+		ticks = pm.defaultTimeouts[pm.currentPhase.Type()]
+	}
+	if ticks > 0 {
+		pm.timeoutTarget = pm.engine.GetGameState().Tick + ticks
+	}
+}
+
 // transitionToNext determines the next phase from the flowchart and transitions to it.
 func (pm *Manager) transitionToNext() bool {
 	nextPhaseType := pm.flowchart.GetNextPhase(pm.currentPhase.Type())
@@ -153,10 +153,9 @@ func checkTriggers(ge GameEngine) {
 // checkEndConditions checks if any game-ending conditions are met.
 func checkEndConditions(ge GameEngine) {
 	gs := ge.GetGameState()
-	script := ge.GetGameRepo().GetScript()
 
 	// Check if the maximum number of loops has been reached
-	if gs.CurrentLoop >= script.GetLoopCount() {
+	if gs.CurrentLoop >= gs.LoopCount {
 		ge.Logger().Info("Max loops reached. Protagonists win.")
 		ge.TriggerEvent(model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED, &model.EventPayload{
 			Payload: &model.EventPayload_GameEnded{GameEnded: &model.GameEndedEvent{Winner: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}},
@@ -166,6 +165,14 @@ func checkEndConditions(ge GameEngine) {
 	// ... other game-ending conditions can be added here (e.g., all protagonists are dead) ...
 }
 
+// AllowedActions returns the actions the mastermind may take.
+func (p *MastermindAbilitiesPhase) AllowedActions(ge GameEngine, player *model.Player) []ActionKind {
+	if player.Role != model.PlayerRole_PLAYER_ROLE_MASTERMIND {
+		return nil
+	}
+	return []ActionKind{ActionUseAbility, ActionPass}
+}
+
 func init() {
 	RegisterPhase(&MastermindAbilitiesPhase{})
 }
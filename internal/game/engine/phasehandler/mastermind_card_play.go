@@ -5,9 +5,9 @@ import (
 )
 
 // MastermindCardPlayPhase is the phase where the mastermind plays their cards.
+// The number of cards played so far is kept in GameState.PhaseTurn.
 type MastermindCardPlayPhase struct {
 	BasePhase
-	mastermindCardsPlayed int
 }
 
 // Type returns the phase type.
@@ -17,7 +17,7 @@ func (p *MastermindCardPlayPhase) Type() model.GamePhase {
 
 // Enter is called when the phase begins.
 func (p *MastermindCardPlayPhase) Enter(ge GameEngine) PhaseState {
-	p.mastermindCardsPlayed = 0
+	ge.GetGameState().PhaseTurn = 0
 	ge.RequestAIAction(ge.GetMastermindPlayer().Id)
 	return PhaseInProgress
 }
@@ -28,17 +28,26 @@ func (p *MastermindCardPlayPhase) HandleAction(ge GameEngine, player *model.Play
 		return PhaseInProgress
 	}
 
+	gs := ge.GetGameState()
 	if payload, ok := action.Payload.(*model.PlayerActionPayload_PlayCard); ok {
 		handlePlayCardAction(ge, player, payload.PlayCard)
-		p.mastermindCardsPlayed++
+		gs.PhaseTurn++
 	}
 
-	if p.mastermindCardsPlayed >= 1 {
+	if gs.PhaseTurn >= 1 {
 		return PhaseComplete
 	}
 	return PhaseInProgress
 }
 
+// AllowedActions returns the actions the mastermind may take: playing a card until one has been played.
+func (p *MastermindCardPlayPhase) AllowedActions(ge GameEngine, player *model.Player) []ActionKind {
+	if player.Role != model.PlayerRole_PLAYER_ROLE_MASTERMIND || ge.GetGameState().PhaseTurn >= 1 {
+		return nil
+	}
+	return []ActionKind{ActionPlayCard}
+}
+
 func init() {
 	RegisterPhase(&MastermindCardPlayPhase{})
 }
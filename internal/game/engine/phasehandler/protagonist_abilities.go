@@ -6,9 +6,9 @@ import (
 )
 
 // ProtagonistAbilitiesPhase is the phase where protagonists can use character abilities.
+// The index of the protagonist whose turn it is is kept in GameState.PhaseTurn.
 type ProtagonistAbilitiesPhase struct {
 	BasePhase
-	protagonistTurnIndex int
 }
 
 // Type returns the phase type.
@@ -18,7 +18,7 @@ func (p *ProtagonistAbilitiesPhase) Type() model.GamePhase {
 
 // Enter is called at the beginning of the phase.
 func (p *ProtagonistAbilitiesPhase) Enter(ge GameEngine) PhaseState {
-	p.protagonistTurnIndex = 0
+	ge.GetGameState().PhaseTurn = 0
 
 	// If no protagonists need to act, move to the next phase.
 	if len(ge.GetProtagonistPlayers()) == 0 {
@@ -54,22 +54,24 @@ func (p *ProtagonistAbilitiesPhase) HandleTimeout(ge GameEngine) {
 
 func (p *ProtagonistAbilitiesPhase) isActionInTurn(ge GameEngine, player *model.Player) bool {
 	protagonists := ge.GetProtagonistPlayers()
-	if p.protagonistTurnIndex >= len(protagonists) {
+	turn := int(ge.GetGameState().PhaseTurn)
+	if turn >= len(protagonists) {
 		return false // Should not happen
 	}
-	return player.Id == protagonists[p.protagonistTurnIndex].Id
+	return player.Id == protagonists[turn].Id
 }
 
 func (p *ProtagonistAbilitiesPhase) handlePassTurn(ge GameEngine) PhaseState {
-	p.protagonistTurnIndex++
+	gs := ge.GetGameState()
+	gs.PhaseTurn++
 	protagonists := ge.GetProtagonistPlayers()
-	if p.protagonistTurnIndex >= len(protagonists) {
+	if int(gs.PhaseTurn) >= len(protagonists) {
 		ge.Logger().Info("All protagonists have acted, moving to Incidents Phase")
 		return PhaseComplete
 	}
 
 	// Trigger AI for the next protagonist if applicable.
-	// ge.RequestAIAction(protagonists[p.protagonistTurnIndex].Id)
+	// ge.RequestAIAction(protagonists[gs.PhaseTurn].Id)
 	return PhaseInProgress
 }
 
@@ -112,6 +114,14 @@ func (p *ProtagonistAbilitiesPhase) handleUseAbilityAction(ge GameEngine, player
 	ge.Logger().Info("Player used ability", zap.String("player", player.Name), zap.String("ability", ability.Config.Name))
 }
 
+// AllowedActions returns the actions of the protagonist whose turn it is.
+func (p *ProtagonistAbilitiesPhase) AllowedActions(ge GameEngine, player *model.Player) []ActionKind {
+	if !p.isActionInTurn(ge, player) {
+		return nil
+	}
+	return []ActionKind{ActionUseAbility, ActionPass}
+}
+
 func init() {
 	RegisterPhase(&ProtagonistAbilitiesPhase{})
 }
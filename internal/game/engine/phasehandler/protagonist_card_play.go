@@ -7,9 +7,9 @@ import (
 )
 
 // ProtagonistCardPlayPhase is the phase where the protagonists play their cards.
+// The index of the protagonist whose turn it is is kept in GameState.PhaseTurn.
 type ProtagonistCardPlayPhase struct {
 	BasePhase
-	protagonistTurnIndex int
 }
 
 // Type returns the phase type.
@@ -19,7 +19,7 @@ func (p *ProtagonistCardPlayPhase) Type() model.GamePhase {
 
 // Enter is called when the phase begins.
 func (p *ProtagonistCardPlayPhase) Enter(ge GameEngine) PhaseState {
-	p.protagonistTurnIndex = 0
+	ge.GetGameState().PhaseTurn = 0
 	protagonists := ge.GetProtagonistPlayers()
 	if len(protagonists) == 0 {
 		return PhaseComplete
@@ -34,9 +34,10 @@ func (p *ProtagonistCardPlayPhase) HandleAction(ge GameEngine, player *model.Pla
 	if len(protagonists) == 0 {
 		return PhaseComplete
 	}
+	gs := ge.GetGameState()
 
-	if player.Role != model.PlayerRole_PLAYER_ROLE_PROTAGONIST || player.Id != protagonists[p.protagonistTurnIndex].Id {
-		ge.Logger().Warn("Received action from player out of turn", zap.String("expected_player", protagonists[p.protagonistTurnIndex].Name), zap.String("actual_player", player.Name))
+	if player.Role != model.PlayerRole_PLAYER_ROLE_PROTAGONIST || player.Id != protagonists[gs.PhaseTurn].Id {
+		ge.Logger().Warn("Received action from player out of turn", zap.String("expected_player", protagonists[gs.PhaseTurn].Name), zap.String("actual_player", player.Name))
 		return PhaseInProgress
 	}
 
@@ -47,18 +48,28 @@ func (p *ProtagonistCardPlayPhase) HandleAction(ge GameEngine, player *model.Pla
 		handlePassTurnAction(ge, player)
 	}
 
-	p.protagonistTurnIndex++
+	gs.PhaseTurn++
 
-	if p.protagonistTurnIndex >= len(protagonists) {
+	if int(gs.PhaseTurn) >= len(protagonists) {
 		return PhaseComplete
 	}
 
 	// Trigger AI for the next protagonist.
-	nextProtagonist := protagonists[p.protagonistTurnIndex]
+	nextProtagonist := protagonists[gs.PhaseTurn]
 	ge.RequestAIAction(nextProtagonist.Id)
 	return PhaseInProgress
 }
 
+// AllowedActions returns the actions of the protagonist whose turn it is.
+func (p *ProtagonistCardPlayPhase) AllowedActions(ge GameEngine, player *model.Player) []ActionKind {
+	protagonists := ge.GetProtagonistPlayers()
+	turn := int(ge.GetGameState().PhaseTurn)
+	if turn >= len(protagonists) || player.Id != protagonists[turn].Id {
+		return nil
+	}
+	return []ActionKind{ActionPlayCard, ActionPass}
+}
+
 func init() {
 	RegisterPhase(&ProtagonistCardPlayPhase{})
 }
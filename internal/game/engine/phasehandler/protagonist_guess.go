@@ -63,6 +63,14 @@ func (p *ProtagonistGuessPhase) HandleAction(ge GameEngine, player *model.Player
 	return PhaseComplete
 }
 
+// AllowedActions returns the actions a protagonist may take: making a guess or passing on it.
+func (p *ProtagonistGuessPhase) AllowedActions(ge GameEngine, player *model.Player) []ActionKind {
+	if player.Role != model.PlayerRole_PLAYER_ROLE_PROTAGONIST {
+		return nil
+	}
+	return []ActionKind{ActionMakeGuess, ActionPass}
+}
+
 func init() {
 	RegisterPhase(&ProtagonistGuessPhase{})
 }
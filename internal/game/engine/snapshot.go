@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine/ai"
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// getSnapshotRequest is a request to get a copy of the authoritative game state.
+type getSnapshotRequest struct {
+	responseChan chan *model.GameState
+}
+
+// Snapshot 返回权威游戏状态的深拷贝，用于持久化。引擎停止后返回 nil。
+func (ge *GameEngine) Snapshot() *model.GameState {
+	responseChan := make(chan *model.GameState)
+	select {
+	case ge.engineChan <- &getSnapshotRequest{responseChan: responseChan}:
+	case <-ge.stopChan:
+		return nil
+	}
+	select {
+	case gs := <-responseChan:
+		return gs
+	case <-ge.stopChan:
+		return nil
+	}
+}
+
+// RestoreGameEngine 从 Snapshot 保存的游戏状态恢复游戏引擎。
+// eventLog 是快照之前的完整事件历史，用于重建每个玩家的事件记录。
+// 恢复的引擎启动后继续快照所在的阶段而不重新进入它：阶段内的进度（GameState.PhaseTurn）保持不变，
+// 只有阶段的计时从头开始。待回应的选择请求不会恢复，因为继续应用效果所需的上下文不在快照中。
+func RestoreGameEngine(logger *zap.Logger, snapshot *model.GameState, eventLog []*model.GameEvent, actionGenerator ai.ActionGenerator, gameConfig loader.ScriptConfig) (*GameEngine, error) {
+	if snapshot == nil {
+		return nil, fmt.Errorf("snapshot is nil")
+	}
+	ge, err := NewGameEngine(logger, nil, actionGenerator, gameConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	gs := proto.Clone(snapshot).(*model.GameState)
+	if gs.Players == nil {
+		gs.Players = make(map[int32]*model.Player)
+	}
+	if gs.DisconnectedPlayers == nil {
+		gs.DisconnectedPlayers = make(map[int32]bool)
+	}
+	players := gs.Players
+	gs.Players = make(map[int32]*model.Player, len(players))
+	ge.GameState = gs
+	for _, id := range slices.Sorted(maps.Keys(players)) {
+		if err := ge.addPlayer(players[id]); err != nil {
+			return nil, err
+		}
+	}
+	if err := ge.phaseManager.ResumeAt(gs.CurrentPhase); err != nil {
+		return nil, err
+	}
+	for _, event := range eventLog {
+		ge.recordEvent(event)
+		if event.GetType() == model.GameEventType_GAME_EVENT_TYPE_CHOICE_REQUIRED {
+			// 新的选择请求不能与历史中的请求重复 ID。
+			ge.choiceSeq++
+		}
+	}
+	ge.restored = true
+	return ge, nil
+}
+
+// resumeAIPlayers 在恢复的引擎启动后，为现在可以操作的 AI 玩家重新发出请求，
+// 因为快照之前发出的请求随原来的引擎一起丢失了。
+// 此方法不是线程安全的，必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) resumeAIPlayers() {
+	for _, id := range slices.Sorted(maps.Keys(ge.GameState.Players)) {
+		player := ge.GameState.Players[id]
+		if player.IsLlm && len(ge.legalActions(player)) > 0 {
+			ge.RequestAIAction(id)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_RestoreResumesPhase(t *testing.T) {
+	original := helper_NewGameEngineForTest(t)
+	original.GameState.CurrentPhase = v1.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY
+	original.GameState.PhaseTurn = 1 // 第一名主角已经出过牌。
+	staleChoice := &v1.GameEvent{
+		Type: v1.GameEventType_GAME_EVENT_TYPE_CHOICE_REQUIRED,
+		Payload: &v1.EventPayload{Payload: &v1.EventPayload_ChoiceRequired{ChoiceRequired: &v1.ChoiceRequiredEvent{
+			RequestId: "choice_1",
+			PlayerId:  2,
+			Choices:   []*v1.Choice{{Id: "target_char_1"}},
+		}}},
+	}
+
+	restored, err := RestoreGameEngine(logger.New(), original.GameState, []*v1.GameEvent{staleChoice}, nil, original.scriptConfig)
+	require.NoError(t, err)
+	restored.Start()
+	defer restored.Stop()
+
+	// 恢复不重新进入阶段，因此轮到第二名主角；历史中的选择请求不能再回应。
+	assert.Empty(t, restored.LegalActions(2))
+	assert.NotEmpty(t, restored.LegalActions(3))
+	require.NoError(t, restored.runAdmin(func() error {
+		assert.Equal(t, int32(1), restored.GameState.PhaseTurn)
+		assert.Equal(t, 1, restored.choiceSeq, "new choice requests must not reuse the IDs in the event log")
+		return nil
+	}))
+}
@@ -0,0 +1,195 @@
+// Package viewdiff 计算同一玩家两个版本的 PlayerView 之间的差异（ViewDelta），并将差异应用到视图上。
+//
+// 服务器只向视图已同步的客户端发送差异；客户端用 Apply 将差异应用到本地视图，
+// 版本不一致时应请求完整视图。差异的格式见 ViewDelta 的说明。
+package viewdiff
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// 以下字段不通过 changed_fields 传递，而是由 ViewDelta 中的专用字段按条目更新。
+const (
+	charactersField   = "characters"
+	playersField      = "players"
+	publicEventsField = "public_events"
+)
+
+// Diff 返回从 old 到 new 的差异。old 为 nil 时视为空视图。返回的差异不包含版本号，由调用者填写。
+// 差异与 new 共享子消息，调用者不应在之后修改 new。
+func Diff(old, new *model.PlayerView) *model.ViewDelta {
+	if old == nil {
+		old = &model.PlayerView{}
+	}
+	delta := &model.ViewDelta{}
+
+	changed := &model.PlayerView{}
+	om, nm, cm := old.ProtoReflect(), new.ProtoReflect(), changed.ProtoReflect()
+	fields := nm.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		switch fd.Name() {
+		case charactersField, playersField, publicEventsField:
+			continue
+		}
+		if valueEqual(fd, om.Get(fd), nm.Get(fd)) {
+			continue
+		}
+		delta.ChangedFields = append(delta.ChangedFields, string(fd.Name()))
+		if nm.Has(fd) {
+			cm.Set(fd, nm.Get(fd))
+		}
+	}
+	if len(delta.ChangedFields) > 0 {
+		delta.Changed = changed
+	}
+
+	delta.Characters, delta.RemovedCharacterIds = diffMap(old.Characters, new.Characters)
+	delta.Players, delta.RemovedPlayerIds = diffMap(old.Players, new.Players)
+
+	// 事件历史只会追加。如果旧历史不是新历史的前缀（例如视图来自另一局游戏），整体替换。
+	if hasPrefix(new.PublicEvents, old.PublicEvents) {
+		delta.NewEvents = new.PublicEvents[len(old.PublicEvents):]
+	} else {
+		delta.ChangedFields = append(delta.ChangedFields, publicEventsField)
+		if delta.Changed == nil {
+			delta.Changed = changed
+		}
+		changed.PublicEvents = new.PublicEvents
+	}
+	return delta
+}
+
+// IsEmpty 报告差异是否不包含任何变化。
+func IsEmpty(delta *model.ViewDelta) bool {
+	return len(delta.GetChangedFields()) == 0 &&
+		len(delta.GetCharacters()) == 0 && len(delta.GetRemovedCharacterIds()) == 0 &&
+		len(delta.GetPlayers()) == 0 && len(delta.GetRemovedPlayerIds()) == 0 &&
+		len(delta.GetNewEvents()) == 0
+}
+
+// Apply 将差异应用到视图上，原地修改 view。调用者负责检查 view 的版本与 delta.base_version 一致。
+// 应用后 view 与 delta 共享子消息。
+func Apply(view *model.PlayerView, delta *model.ViewDelta) error {
+	vm := view.ProtoReflect()
+	cm := delta.GetChanged().ProtoReflect()
+	fields := vm.Descriptor().Fields()
+	for _, name := range delta.GetChangedFields() {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Name() == charactersField || fd.Name() == playersField {
+			return fmt.Errorf("invalid changed field %q", name)
+		}
+		if cm.IsValid() && cm.Has(fd) {
+			vm.Set(fd, cm.Get(fd))
+		} else {
+			vm.Clear(fd)
+		}
+	}
+
+	if len(delta.GetCharacters()) > 0 && view.Characters == nil {
+		view.Characters = make(map[int32]*model.PlayerViewCharacter, len(delta.GetCharacters()))
+	}
+	for id, c := range delta.GetCharacters() {
+		view.Characters[id] = c
+	}
+	for _, id := range delta.GetRemovedCharacterIds() {
+		delete(view.Characters, id)
+	}
+
+	if len(delta.GetPlayers()) > 0 && view.Players == nil {
+		view.Players = make(map[int32]*model.PlayerViewPlayer, len(delta.GetPlayers()))
+	}
+	for id, p := range delta.GetPlayers() {
+		view.Players[id] = p
+	}
+	for _, id := range delta.GetRemovedPlayerIds() {
+		delete(view.Players, id)
+	}
+
+	view.PublicEvents = append(view.PublicEvents, delta.GetNewEvents()...)
+	return nil
+}
+
+// diffMap 返回 new 中新增或发生变化的条目，以及 old 中被删除的键（按升序排列）。
+func diffMap[M proto.Message](old, new map[int32]M) (map[int32]M, []int32) {
+	var changed map[int32]M
+	for id, v := range new {
+		if ov, ok := old[id]; ok && proto.Equal(ov, v) {
+			continue
+		}
+		if changed == nil {
+			changed = make(map[int32]M)
+		}
+		changed[id] = v
+	}
+	var removed []int32
+	for id := range old {
+		if _, ok := new[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	slices.Sort(removed)
+	return changed, removed
+}
+
+// hasPrefix 报告 prefix 是否为 events 的前缀。引擎中的事件历史共享同一批事件对象，因此先比较指针。
+func hasPrefix(events, prefix []*model.GameEvent) bool {
+	if len(prefix) > len(events) {
+		return false
+	}
+	for i, e := range prefix {
+		if e != events[i] && !proto.Equal(e, events[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// valueEqual 报告字段 fd 的两个值是否相等。
+func valueEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch {
+	case fd.IsList():
+		la, lb := a.List(), b.List()
+		if la.Len() != lb.Len() {
+			return false
+		}
+		for i := 0; i < la.Len(); i++ {
+			if !singularEqual(fd, la.Get(i), lb.Get(i)) {
+				return false
+			}
+		}
+		return true
+	case fd.IsMap():
+		ma, mb := a.Map(), b.Map()
+		if ma.Len() != mb.Len() {
+			return false
+		}
+		equal := true
+		ma.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			w := mb.Get(k)
+			equal = w.IsValid() && singularEqual(fd.MapValue(), v, w)
+			return equal
+		})
+		return equal
+	default:
+		return singularEqual(fd, a, b)
+	}
+}
+
+func singularEqual(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	case protoreflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	default:
+		return a.Interface() == b.Interface()
+	}
+}
@@ -0,0 +1,90 @@
+package viewdiff
+
+import (
+	"testing"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func testView() *model.PlayerView {
+	return &model.PlayerView{
+		GameId:       "game-1",
+		Tick:         10,
+		CurrentLoop:  1,
+		CurrentDay:   1,
+		CurrentPhase: model.GamePhase_GAME_PHASE_MASTERMIND_CARD_PLAY,
+		Characters: map[int32]*model.PlayerViewCharacter{
+			1: {Id: 1, Name: "Boy Student", Stats: map[int32]int32{1: 0}, IsAlive: true},
+			2: {Id: 2, Name: "Girl Student", IsAlive: true},
+		},
+		Players: map[int32]*model.PlayerViewPlayer{
+			1: {Id: 1, Name: "alice", HandSize: 3},
+		},
+		YourHand: []*model.Card{{Config: &model.CardConfig{Id: 1}}, {Config: &model.CardConfig{Id: 2}}},
+		PublicEvents: []*model.GameEvent{
+			{Type: model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED, Day: 1},
+		},
+		Paused: true,
+	}
+}
+
+func TestDiffApply(t *testing.T) {
+	old := testView()
+	new := testView()
+	new.Tick = 12
+	new.CurrentPhase = model.GamePhase_GAME_PHASE_CARD_REVEAL
+	new.Characters[1].Stats[1] = 2
+	delete(new.Characters, 2)
+	new.Players[1].HandSize = 2
+	new.YourHand = new.YourHand[:1]
+	new.Paused = false
+	new.PublicEvents = append(old.PublicEvents[:1:1], &model.GameEvent{Type: model.GameEventType_GAME_EVENT_TYPE_CARD_PLAYED, Day: 1})
+
+	delta := Diff(old, new)
+	assert.ElementsMatch(t, []string{"tick", "current_phase", "your_hand", "paused"}, delta.ChangedFields)
+	assert.Len(t, delta.Characters, 1, "only the changed character is sent")
+	assert.Equal(t, []int32{2}, delta.RemovedCharacterIds)
+	assert.Len(t, delta.Players, 1)
+	assert.Len(t, delta.NewEvents, 1, "only appended events are sent")
+
+	// 通过线路传输后应用。
+	data, err := proto.Marshal(delta)
+	assert.NoError(t, err)
+	received := &model.ViewDelta{}
+	assert.NoError(t, proto.Unmarshal(data, received))
+	view := testView()
+	assert.NoError(t, Apply(view, received))
+	assert.True(t, proto.Equal(new, view), "applying the delta yields the new view")
+}
+
+func TestDiffUnchanged(t *testing.T) {
+	assert.True(t, IsEmpty(Diff(testView(), testView())))
+	assert.False(t, IsEmpty(Diff(nil, testView())))
+
+	view := &model.PlayerView{}
+	assert.NoError(t, Apply(view, Diff(nil, testView())))
+	assert.True(t, proto.Equal(testView(), view), "a delta from an empty view is a full snapshot")
+}
+
+func TestDiffReplacesRewrittenHistory(t *testing.T) {
+	old := testView()
+	new := testView()
+	new.PublicEvents = []*model.GameEvent{{Type: model.GameEventType_GAME_EVENT_TYPE_LOOP_RESET}}
+	delta := Diff(old, new)
+	assert.Contains(t, delta.ChangedFields, "public_events")
+	assert.Empty(t, delta.NewEvents)
+
+	view := testView()
+	assert.NoError(t, Apply(view, delta))
+	assert.True(t, proto.Equal(new, view))
+}
+
+func TestApplyRejectsUnknownField(t *testing.T) {
+	err := Apply(&model.PlayerView{}, &model.ViewDelta{ChangedFields: []string{"no_such_field"}})
+	assert.Error(t, err)
+	err = Apply(&model.PlayerView{}, &model.ViewDelta{ChangedFields: []string{"characters"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"github.com/constellation39/tragedyLooper/internal/game/engine/viewdiff"
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// viewChanSize 是等待监听者处理的视图批次数量上限。
+const viewChanSize = 16
+
+// PublishedView 是引擎发布的一个玩家视图版本。发布后不再修改，可以在任意 goroutine 中读取。
+type PublishedView struct {
+	Version uint64            // 视图版本，所有玩家共用一个单调递增的计数器
+	View    *model.PlayerView // 完整视图
+	Delta   *model.ViewDelta  // 相对该玩家上一个发布版本的差异；第一个版本的差异相对空视图，base_version 为 0
+}
+
+// ViewBatch 是引擎在一个 tick 结束时发布的视图变化。同一 tick 内的多个事件和操作合并为一个批次，
+// 批次中只包含视图发生了变化的玩家。批次在该 tick 的所有事件之后发出。
+type ViewBatch struct {
+	Tick  int64
+	Views map[int32]*PublishedView // 以 player_id 为键
+	// Spectators 是发生了变化的旁观者完整视图，以 visibility.SpectatorViewer 为键。
+	Spectators map[visibility.Viewer]*model.PlayerView
+}
+
+// getPublishedViewRequest is a request to get the latest published view of a player.
+type getPublishedViewRequest struct {
+	playerID     int32
+	responseChan chan *PublishedView
+}
+
+// ViewUpdates 返回视图批次的只读通道。监听者处理过慢时批次会被丢弃；
+// 之后的差异无法应用到监听者持有的版本上，监听者应改用批次中的完整视图。游戏主循环退出后通道被关闭。
+func (ge *GameEngine) ViewUpdates() <-chan *ViewBatch {
+	return ge.viewChan
+}
+
+// GetPublishedView 获取玩家最新发布的视图，用于向新连接发送完整视图。
+// 尚未发布过视图或引擎停止后返回 nil。
+func (ge *GameEngine) GetPublishedView(playerID int32) *PublishedView {
+	responseChan := make(chan *PublishedView)
+	select {
+	case ge.engineChan <- &getPublishedViewRequest{playerID: playerID, responseChan: responseChan}:
+	case <-ge.stopChan:
+		return nil
+	}
+	select {
+	case view := <-responseChan:
+		return view
+	case <-ge.stopChan:
+		return nil
+	}
+}
+
+// markViewsDirty 标记玩家视图可能已经变化，需要在本 tick 结束时重新计算。
+func (ge *GameEngine) markViewsDirty() {
+	ge.viewsDirty = true
+}
+
+// publishViews 在视图可能发生变化时重新计算每个玩家和每种旁观者的视图，并将变化的视图作为一个批次发布。
+// 阶段转换不产生事件，因此当前阶段变化时也会重新计算。
+// 此方法不是线程安全的，必须仅在 runGameLoop goroutine 中调用。
+func (ge *GameEngine) publishViews() {
+	if !ge.viewsDirty && ge.GameState.CurrentPhase == ge.publishedPhase {
+		return
+	}
+	ge.viewsDirty = false
+	ge.publishedPhase = ge.GameState.CurrentPhase
+
+	version := ge.viewVersion + 1
+	batch := &ViewBatch{
+		Tick:       ge.GameState.Tick,
+		Views:      make(map[int32]*PublishedView),
+		Spectators: make(map[visibility.Viewer]*model.PlayerView),
+	}
+	for playerID := range ge.GameState.Players {
+		view := ge.GeneratePlayerView(playerID)
+		prev := ge.publishedViews[playerID]
+		var delta *model.ViewDelta
+		if prev == nil {
+			delta = viewdiff.Diff(nil, view)
+		} else {
+			delta = viewdiff.Diff(prev.View, view)
+			if viewdiff.IsEmpty(delta) {
+				continue
+			}
+			delta.BaseVersion = prev.Version
+		}
+		delta.Version = version
+		published := &PublishedView{Version: version, View: view, Delta: delta}
+		ge.publishedViews[playerID] = published
+		batch.Views[playerID] = published
+	}
+	for v := range ge.spectatorEvents {
+		view := ge.GenerateSpectatorView(v)
+		if prev := ge.spectatorViews[v]; prev != nil && viewdiff.IsEmpty(viewdiff.Diff(prev, view)) {
+			continue
+		}
+		ge.spectatorViews[v] = view
+		batch.Spectators[v] = view
+	}
+	if len(batch.Views) == 0 && len(batch.Spectators) == 0 {
+		return
+	}
+	ge.viewVersion = version
+
+	select {
+	case ge.viewChan <- batch:
+	default:
+		viewBatchesDropped.Inc()
+		ge.logger.Warn("View channel full, dropping view batch", zap.Uint64("version", version))
+	}
+}
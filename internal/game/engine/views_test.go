@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine/viewdiff"
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// nextViewBatch 等待引擎发布下一个视图批次。
+func nextViewBatch(t *testing.T, engine *GameEngine) *ViewBatch {
+	t.Helper()
+	select {
+	case batch := <-engine.ViewUpdates():
+		return batch
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a view batch")
+		return nil
+	}
+}
+
+func TestEngine_PublishViews(t *testing.T) {
+	engine := helper_NewGameEngineForTest(t)
+	engine.Start()
+	defer engine.Stop()
+
+	// 第一个批次包含每个玩家相对空视图的差异。
+	first := nextViewBatch(t, engine)
+	require.Len(t, first.Views, len(engine.GameState.Players))
+	views := make(map[int32]*PublishedView, len(first.Views))
+	for playerID, pv := range first.Views {
+		assert.Zero(t, pv.Delta.BaseVersion)
+		views[playerID] = pv
+	}
+
+	// 之后的差异以上一个发布版本为基准，应用后得到新的完整视图。
+	engine.SetPlayerConnected(2, false)
+	for !views[2].View.Paused {
+		batch := nextViewBatch(t, engine)
+		require.NotEmpty(t, batch.Views)
+		for playerID, pv := range batch.Views {
+			prev := views[playerID]
+			assert.Equal(t, prev.Version, pv.Delta.BaseVersion)
+			assert.Greater(t, pv.Version, prev.Version)
+
+			view := proto.Clone(prev.View).(*v1.PlayerView)
+			assert.NoError(t, viewdiff.Apply(view, pv.Delta))
+			assert.True(t, proto.Equal(pv.View, view), "player %d", playerID)
+			views[playerID] = pv
+		}
+	}
+
+	latest := engine.GetPublishedView(2)
+	require.NotNil(t, latest)
+	assert.Equal(t, views[2].Version, latest.Version)
+}
+
+// 延迟发送给旁观者的视图必须保持发布时的内容，不随之后的游戏状态变化。
+func TestEngine_PublishSpectatorViews(t *testing.T) {
+	engine := helper_NewGameEngineForTest(t)
+	engine.Start()
+	defer engine.Stop()
+
+	full := visibility.SpectatorViewer(true)
+	first := nextViewBatch(t, engine)
+	require.Contains(t, first.Spectators, full)
+	require.Contains(t, first.Spectators, visibility.SpectatorViewer(false))
+	published := first.Spectators[full]
+	saved := proto.Clone(published).(*v1.PlayerView)
+
+	paranoia := int32(v1.StatType_STAT_TYPE_PARANOIA)
+	var charID int32
+	require.NoError(t, engine.runAdmin(func() error {
+		for id, char := range engine.GameState.Characters {
+			if char.Stats == nil {
+				char.Stats = make(map[int32]int32)
+			}
+			char.Stats[paranoia] += 3
+			charID = id
+			break
+		}
+		return nil
+	}))
+
+	var latest *v1.PlayerView
+	for latest == nil {
+		latest = nextViewBatch(t, engine).Spectators[full]
+	}
+	assert.Equal(t, saved.Characters[charID].GetStats()[paranoia]+3, latest.Characters[charID].GetStats()[paranoia])
+	assert.True(t, proto.Equal(saved, published), "a published view must not change with the game state")
+}
@@ -0,0 +1,257 @@
+package visibility
+
+import (
+	"maps"
+	"slices"
+	"sort"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ScriptInfo 是过滤器需要的剧本信息，loader.ScriptConfig 满足此接口。
+type ScriptInfo interface {
+	GetRole(id int32) *model.RoleConfig
+	GetRoleMap() map[int32]*model.RoleConfig
+	GetIncident(id int32) *model.IncidentConfig
+	PrivateInfo() *model.PrivateConfig
+	PublicSheet() *model.PublicScriptSheet
+	PrivateSheet() *model.PrivateScriptSheet
+}
+
+// Filter 按 Policy 从 GameState 中为每个观察者裁剪信息。
+// Filter 本身不持有可变状态，但传入的 GameState 必须只在引擎主循环中读取。
+// 生成的视图复制了游戏状态中所有可变的数据，可以交给其他 goroutine 读取；只有剧本表等不变的配置是共享的。
+type Filter struct {
+	script         ScriptInfo
+	roleAbilityIDs map[int32]bool
+
+	// 剧本表在创建过滤器时生成一次，之后每个视图共享。
+	publicSheet  *model.PublicScriptSheet
+	privateSheet *model.PrivateScriptSheet
+}
+
+// NewFilter 创建一个新的过滤器。
+func NewFilter(script ScriptInfo) *Filter {
+	f := &Filter{
+		script:         script,
+		roleAbilityIDs: make(map[int32]bool),
+		publicSheet:    script.PublicSheet(),
+		privateSheet:   script.PrivateSheet(),
+	}
+	for _, role := range script.GetRoleMap() {
+		for id := range role.GetAbilities() {
+			f.roleAbilityIDs[id] = true
+		}
+	}
+	return f
+}
+
+// IsRoleAbility 报告能力是否来自隐藏身份。
+func (f *Filter) IsRoleAbility(abilityID int32) bool {
+	return f.roleAbilityIDs[abilityID]
+}
+
+// View 为观察者生成过滤后的游戏视图。
+func (f *Filter) View(v Viewer, gs *model.GameState) *model.PlayerView {
+	view := &model.PlayerView{
+		GameId:         gs.GetGameId(),
+		Tick:           gs.GetTick(),
+		CurrentLoop:    gs.GetCurrentLoop(),
+		CurrentDay:     gs.GetCurrentDay(),
+		CurrentPhase:   gs.GetCurrentPhase(),
+		Characters:     make(map[int32]*model.PlayerViewCharacter, len(gs.GetCharacters())),
+		Players:        make(map[int32]*model.PlayerViewPlayer, len(gs.GetPlayers())),
+		LoopCount:      gs.GetLoopCount(),
+		LoopsRemaining: max(gs.GetLoopCount()-gs.GetCurrentLoop(), 0),
+		PublicSheet:    f.publicSheet,
+		PlayedCards:    f.PlayedCards(v, gs),
+		Incidents:      f.Incidents(v, gs),
+		Paused:         gs.GetPaused(),
+	}
+	if v.canSee(ItemPrivateSheet, 0) {
+		view.PrivateSheet = f.privateSheet
+	}
+
+	for id, char := range gs.GetCharacters() {
+		view.Characters[id] = f.Character(v, gs, char)
+	}
+
+	for id, p := range gs.GetPlayers() {
+		viewPlayer := &model.PlayerViewPlayer{
+			Id:           id,
+			Name:         p.GetName(),
+			Role:         p.GetRole(),
+			Disconnected: gs.GetDisconnectedPlayers()[id],
+		}
+		if v.canSee(ItemHandSize, id) {
+			viewPlayer.HandSize = int32(len(p.GetHand().GetCards()))
+		}
+		view.Players[id] = viewPlayer
+	}
+
+	if player, ok := gs.GetPlayers()[v.PlayerID]; ok {
+		if v.canSee(ItemHand, player.GetId()) {
+			view.YourHand = cloneAll(player.GetHand().GetCards())
+		}
+		if player.GetRole() == model.PlayerRole_PLAYER_ROLE_PROTAGONIST && v.canSee(ItemDeductions, player.GetId()) && player.GetDeductionKnowledge() != nil {
+			view.YourDeductions = proto.Clone(player.GetDeductionKnowledge()).(*model.PlayerDeductionKnowledge)
+		}
+	}
+	return view
+}
+
+// Character 返回观察者可见的角色信息。
+func (f *Filter) Character(v Viewer, gs *model.GameState, char *model.Character) *model.PlayerViewCharacter {
+	id := char.GetConfig().GetId()
+	roleVisible := gs.GetRevealedRoles()[id] || v.canSee(ItemHiddenRole, 0)
+
+	viewChar := &model.PlayerViewCharacter{
+		Id:              id,
+		Name:            char.GetConfig().GetName(),
+		Traits:          slices.Clone(char.GetTraits()),
+		CurrentLocation: char.GetCurrentLocation(),
+		Stats:           maps.Clone(char.GetStats()),
+		IsAlive:         char.GetIsAlive(),
+		InPanicMode:     char.GetInPanicMode(),
+	}
+	if v.canSee(ItemCharacterRules, 0) {
+		viewChar.Rules = char.GetConfig().GetRules()
+	}
+	if roleVisible && char.GetHiddenRoleId() != 0 {
+		viewChar.RoleId = char.GetHiddenRoleId()
+		viewChar.RoleName = f.script.GetRole(char.GetHiddenRoleId()).GetName()
+	}
+
+	for _, ability := range char.GetAbilities() {
+		if f.IsRoleAbility(ability.GetConfig().GetId()) {
+			if !roleVisible && !v.canSee(ItemRoleAbility, 0) {
+				continue
+			}
+		} else if !v.canSee(ItemCharacterAbility, 0) {
+			continue
+		}
+		viewChar.Abilities = append(viewChar.Abilities, proto.Clone(ability).(*model.Ability))
+	}
+	return viewChar
+}
+
+// PlayedCards 返回本日已打出的卡牌。揭示前，非持有者只能看到背面。
+func (f *Filter) PlayedCards(v Viewer, gs *model.GameState) []*model.PlayerViewPlayedCard {
+	revealed := cardsRevealed(gs.GetCurrentPhase())
+	var played []*model.PlayerViewPlayedCard
+	for _, playerID := range sortedIDs(gs.GetPlayedCardsThisDay()) {
+		for _, card := range gs.GetPlayedCardsThisDay()[playerID].GetCards() {
+			item := ItemUnrevealedCard
+			if revealed {
+				item = ItemRevealedCard
+			}
+			if v.canSee(item, playerID) {
+				played = append(played, &model.PlayerViewPlayedCard{PlayerId: playerID, Card: proto.Clone(card).(*model.Card), FaceDown: !revealed})
+			} else {
+				played = append(played, &model.PlayerViewPlayedCard{PlayerId: playerID, FaceDown: true})
+			}
+		}
+	}
+	return played
+}
+
+// Incidents 返回剧本中的预定事件，罪魁祸首仅对主谋可见。
+func (f *Filter) Incidents(v Viewer, gs *model.GameState) []*model.PlayerViewIncident {
+	if !v.canSee(ItemIncidentSchedule, 0) {
+		return nil
+	}
+
+	// 同一天可能有多个事件，因此按事件 ID 查找罪魁祸首。
+	culprits := make(map[int32]string)
+	if v.canSee(ItemIncidentCulprit, 0) {
+		for _, incident := range f.privateSheet.GetIncidents() {
+			if incident.GetIncidentId() != 0 {
+				culprits[incident.GetIncidentId()] = incident.GetCulprit()
+			}
+		}
+	}
+
+	var incidents []*model.PlayerViewIncident
+	for _, id := range f.script.PrivateInfo().GetIncidentIds() {
+		config := f.script.GetIncident(id)
+		if config == nil {
+			continue
+		}
+		incidents = append(incidents, &model.PlayerViewIncident{
+			Id:        id,
+			Name:      config.GetName(),
+			Day:       config.GetDay(),
+			Triggered: gs.GetTriggeredIncidents()[id],
+			Culprit:   culprits[id],
+		})
+	}
+	return incidents
+}
+
+// Event 返回观察者可见的事件副本；如果整个事件对观察者不可见，则返回 nil。
+// 原事件不会被修改。
+func (f *Filter) Event(v Viewer, event *model.GameEvent) *model.GameEvent {
+	if event == nil {
+		return nil
+	}
+	redacted := proto.Clone(event).(*model.GameEvent)
+
+	hideRoleAbility := false
+	if abilityID, ok := redacted.GetCause().GetCauseType().(*model.Cause_AbilityId); ok &&
+		f.IsRoleAbility(abilityID.AbilityId) && !v.canSee(ItemRoleAbility, 0) {
+		// 身份能力的来源会暴露隐藏身份。
+		redacted.Cause = nil
+		hideRoleAbility = true
+	}
+
+	switch payload := redacted.GetPayload().GetPayload().(type) {
+	case *model.EventPayload_CardPlayed:
+		if !v.canSee(ItemUnrevealedCard, payload.CardPlayed.GetPlayerId()) {
+			payload.CardPlayed.Card = nil
+		}
+	case *model.EventPayload_PlayerActionTaken:
+		if playCard := payload.PlayerActionTaken.GetAction().GetPlayCard(); playCard != nil &&
+			!v.canSee(ItemUnrevealedCard, payload.PlayerActionTaken.GetPlayerId()) {
+			payload.PlayerActionTaken.Action.Payload = &model.PlayerActionPayload_PlayCard{PlayCard: &model.PlayCardPayload{}}
+		}
+	case *model.EventPayload_ChoiceRequired:
+		if !v.canSee(ItemChoiceRequest, payload.ChoiceRequired.GetPlayerId()) {
+			return nil
+		}
+	case *model.EventPayload_IncidentTriggered:
+		if !v.canSee(ItemIncidentCulprit, 0) {
+			payload.IncidentTriggered.CulpritCharacterId = 0
+		}
+	case *model.EventPayload_AbilityUsed:
+		if hideRoleAbility {
+			payload.AbilityUsed.AbilityName = ""
+		}
+	case *model.EventPayload_ChatMessage:
+		if payload.ChatMessage.GetChannel() == model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS && !v.canSee(ItemProtagonistChat, 0) {
+			return nil
+		}
+	}
+	return redacted
+}
+
+// cloneAll 返回消息列表的深拷贝。
+func cloneAll[M proto.Message](msgs []M) []M {
+	if msgs == nil {
+		return nil
+	}
+	out := make([]M, len(msgs))
+	for i, m := range msgs {
+		out[i] = proto.Clone(m).(M)
+	}
+	return out
+}
+
+func sortedIDs[V any](m map[int32]V) []int32 {
+	ids := make([]int32, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
@@ -0,0 +1,137 @@
+// Package visibility 定义了游戏信息对不同玩家的可见性策略，
+// 并据此从权威的 GameState 生成每个玩家的 PlayerView。
+//
+// 所有发往客户端或 AI 玩家的信息都必须经过本包过滤，
+// 以保证隐藏身份、罪魁祸首和未揭示的卡牌不会泄露给主角。
+package visibility
+
+import (
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// Level 描述一条信息对谁可见。
+type Level int
+
+const (
+	// Public 表示所有玩家（包括旁观者）都可见。
+	Public Level = iota
+	// MastermindOnly 表示仅主谋可见。
+	MastermindOnly
+	// OwnerOnly 表示仅信息的持有者可见（例如手牌）。
+	OwnerOnly
+	// ProtagonistsOnly 表示主角和旁观者可见，主谋不可见（例如主角频道的聊天）。
+	ProtagonistsOnly
+)
+
+func (l Level) String() string {
+	switch l {
+	case Public:
+		return "public"
+	case MastermindOnly:
+		return "mastermind-only"
+	case OwnerOnly:
+		return "owner-only"
+	case ProtagonistsOnly:
+		return "protagonists-only"
+	default:
+		return "unknown"
+	}
+}
+
+// Item 标识一类受可见性策略约束的信息。
+type Item int
+
+const (
+	ItemCharacterState   Item = iota // 角色的位置、属性、特征、存活状态
+	ItemCharacterAbility             // 角色卡上的能力（例如好感能力）
+	ItemCharacterRules               // 角色卡上的特殊规则
+	ItemHiddenRole                   // 角色的隐藏身份
+	ItemRoleAbility                  // 来自隐藏身份的能力
+	ItemHand                         // 玩家手牌
+	ItemHandSize                     // 玩家手牌数量
+	ItemUnrevealedCard               // 已打出但尚未揭示的卡牌
+	ItemRevealedCard                 // 已揭示的卡牌
+	ItemIncidentSchedule             // 事件的名称与日期
+	ItemIncidentCulprit              // 事件的罪魁祸首
+	ItemChoiceRequest                // 发给某个玩家的选择请求
+	ItemDeductions                   // 主角的推理记录
+	ItemProtagonistChat              // 主角频道的聊天消息
+	ItemPrivateSheet                 // 私有剧本表
+)
+
+// Policy 是每类信息的可见级别。它是本包所有过滤逻辑的唯一依据。
+// 身份被公开（RevealedRoles）的角色，其 ItemHiddenRole 与 ItemRoleAbility 视为 Public。
+var Policy = map[Item]Level{
+	ItemCharacterState:   Public,
+	ItemCharacterAbility: Public,
+	ItemCharacterRules:   Public,
+	ItemHiddenRole:       MastermindOnly,
+	ItemRoleAbility:      MastermindOnly,
+	ItemHand:             OwnerOnly,
+	ItemHandSize:         Public,
+	ItemUnrevealedCard:   OwnerOnly,
+	ItemRevealedCard:     Public,
+	ItemIncidentSchedule: Public,
+	ItemIncidentCulprit:  MastermindOnly,
+	ItemChoiceRequest:    OwnerOnly,
+	ItemDeductions:       OwnerOnly,
+	ItemProtagonistChat:  ProtagonistsOnly,
+	ItemPrivateSheet:     MastermindOnly,
+}
+
+// Viewer 是接收信息的一方。旁观者使用 PlayerID 0 和主角身份。
+type Viewer struct {
+	PlayerID int32
+	Role     model.PlayerRole
+}
+
+// SpectatorViewer 返回没有席位的旁观者对应的 Viewer。
+// full 为 true 时旁观者获得主谋级别的信息，但仍看不到任何玩家的手牌或选择请求。
+func SpectatorViewer(full bool) Viewer {
+	if full {
+		return Viewer{Role: model.PlayerRole_PLAYER_ROLE_MASTERMIND}
+	}
+	return Viewer{Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}
+}
+
+// ViewerFor 返回玩家对应的 Viewer。
+func ViewerFor(player *model.Player) Viewer {
+	return Viewer{PlayerID: player.GetId(), Role: player.GetRole()}
+}
+
+// IsMastermind 报告观察者是否为主谋。
+func (v Viewer) IsMastermind() bool {
+	return v.Role == model.PlayerRole_PLAYER_ROLE_MASTERMIND
+}
+
+// CanSee 报告观察者能否看到由 ownerID 持有、可见级别为 level 的信息。
+// 对于没有持有者的信息，ownerID 传 0。
+func (v Viewer) CanSee(level Level, ownerID int32) bool {
+	switch level {
+	case Public:
+		return true
+	case MastermindOnly:
+		return v.IsMastermind()
+	case OwnerOnly:
+		return v.PlayerID != 0 && v.PlayerID == ownerID
+	case ProtagonistsOnly:
+		return !v.IsMastermind() || v.PlayerID == 0
+	default:
+		return false
+	}
+}
+
+// canSee 按 Policy 判断观察者能否看到某类信息。
+func (v Viewer) canSee(item Item, ownerID int32) bool {
+	level, ok := Policy[item]
+	if !ok {
+		return false
+	}
+	return v.CanSee(level, ownerID)
+}
+
+// cardsRevealed 报告当天打出的牌在当前阶段是否已经揭示。
+// 卡牌在卡牌揭示阶段翻开，并在当天结束前保持公开。
+func cardsRevealed(phase model.GamePhase) bool {
+	return phase >= model.GamePhase_GAME_PHASE_CARD_REVEAL && phase <= model.GamePhase_GAME_PHASE_DAY_END
+}
@@ -0,0 +1,406 @@
+package visibility
+
+import (
+	"testing"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	mastermindID   int32 = 1
+	protagonistAID int32 = 2
+	protagonistBID int32 = 3
+
+	killerRoleID     int32 = 3001
+	killerAbilityID  int32 = 9001
+	goodwillAbility  int32 = 9101
+	officeWorkerID   int32 = 5001
+	murderIncidentID int32 = 4001
+	rumorIncidentID  int32 = 4002
+)
+
+// fakeScript 是测试用的剧本信息。
+type fakeScript struct{}
+
+func (fakeScript) GetRole(id int32) *model.RoleConfig { return fakeRoles()[id] }
+
+func (fakeScript) GetRoleMap() map[int32]*model.RoleConfig { return fakeRoles() }
+
+func (fakeScript) GetIncident(id int32) *model.IncidentConfig {
+	switch id {
+	case murderIncidentID:
+		return &model.IncidentConfig{Id: murderIncidentID, Name: "Murder", Day: 3}
+	case rumorIncidentID:
+		return &model.IncidentConfig{Id: rumorIncidentID, Name: "Rumor", Day: 3}
+	}
+	return nil
+}
+
+func (fakeScript) PrivateInfo() *model.PrivateConfig {
+	return &model.PrivateConfig{IncidentIds: []int32{murderIncidentID, rumorIncidentID}}
+}
+
+func (fakeScript) PublicSheet() *model.PublicScriptSheet {
+	return &model.PublicScriptSheet{
+		ScriptName: "Test Script",
+		Incidents:  []*model.ScriptSheetIncident{{Day: 3, Incident: "Murder"}, {Day: 3, Incident: "Rumor"}},
+	}
+}
+
+func (fakeScript) PrivateSheet() *model.PrivateScriptSheet {
+	return &model.PrivateScriptSheet{
+		MainPlot: "SecretMainPlot",
+		Cast:     []*model.ScriptSheetCast{{Character: "Office Worker", Role: "SecretKiller"}},
+		Incidents: []*model.ScriptSheetIncident{
+			{Day: 3, Incident: "Murder", Culprit: "SecretCulprit", IncidentId: murderIncidentID},
+			{Day: 3, Incident: "Rumor", Culprit: "SecretRumorCulprit", IncidentId: rumorIncidentID},
+		},
+	}
+}
+
+func fakeRoles() map[int32]*model.RoleConfig {
+	return map[int32]*model.RoleConfig{
+		killerRoleID: {
+			Id:        killerRoleID,
+			Name:      "SecretKiller",
+			Abilities: map[int32]*model.AbilityConfig{killerAbilityID: {Id: killerAbilityID, Name: "SecretKillerAbility"}},
+		},
+	}
+}
+
+func card(id int32, name string) *model.Card {
+	return &model.Card{Config: &model.CardConfig{Id: id, Name: name}}
+}
+
+func newGameState(phase model.GamePhase) *model.GameState {
+	return &model.GameState{
+		GameId:       "game",
+		CurrentLoop:  1,
+		CurrentDay:   3,
+		LoopCount:    4,
+		CurrentPhase: phase,
+		Characters: map[int32]*model.Character{
+			officeWorkerID: {
+				Config:       &model.CharacterConfig{Id: officeWorkerID, Name: "Office Worker"},
+				HiddenRoleId: killerRoleID,
+				IsAlive:      true,
+				Stats:        map[int32]int32{int32(model.StatType_STAT_TYPE_PARANOIA): 2},
+				Abilities: []*model.Ability{
+					{Config: &model.AbilityConfig{Id: goodwillAbility, Name: "PublicGoodwillAbility"}},
+					{Config: &model.AbilityConfig{Id: killerAbilityID, Name: "SecretKillerAbility"}},
+				},
+			},
+		},
+		Players: map[int32]*model.Player{
+			mastermindID: {
+				Id: mastermindID, Name: "mm", Role: model.PlayerRole_PLAYER_ROLE_MASTERMIND,
+				Hand: &model.CardList{Cards: []*model.Card{card(6001, "SecretMastermindHandCard")}},
+			},
+			protagonistAID: {
+				Id: protagonistAID, Name: "a", Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST,
+				Hand:               &model.CardList{Cards: []*model.Card{card(7001, "HandCardA")}},
+				DeductionKnowledge: &model.PlayerDeductionKnowledge{Clues: []string{"ClueOfA"}},
+			},
+			protagonistBID: {
+				Id: protagonistBID, Name: "b", Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST,
+				Hand:               &model.CardList{Cards: []*model.Card{card(7002, "HandCardB")}},
+				DeductionKnowledge: &model.PlayerDeductionKnowledge{Clues: []string{"ClueOfB"}},
+			},
+		},
+		PlayedCardsThisDay: map[int32]*model.CardList{
+			mastermindID:   {Cards: []*model.Card{card(6002, "SecretMastermindPlayedCard")}},
+			protagonistAID: {Cards: []*model.Card{card(7003, "PlayedCardA")}},
+		},
+		TriggeredIncidents: map[int32]bool{},
+		RevealedRoles:      map[int32]bool{},
+	}
+}
+
+var (
+	mastermind   = Viewer{PlayerID: mastermindID, Role: model.PlayerRole_PLAYER_ROLE_MASTERMIND}
+	protagonistA = Viewer{PlayerID: protagonistAID, Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}
+	protagonistB = Viewer{PlayerID: protagonistBID, Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}
+	spectator    = Viewer{Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}
+)
+
+// secrets 是任何主角视图中都绝不能出现的字符串。
+var secrets = []string{
+	"SecretKiller",
+	"SecretKillerAbility",
+	"SecretCulprit",
+	"SecretRumorCulprit",
+	"SecretMainPlot",
+	"SecretMastermindHandCard",
+	"SecretMastermindPlayedCard",
+}
+
+func TestCanSee(t *testing.T) {
+	tests := []struct {
+		name    string
+		viewer  Viewer
+		level   Level
+		ownerID int32
+		want    bool
+	}{
+		{"public to protagonist", protagonistA, Public, 0, true},
+		{"public to spectator", spectator, Public, 0, true},
+		{"mastermind-only to mastermind", mastermind, MastermindOnly, 0, true},
+		{"mastermind-only to protagonist", protagonistA, MastermindOnly, 0, false},
+		{"mastermind-only to spectator", spectator, MastermindOnly, 0, false},
+		{"owner-only to owner", protagonistA, OwnerOnly, protagonistAID, true},
+		{"owner-only to teammate", protagonistB, OwnerOnly, protagonistAID, false},
+		{"owner-only to mastermind", mastermind, OwnerOnly, protagonistAID, false},
+		{"owner-only to spectator", spectator, OwnerOnly, 0, false},
+		{"protagonists-only to protagonist", protagonistB, ProtagonistsOnly, 0, true},
+		{"protagonists-only to mastermind", mastermind, ProtagonistsOnly, 0, false},
+		{"protagonists-only to spectator", spectator, ProtagonistsOnly, 0, true},
+		{"protagonists-only to full spectator", SpectatorViewer(true), ProtagonistsOnly, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.viewer.CanSee(tt.level, tt.ownerID))
+		})
+	}
+}
+
+func TestProtagonistViewNeverLeaksSecrets(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+	phases := []model.GamePhase{
+		model.GamePhase_GAME_PHASE_MASTERMIND_CARD_PLAY,
+		model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY,
+		model.GamePhase_GAME_PHASE_INCIDENTS,
+	}
+
+	for _, phase := range phases {
+		for name, viewer := range map[string]Viewer{"protagonist A": protagonistA, "protagonist B": protagonistB, "spectator": spectator} {
+			t.Run(phase.String()+"/"+name, func(t *testing.T) {
+				view := filter.View(viewer, newGameState(phase))
+
+				data, err := protojson.Marshal(view)
+				assert.NoError(t, err)
+				for _, secret := range secrets {
+					if phase == model.GamePhase_GAME_PHASE_INCIDENTS && secret == "SecretMastermindPlayedCard" {
+						continue // 揭示后打出的牌是公开信息
+					}
+					assert.NotContains(t, string(data), secret)
+				}
+
+				char := view.Characters[officeWorkerID]
+				assert.Zero(t, char.GetRoleId())
+				assert.Empty(t, char.GetRoleName())
+				if assert.Len(t, char.GetAbilities(), 1) {
+					assert.Equal(t, goodwillAbility, char.GetAbilities()[0].GetConfig().GetId())
+				}
+				assert.Equal(t, int32(2), char.GetStats()[int32(model.StatType_STAT_TYPE_PARANOIA)])
+				assert.Nil(t, view.GetPrivateSheet())
+				for _, incident := range view.GetIncidents() {
+					assert.Empty(t, incident.GetCulprit())
+				}
+			})
+		}
+	}
+}
+
+func TestPlayedCardsAndHands(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+
+	tests := []struct {
+		name         string
+		viewer       Viewer
+		phase        model.GamePhase
+		wantHand     []string
+		wantVisible  []string
+		wantFaceDown int
+		wantClues    []string
+	}{
+		{"owner sees own face-down card", protagonistA, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY, []string{"HandCardA"}, []string{"PlayedCardA"}, 2, []string{"ClueOfA"}},
+		{"teammate sees nothing before reveal", protagonistB, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY, []string{"HandCardB"}, nil, 2, []string{"ClueOfB"}},
+		{"mastermind sees only own card before reveal", mastermind, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY, []string{"SecretMastermindHandCard"}, []string{"SecretMastermindPlayedCard"}, 2, nil},
+		{"spectator has no hand", spectator, model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY, nil, nil, 2, nil},
+		{"everyone sees cards after reveal", protagonistB, model.GamePhase_GAME_PHASE_CARD_RESOLVE, []string{"HandCardB"}, []string{"SecretMastermindPlayedCard", "PlayedCardA"}, 0, []string{"ClueOfB"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view := filter.View(tt.viewer, newGameState(tt.phase))
+
+			var hand []string
+			for _, c := range view.GetYourHand() {
+				hand = append(hand, c.GetConfig().GetName())
+			}
+			assert.Equal(t, tt.wantHand, hand)
+
+			var visible []string
+			faceDown := 0
+			for _, played := range view.GetPlayedCards() {
+				if played.GetCard() != nil {
+					visible = append(visible, played.GetCard().GetConfig().GetName())
+				}
+				if played.GetFaceDown() {
+					faceDown++
+				}
+			}
+			assert.Equal(t, tt.wantVisible, visible)
+			assert.Equal(t, tt.wantFaceDown, faceDown)
+			assert.Equal(t, tt.wantClues, view.GetYourDeductions().GetClues())
+			assert.Equal(t, int32(1), view.GetPlayers()[mastermindID].GetHandSize())
+		})
+	}
+}
+
+func TestMastermindViewIncludesSecrets(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+	view := filter.View(mastermind, newGameState(model.GamePhase_GAME_PHASE_INCIDENTS))
+
+	char := view.Characters[officeWorkerID]
+	assert.Equal(t, killerRoleID, char.GetRoleId())
+	assert.Equal(t, "SecretKiller", char.GetRoleName())
+	assert.Len(t, char.GetAbilities(), 2)
+	assert.Equal(t, "SecretMainPlot", view.GetPrivateSheet().GetMainPlot())
+	// 同一天的两个事件各自显示自己的罪魁祸首。
+	if assert.Len(t, view.GetIncidents(), 2) {
+		assert.Equal(t, "SecretCulprit", view.GetIncidents()[0].GetCulprit())
+		assert.Equal(t, "SecretRumorCulprit", view.GetIncidents()[1].GetCulprit())
+	}
+}
+
+func TestRevealedRoleIsPublic(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+	gs := newGameState(model.GamePhase_GAME_PHASE_DAY_END)
+	gs.RevealedRoles[officeWorkerID] = true
+
+	char := filter.View(protagonistA, gs).Characters[officeWorkerID]
+	assert.Equal(t, killerRoleID, char.GetRoleId())
+	assert.Len(t, char.GetAbilities(), 2)
+}
+
+// 视图在引擎主循环之外读取，因此之后对游戏状态的修改不能反映到已经生成的视图中。
+func TestViewDoesNotShareState(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+	gs := newGameState(model.GamePhase_GAME_PHASE_DAY_END)
+	gs.Characters[officeWorkerID].Traits = []string{"Student"}
+	view := filter.View(mastermind, gs)
+
+	char := gs.Characters[officeWorkerID]
+	char.Stats[int32(model.StatType_STAT_TYPE_PARANOIA)] = 5
+	char.Traits[0] = "Changed"
+	char.Abilities[0].UsedThisLoop = true
+	gs.Players[mastermindID].Hand.Cards[0].Config.Name = "Changed"
+	gs.PlayedCardsThisDay[protagonistAID].Cards[0].Config.Name = "Changed"
+
+	viewChar := view.Characters[officeWorkerID]
+	assert.Equal(t, int32(2), viewChar.GetStats()[int32(model.StatType_STAT_TYPE_PARANOIA)])
+	assert.Equal(t, []string{"Student"}, viewChar.GetTraits())
+	assert.False(t, viewChar.GetAbilities()[0].GetUsedThisLoop())
+	assert.Equal(t, "SecretMastermindHandCard", view.GetYourHand()[0].GetConfig().GetName())
+	for _, played := range view.GetPlayedCards() {
+		assert.NotEqual(t, "Changed", played.GetCard().GetConfig().GetName())
+	}
+}
+
+func TestSpectatorViewer(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+	gs := newGameState(model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY)
+
+	full := filter.View(SpectatorViewer(true), gs)
+	assert.Equal(t, "SecretKiller", full.Characters[officeWorkerID].GetRoleName())
+	assert.Equal(t, "SecretMainPlot", full.GetPrivateSheet().GetMainPlot())
+	assert.Empty(t, full.GetYourHand(), "spectators have no hand")
+	assert.Nil(t, full.GetYourDeductions())
+
+	data, err := protojson.Marshal(full)
+	assert.NoError(t, err)
+	for _, hand := range []string{"SecretMastermindHandCard", "HandCardA", "HandCardB"} {
+		assert.NotContains(t, string(data), hand, "full spectator view must not contain player hands")
+	}
+
+	assert.Equal(t, spectator, SpectatorViewer(false))
+}
+
+func TestEventRedaction(t *testing.T) {
+	filter := NewFilter(fakeScript{})
+
+	cardPlayed := &model.GameEvent{
+		Type: model.GameEventType_GAME_EVENT_TYPE_CARD_PLAYED,
+		Payload: &model.EventPayload{Payload: &model.EventPayload_CardPlayed{
+			CardPlayed: &model.CardPlayedEvent{PlayerId: mastermindID, Card: card(6002, "SecretMastermindPlayedCard")},
+		}},
+	}
+	incident := &model.GameEvent{
+		Type: model.GameEventType_GAME_EVENT_TYPE_INCIDENT_TRIGGERED,
+		Payload: &model.EventPayload{Payload: &model.EventPayload_IncidentTriggered{
+			IncidentTriggered: &model.IncidentTriggeredEvent{CulpritCharacterId: officeWorkerID},
+		}},
+	}
+	choice := &model.GameEvent{
+		Type: model.GameEventType_GAME_EVENT_TYPE_CHOICE_REQUIRED,
+		Payload: &model.EventPayload{Payload: &model.EventPayload_ChoiceRequired{
+			ChoiceRequired: &model.ChoiceRequiredEvent{PlayerId: mastermindID},
+		}},
+	}
+	roleAbility := &model.GameEvent{
+		Type:  model.GameEventType_GAME_EVENT_TYPE_PLAYER_ACTION,
+		Cause: &model.Cause{CauseType: &model.Cause_AbilityId{AbilityId: killerAbilityID}},
+		Payload: &model.EventPayload{Payload: &model.EventPayload_AbilityUsed{
+			AbilityUsed: &model.AbilityUsedEvent{CharacterId: officeWorkerID, AbilityName: "SecretKillerAbility"},
+		}},
+	}
+
+	protagonistChat := &model.GameEvent{
+		Type: model.GameEventType_GAME_EVENT_TYPE_CHAT_MESSAGE,
+		Payload: &model.EventPayload{Payload: &model.EventPayload_ChatMessage{
+			ChatMessage: &model.ChatMessageEvent{PlayerId: protagonistAID, Channel: model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS, Text: "SecretStrategy"},
+		}},
+	}
+
+	tests := []struct {
+		name   string
+		viewer Viewer
+		event  *model.GameEvent
+		check  func(t *testing.T, got *model.GameEvent)
+	}{
+		{"face-down card hidden from protagonist", protagonistA, cardPlayed, func(t *testing.T, got *model.GameEvent) {
+			assert.Equal(t, mastermindID, got.GetPayload().GetCardPlayed().GetPlayerId())
+			assert.Nil(t, got.GetPayload().GetCardPlayed().GetCard())
+		}},
+		{"face-down card visible to owner", mastermind, cardPlayed, func(t *testing.T, got *model.GameEvent) {
+			assert.NotNil(t, got.GetPayload().GetCardPlayed().GetCard())
+		}},
+		{"culprit hidden from protagonist", protagonistA, incident, func(t *testing.T, got *model.GameEvent) {
+			assert.Zero(t, got.GetPayload().GetIncidentTriggered().GetCulpritCharacterId())
+		}},
+		{"culprit visible to mastermind", mastermind, incident, func(t *testing.T, got *model.GameEvent) {
+			assert.Equal(t, officeWorkerID, got.GetPayload().GetIncidentTriggered().GetCulpritCharacterId())
+		}},
+		{"choice request hidden from others", protagonistA, choice, func(t *testing.T, got *model.GameEvent) {
+			assert.Nil(t, got)
+		}},
+		{"protagonist chat hidden from mastermind", mastermind, protagonistChat, func(t *testing.T, got *model.GameEvent) {
+			assert.Nil(t, got)
+		}},
+		{"protagonist chat visible to teammate", protagonistB, protagonistChat, func(t *testing.T, got *model.GameEvent) {
+			assert.Equal(t, "SecretStrategy", got.GetPayload().GetChatMessage().GetText())
+		}},
+		{"role ability source hidden from protagonist", protagonistB, roleAbility, func(t *testing.T, got *model.GameEvent) {
+			assert.Nil(t, got.GetCause())
+			assert.Empty(t, got.GetPayload().GetAbilityUsed().GetAbilityName())
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, filter.Event(tt.viewer, tt.event))
+		})
+	}
+
+	// 原事件不能被修改。
+	assert.NotNil(t, cardPlayed.GetPayload().GetCardPlayed().GetCard())
+	assert.Equal(t, officeWorkerID, incident.GetPayload().GetIncidentTriggered().GetCulpritCharacterId())
+}
+
+func TestPolicyCoversAllItems(t *testing.T) {
+	for item := ItemCharacterState; item <= ItemPrivateSheet; item++ {
+		_, ok := Policy[item]
+		assert.True(t, ok, "item %d has no visibility level", item)
+	}
+}
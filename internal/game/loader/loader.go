@@ -16,8 +16,8 @@ type ScriptConfig interface {
 	GetScript() *v1.ScriptConfig
 	GetModel() *v1.ScriptModel
 
-	PrivateInfo() *v1.PrivateInfo
-	PublicInfo() *v1.PublicInfo
+	PrivateInfo() *v1.PrivateConfig
+	PublicInfo() *v1.PublicConfig
 
 	GetPlot(id int32) *v1.PlotConfig
 	GetPlotMap() map[int32]*v1.PlotConfig
@@ -25,6 +25,8 @@ type ScriptConfig interface {
 	GetRoleMap() map[int32]*v1.RoleConfig
 	GetCard(id int32) *v1.CardConfig
 	GetCardMap() map[int32]*v1.CardConfig
+	GetAbility(id int32) *v1.AbilityConfig
+	GetAbilityMap() map[int32]*v1.AbilityConfig
 
 	GetMainPlot() *v1.PlotConfig
 	GetSubPlot(id int32) *v1.PlotConfig
@@ -36,13 +38,27 @@ type ScriptConfig interface {
 	GetLoopCount() int32
 	GetDaysPerLoop() int32
 	GetCanDiscuss() bool
+
+	// GetDifficultySets returns the (loops, difficulty) pairs offered by the script model.
+	GetDifficultySets() []*v1.DifficultySet
+	// GetDifficultySet returns the selected difficulty set, or nil if none was selected.
+	GetDifficultySet() *v1.DifficultySet
+	// SetDifficultySet selects one of the model's difficulty sets.
+	// It returns an error if the set is not offered by the script model.
+	SetDifficultySet(set *v1.DifficultySet) error
+
+	// PublicSheet returns the public script sheet shown to every player.
+	PublicSheet() *v1.PublicScriptSheet
+	// PrivateSheet returns the mastermind-only script sheet.
+	PrivateSheet() *v1.PrivateScriptSheet
 }
 
 // scriptConfig is the concrete implementation of the ScriptConfig interface.
 // It holds all the loaded script and library data.
 type scriptConfig struct {
-	script  *v1.ScriptConfig
-	modelId int32
+	script        *v1.ScriptConfig
+	modelId       int32
+	difficultySet *v1.DifficultySet
 }
 
 // newRepository creates a new, empty scriptConfig repository.
@@ -105,16 +121,16 @@ func (s *scriptConfig) GetModel() *v1.ScriptModel {
 	return s.script.GetScriptModels()[s.modelId]
 }
 
-func (s *scriptConfig) PrivateInfo() *v1.PrivateInfo {
+func (s *scriptConfig) PrivateInfo() *v1.PrivateConfig {
 	if model := s.GetModel(); model != nil {
-		return model.GetPrivateInfo()
+		return model.GetPrivateConfig()
 	}
 	return nil
 }
 
-func (s *scriptConfig) PublicInfo() *v1.PublicInfo {
+func (s *scriptConfig) PublicInfo() *v1.PublicConfig {
 	if model := s.GetModel(); model != nil {
-		return model.GetPublicInfo()
+		return model.GetPublicConfig()
 	}
 	return nil
 }
@@ -241,7 +257,12 @@ func (s *scriptConfig) GetIncidentMap() map[int32]*v1.IncidentConfig {
 	return s.script.GetIncidents()
 }
 
+// GetLoopCount returns the number of loops for this game. The selected difficulty
+// set takes precedence over the loop count in the model's public config.
 func (s *scriptConfig) GetLoopCount() int32 {
+	if s.difficultySet != nil {
+		return s.difficultySet.GetNumberOfLoops()
+	}
 	if publicInfo := s.PublicInfo(); publicInfo != nil {
 		return publicInfo.GetLoopCount()
 	}
@@ -261,3 +282,29 @@ func (s *scriptConfig) GetCanDiscuss() bool {
 	}
 	return false
 }
+
+func (s *scriptConfig) GetDifficultySets() []*v1.DifficultySet {
+	return s.GetModel().GetMetadata().GetDifficultySets()
+}
+
+func (s *scriptConfig) GetDifficultySet() *v1.DifficultySet {
+	return s.difficultySet
+}
+
+func (s *scriptConfig) SetDifficultySet(set *v1.DifficultySet) error {
+	if set == nil {
+		s.difficultySet = nil
+		return nil
+	}
+	if s.GetModel() == nil {
+		return fmt.Errorf("script model %d not found", s.modelId)
+	}
+	for _, available := range s.GetDifficultySets() {
+		if available.GetNumberOfLoops() == set.GetNumberOfLoops() && available.GetDifficulty() == set.GetDifficulty() {
+			s.difficultySet = available
+			return nil
+		}
+	}
+	return fmt.Errorf("difficulty set (loops %d, difficulty %d) is not available for script model %d",
+		set.GetNumberOfLoops(), set.GetDifficulty(), s.modelId)
+}
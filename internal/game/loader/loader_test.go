@@ -47,3 +47,83 @@ func TestLoadConfig(t *testing.T) {
 	incidents := config.GetIncidentMap()
 	assert.NotEmpty(t, incidents)
 }
+
+func TestSetDifficultySet(t *testing.T) {
+	repo := newRepository(8001)
+	repo.script.ScriptModels = map[int32]*v1.ScriptModel{
+		8001: {
+			Id:           8001,
+			PublicConfig: &v1.PublicConfig{LoopCount: 5},
+			Metadata: &v1.ScriptMetadata{
+				DifficultySets: []*v1.DifficultySet{
+					{NumberOfLoops: 4, Difficulty: 2},
+					{NumberOfLoops: 3, Difficulty: 4},
+				},
+			},
+		},
+	}
+
+	// Without a selection, the public config loop count is used.
+	assert.Equal(t, int32(5), repo.GetLoopCount())
+	assert.Nil(t, repo.GetDifficultySet())
+
+	// Selecting an offered set switches the loop count.
+	assert.NoError(t, repo.SetDifficultySet(&v1.DifficultySet{NumberOfLoops: 3, Difficulty: 4}))
+	assert.Equal(t, int32(3), repo.GetLoopCount())
+	assert.Equal(t, int32(4), repo.GetDifficultySet().GetDifficulty())
+
+	// A set that the model does not offer is rejected and keeps the previous selection.
+	assert.Error(t, repo.SetDifficultySet(&v1.DifficultySet{NumberOfLoops: 2, Difficulty: 4}))
+	assert.Equal(t, int32(3), repo.GetLoopCount())
+
+	// Clearing the selection falls back to the public config again.
+	assert.NoError(t, repo.SetDifficultySet(nil))
+	assert.Equal(t, int32(5), repo.GetLoopCount())
+}
+
+func TestScriptSheets(t *testing.T) {
+	repo := newRepository(8001)
+	repo.script.Name = "Basic Tragedy X"
+	repo.script.ScriptModels = map[int32]*v1.ScriptModel{
+		8001: {
+			Id:           8001,
+			PublicConfig: &v1.PublicConfig{LoopCount: 4, DaysPerLoop: 6},
+			Metadata: &v1.ScriptMetadata{
+				Title:          "Young Women's Battlefield",
+				TragedySet:     "basicTragedy",
+				DifficultySets: []*v1.DifficultySet{{NumberOfLoops: 4, Difficulty: 2}},
+				MainPlot:       []string{"signWithMe"},
+				Cast: map[string]*v1.CastAssignment{
+					"officeWorker": {Assignment: &v1.CastAssignment_RoleName{RoleName: "lover"}},
+					"girlStudent":  {Assignment: &v1.CastAssignment_RoleName{RoleName: "friend"}},
+				},
+				Incidents: []*v1.IncidentInstance{
+					{Day: 6, Incident: "suicide", Culprit: "girlStudent"},
+					{Day: 3, Incident: "foulEvil", Culprit: "officeWorker"},
+				},
+				SpecialRules: []string{"No special rules"},
+			},
+		},
+	}
+
+	public := repo.PublicSheet()
+	assert.Equal(t, int32(4), public.GetLoopCount())
+	assert.Equal(t, int32(6), public.GetDaysPerLoop())
+	assert.Equal(t, int32(2), public.GetDifficulty())
+	assert.Equal(t, []string{"girlStudent", "officeWorker"}, public.GetCharacters())
+	if assert.Len(t, public.GetIncidents(), 2) {
+		assert.Equal(t, int32(3), public.GetIncidents()[0].GetDay())
+		for _, incident := range public.GetIncidents() {
+			assert.Empty(t, incident.GetCulprit(), "public sheet must not reveal culprits")
+		}
+	}
+	markdown := PublicSheetMarkdown(public)
+	assert.Contains(t, markdown, "foulEvil")
+	assert.NotContains(t, markdown, "lover")
+
+	private := repo.PrivateSheet()
+	assert.Equal(t, "signWithMe", private.GetMainPlot())
+	assert.Equal(t, "officeWorker", private.GetIncidents()[0].GetCulprit())
+	assert.Len(t, private.GetCast(), 2)
+	assert.Contains(t, PrivateSheetMarkdown(private), "| officeWorker | lover |")
+}
@@ -0,0 +1,257 @@
+package loader
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// PublicSheet builds the public script sheet shown to every player.
+// Culprits, roles and plots are never included.
+func (s *scriptConfig) PublicSheet() *v1.PublicScriptSheet {
+	metadata := s.GetModel().GetMetadata()
+
+	sheet := &v1.PublicScriptSheet{
+		ScriptName:   s.script.GetName(),
+		Title:        metadata.GetTitle(),
+		TragedySet:   metadata.GetTragedySet(),
+		LoopCount:    s.GetLoopCount(),
+		DaysPerLoop:  s.GetDaysPerLoop(),
+		Difficulty:   s.difficulty(),
+		CanDiscuss:   s.GetCanDiscuss(),
+		SpecialRules: metadata.GetSpecialRules(),
+		Characters:   s.castCharacterNames(),
+	}
+	if sheet.DaysPerLoop == 0 {
+		sheet.DaysPerLoop = metadata.GetDaysPerLoop()
+	}
+	for _, incident := range s.sheetIncidents() {
+		sheet.Incidents = append(sheet.Incidents, &v1.ScriptSheetIncident{
+			Day:        incident.GetDay(),
+			Incident:   incident.GetIncident(),
+			IncidentId: incident.GetIncidentId(),
+		})
+	}
+	return sheet
+}
+
+// PrivateSheet builds the mastermind-only script sheet, including the public sheet.
+func (s *scriptConfig) PrivateSheet() *v1.PrivateScriptSheet {
+	metadata := s.GetModel().GetMetadata()
+
+	sheet := &v1.PrivateScriptSheet{
+		PublicSheet:       s.PublicSheet(),
+		Incidents:         s.sheetIncidents(),
+		Cast:              s.sheetCast(),
+		VictoryConditions: metadata.GetVictoryConditions(),
+		Story:             metadata.GetStory(),
+		MastermindHints:   metadata.GetMastermindHints(),
+	}
+
+	if mainPlot := s.GetMainPlot(); mainPlot != nil {
+		sheet.MainPlot = mainPlot.GetName()
+	} else {
+		sheet.MainPlot = strings.Join(metadata.GetMainPlot(), ", ")
+	}
+
+	subPlots := s.GetSubPlotMap()
+	if len(subPlots) > 0 {
+		for _, id := range sortedKeys(subPlots) {
+			sheet.SubPlots = append(sheet.SubPlots, subPlots[id].GetName())
+		}
+	} else {
+		sheet.SubPlots = metadata.GetSubPlots()
+	}
+	return sheet
+}
+
+// difficulty returns the difficulty of the selected set, or of the set matching
+// the loop count when none was selected.
+func (s *scriptConfig) difficulty() int32 {
+	if s.difficultySet != nil {
+		return s.difficultySet.GetDifficulty()
+	}
+	loopCount := s.GetLoopCount()
+	for _, set := range s.GetDifficultySets() {
+		if set.GetNumberOfLoops() == loopCount {
+			return set.GetDifficulty()
+		}
+	}
+	return 0
+}
+
+// sheetIncidents returns the scheduled incidents ordered by day, with culprits.
+// The model metadata is preferred; the incident configs are used as a fallback.
+// Metadata incidents are matched to their config by name and day.
+func (s *scriptConfig) sheetIncidents() []*v1.ScriptSheetIncident {
+	var incidents []*v1.ScriptSheetIncident
+	if instances := s.GetModel().GetMetadata().GetIncidents(); len(instances) > 0 {
+		for _, instance := range instances {
+			incidents = append(incidents, &v1.ScriptSheetIncident{
+				Day:        instance.GetDay(),
+				Incident:   instance.GetIncident(),
+				Culprit:    instance.GetCulprit(),
+				IncidentId: s.incidentID(instance.GetIncident(), instance.GetDay()),
+			})
+		}
+	} else {
+		for _, id := range s.PrivateInfo().GetIncidentIds() {
+			if incident := s.GetIncident(id); incident != nil {
+				incidents = append(incidents, &v1.ScriptSheetIncident{
+					Day:        incident.GetDay(),
+					Incident:   incident.GetName(),
+					IncidentId: id,
+				})
+			}
+		}
+	}
+	sort.SliceStable(incidents, func(i, j int) bool {
+		return incidents[i].GetDay() < incidents[j].GetDay()
+	})
+	return incidents
+}
+
+// incidentID returns the id of the scheduled incident with the given name and day, or 0 if there is none.
+func (s *scriptConfig) incidentID(name string, day int32) int32 {
+	for _, id := range s.PrivateInfo().GetIncidentIds() {
+		if incident := s.GetIncident(id); incident.GetName() == name && incident.GetDay() == day {
+			return id
+		}
+	}
+	return 0
+}
+
+// sheetCast returns the role of every character in the script.
+func (s *scriptConfig) sheetCast() []*v1.ScriptSheetCast {
+	var cast []*v1.ScriptSheetCast
+	if assignments := s.GetModel().GetMetadata().GetCast(); len(assignments) > 0 {
+		for _, name := range sortedKeys(assignments) {
+			assignment := assignments[name]
+			role := assignment.GetRoleName()
+			if role == "" {
+				role = assignment.GetRoleWithExtra().GetRole()
+			}
+			cast = append(cast, &v1.ScriptSheetCast{Character: name, Role: role})
+		}
+		return cast
+	}
+
+	assignments := s.PrivateInfo().GetRoleAssignments()
+	for _, charID := range sortedKeys(assignments) {
+		cast = append(cast, &v1.ScriptSheetCast{
+			Character: s.characterName(charID),
+			Role:      s.roleName(assignments[charID]),
+		})
+	}
+	return cast
+}
+
+// castCharacterNames returns the names of all characters appearing in the script.
+func (s *scriptConfig) castCharacterNames() []string {
+	var names []string
+	if ids := s.PrivateInfo().GetCharactersIds(); len(ids) > 0 {
+		for _, id := range ids {
+			names = append(names, s.characterName(id))
+		}
+		return names
+	}
+	return sortedKeys(s.GetModel().GetMetadata().GetCast())
+}
+
+func (s *scriptConfig) characterName(id int32) string {
+	if char := s.GetCharacter(id); char != nil {
+		return char.GetName()
+	}
+	return fmt.Sprintf("character %d", id)
+}
+
+func (s *scriptConfig) roleName(id int32) string {
+	if role := s.GetRole(id); role != nil {
+		return role.GetName()
+	}
+	return fmt.Sprintf("role %d", id)
+}
+
+func sortedKeys[K int32 | string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// PublicSheetMarkdown renders a public script sheet as Markdown.
+func PublicSheetMarkdown(sheet *v1.PublicScriptSheet) string {
+	var sb strings.Builder
+	title := sheet.GetTitle()
+	if title == "" {
+		title = sheet.GetScriptName()
+	}
+	fmt.Fprintf(&sb, "# %s (Public Sheet)\n\n", title)
+
+	fmt.Fprintf(&sb, "- **Script:** %s\n", sheet.GetScriptName())
+	fmt.Fprintf(&sb, "- **Tragedy Set:** %s\n", sheet.GetTragedySet())
+	fmt.Fprintf(&sb, "- **Loops:** %d\n", sheet.GetLoopCount())
+	fmt.Fprintf(&sb, "- **Days per Loop:** %d\n", sheet.GetDaysPerLoop())
+	if sheet.GetDifficulty() > 0 {
+		fmt.Fprintf(&sb, "- **Difficulty:** %d\n", sheet.GetDifficulty())
+	}
+	fmt.Fprintf(&sb, "- **Discussion Allowed:** %t\n", sheet.GetCanDiscuss())
+	if len(sheet.GetCharacters()) > 0 {
+		fmt.Fprintf(&sb, "- **Characters:** %s\n", strings.Join(sheet.GetCharacters(), ", "))
+	}
+
+	sb.WriteString("\n## Incidents\n\n")
+	sb.WriteString("| Day | Incident |\n|---|---|\n")
+	for _, incident := range sheet.GetIncidents() {
+		fmt.Fprintf(&sb, "| %d | %s |\n", incident.GetDay(), incident.GetIncident())
+	}
+
+	if len(sheet.GetSpecialRules()) > 0 {
+		sb.WriteString("\n## Special Rules\n\n")
+		for _, rule := range sheet.GetSpecialRules() {
+			fmt.Fprintf(&sb, "- %s\n", rule)
+		}
+	}
+	return sb.String()
+}
+
+// PrivateSheetMarkdown renders a private script sheet as Markdown.
+func PrivateSheetMarkdown(sheet *v1.PrivateScriptSheet) string {
+	var sb strings.Builder
+	title := sheet.GetPublicSheet().GetTitle()
+	if title == "" {
+		title = sheet.GetPublicSheet().GetScriptName()
+	}
+	fmt.Fprintf(&sb, "# %s (Private Sheet)\n\n", title)
+
+	fmt.Fprintf(&sb, "- **Main Plot:** %s\n", sheet.GetMainPlot())
+	fmt.Fprintf(&sb, "- **Sub Plots:** %s\n\n", strings.Join(sheet.GetSubPlots(), ", "))
+
+	sb.WriteString("## Cast\n\n")
+	sb.WriteString("| Character | Role |\n|---|---|\n")
+	for _, c := range sheet.GetCast() {
+		fmt.Fprintf(&sb, "| %s | %s |\n", c.GetCharacter(), c.GetRole())
+	}
+
+	sb.WriteString("\n## Incidents\n\n")
+	sb.WriteString("| Day | Incident | Culprit |\n|---|---|---|\n")
+	for _, incident := range sheet.GetIncidents() {
+		fmt.Fprintf(&sb, "| %d | %s | %s |\n", incident.GetDay(), incident.GetIncident(), incident.GetCulprit())
+	}
+
+	writeSection(&sb, "Victory Conditions", sheet.GetVictoryConditions())
+	writeSection(&sb, "Story", sheet.GetStory())
+	writeSection(&sb, "Mastermind Hints", sheet.GetMastermindHints())
+	return sb.String()
+}
+
+func writeSection(sb *strings.Builder, heading, body string) {
+	if body == "" {
+		return
+	}
+	fmt.Fprintf(sb, "\n## %s\n\n%s\n", heading, body)
+}
@@ -1,6 +1,17 @@
 package ticker
 
+import "time"
+
 const (
 	// TicksPerSecond defines the number of game ticks that occur per second.
 	TicksPerSecond = 64
 )
+
+// FromDuration converts a wall-clock duration to game ticks, rounding up so that a positive duration is never zero ticks.
+func FromDuration(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	tick := time.Second / TicksPerSecond
+	return int64((d + tick - 1) / tick)
+}
@@ -2,6 +2,7 @@ package llm
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/constellation39/tragedyLooper/internal/game/engine/ai"
@@ -15,7 +16,9 @@ import (
 // LLMActionGenerator is an implementation of engine.ActionGenerator that uses an LLM.
 type LLMActionGenerator struct {
 	Client Client
-	Logger *zap.Logger
+	// RoleClients optionally overrides Client for players of a role, e.g. a stronger model for the Mastermind.
+	RoleClients map[model.PlayerRole]Client
+	Logger      *zap.Logger
 }
 
 // NewLLMActionGenerator creates a new LLMActionGenerator.
@@ -31,11 +34,7 @@ func (g *LLMActionGenerator) GenerateAction(ctx context.Context, data *ai.Action
 	var prompt string
 
 	if data.Player.Role == model.PlayerRole_PLAYER_ROLE_MASTERMIND {
-		charactersWithStringKeys := make(map[string]*model.Character)
-		for id, char := range data.AllCharacters {
-			charactersWithStringKeys[fmt.Sprint(id)] = char
-		}
-		prompt = pBuilder.BuildMastermindPrompt(data.PlayerView, data.Script, charactersWithStringKeys)
+		prompt = pBuilder.BuildMastermindPrompt(data.PlayerView)
 	} else {
 		deductionKnowledgeWithStringKeys := make(map[string]string)
 		for id, value := range data.Player.DeductionKnowledge.GuessedRoles {
@@ -44,8 +43,9 @@ func (g *LLMActionGenerator) GenerateAction(ctx context.Context, data *ai.Action
 		prompt = pBuilder.BuildProtagonistPrompt(data.PlayerView, deductionKnowledgeWithStringKeys)
 	}
 
-	llmResponse, err := g.Client.GenerateResponse(prompt, data.Player.LlmSessionId)
+	llmResponse, err := g.clientFor(data.Player.Role).GenerateResponse(ctx, prompt, data.Player.LlmSessionId)
 	if err != nil {
+		llmRequests.WithLabelValues(failureResult(ctx)).Inc()
 		g.Logger.Error("LLM call failed", zap.String("player", data.Player.Name), zap.Error(err))
 		return nil, fmt.Errorf("llm call failed for player %s: %w", data.Player.Name, err)
 	}
@@ -53,9 +53,31 @@ func (g *LLMActionGenerator) GenerateAction(ctx context.Context, data *ai.Action
 	responseParser := NewResponseParser()
 	llmAction, err := responseParser.ParseLLMAction(llmResponse)
 	if err != nil {
+		llmRequests.WithLabelValues("unparsable").Inc()
 		g.Logger.Error("Failed to parse LLM response", zap.String("player", data.Player.Name), zap.Error(err))
 		return nil, fmt.Errorf("failed to parse llm response for player %s: %w", data.Player.Name, err)
 	}
 
+	llmRequests.WithLabelValues("ok").Inc()
 	return llmAction, nil
 }
+
+// failureResult returns the llmRequests result label for a failed call.
+func failureResult(ctx context.Context) string {
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		return "canceled"
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "error"
+	}
+}
+
+// clientFor returns the client used for players of the given role.
+func (g *LLMActionGenerator) clientFor(role model.PlayerRole) Client {
+	if client, ok := g.RoleClients[role]; ok {
+		return client
+	}
+	return g.Client
+}
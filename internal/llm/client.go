@@ -1,6 +1,7 @@
 package llm
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"time"
@@ -12,7 +13,8 @@ import (
 
 // LLMClient 定义与 LLM 交互的接口。
 type Client interface {
-	GenerateResponse(prompt string, sessionID string) (string, error)
+	// GenerateResponse 返回 LLM 对提示的回复。实现必须在 ctx 被取消或超过期限时尽快返回 ctx.Err()。
+	GenerateResponse(ctx context.Context, prompt string, sessionID string) (string, error)
 }
 
 // MockLLMClient 是用于测试的模拟实现。
@@ -23,9 +25,13 @@ func NewMockLLMClient() *MockLLMClient {
 }
 
 // GenerateResponse 模拟 LLM 响应。
-func (m *MockLLMClient) GenerateResponse(prompt string, sessionID string) (string, error) {
+func (m *MockLLMClient) GenerateResponse(ctx context.Context, prompt string, sessionID string) (string, error) {
 	log.Printf("MockLLMClient: Received prompt for session %s:\n%s", sessionID, prompt)
-	time.Sleep(500 * time.Millisecond) // 模拟 API 延迟
+	select { // 模拟 API 延迟
+	case <-time.After(500 * time.Millisecond):
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
 
 	// 简单的模拟逻辑：如果提示包含“play card”，则返回卡牌动作。
 	// 实际中，LLM 将解析复杂的游戏状态并做出决策。
@@ -52,56 +58,11 @@ func (m *MockLLMClient) GenerateResponse(prompt string, sessionID string) (strin
 			},
 		}
 		actionBytes, _ := protojson.Marshal(&mockAction)
+		RecordUsage(Usage{PromptTokens: estimateTokens(prompt), CompletionTokens: estimateTokens(string(actionBytes))})
 		return string(actionBytes), nil
 	}
 
-	return "Mock LLM response: I am thinking...", nil
-}
-
-/*
-// OpenAIClient 是使用 OpenAI API 的示例实现。
-type OpenAIClient struct {
-	client *openai.Client
-}
-
-func NewOpenAIClient(apiKey string) *OpenAIClient {
-	return &OpenAIClient{
-		client: openai.NewClient(apiKey),
-	}
-}
-
-func (o *OpenAIClient) GenerateResponse(prompt string, sessionID string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-	defer cancel()
-
-	resp, err := o.client.CreateChatCompletion(
-		ctx,
-		openai.ChatCompletionRequest{
-			Model: openai.GPT3Dot5Turbo, // 或 openai.GPT4 等。
-			Messages: []openai.ChatCompletionMessage{
-				{
-					Role:    openai.ChatMessageRoleSystem,
-					Content: "You are an AI player in Tragedy Looper. Respond with a JSON object representing your action.",
-				},
-				{
-					Role:    openai.ChatMessageRoleUser,
-					Content: prompt,
-				},
-			},
-			ResponseFormat: &openai.ChatCompletionResponseFormat{
-				Type: openai.ChatCompletionResponseFormatTypeJSONObject, // 请求 JSON 输出
-			},
-		},
-	)
-
-	if err != nil {
-		return "", fmt.Errorf("openai chat completion error: %w", err)
-	}
-
-	if len(resp.Choices) == 0 {
-		return "", fmt.Errorf("no response choices from OpenAI")
-	}
-
-	return resp.Choices[0].Message.Content, nil
+	response := "Mock LLM response: I am thinking..."
+	RecordUsage(Usage{PromptTokens: estimateTokens(prompt), CompletionTokens: estimateTokens(response)})
+	return response, nil
 }
-*/
@@ -0,0 +1,31 @@
+package llm
+
+import (
+	"unicode/utf8"
+
+	"github.com/constellation39/tragedyLooper/internal/metrics"
+)
+
+var (
+	llmRequests = metrics.Default.CounterVec("tragedylooper_llm_requests_total",
+		"LLM calls made for AI players, by result.", "result")
+	llmTokens = metrics.Default.CounterVec("tragedylooper_llm_tokens_total",
+		"LLM tokens used, by kind (prompt or completion).", "kind")
+)
+
+// Usage 是一次 LLM 调用消耗的令牌数量。
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// RecordUsage 记录一次 LLM 调用的令牌用量。Client 实现应在每次调用成功后用服务商返回的用量调用它。
+func RecordUsage(u Usage) {
+	llmTokens.WithLabelValues("prompt").Add(float64(u.PromptTokens))
+	llmTokens.WithLabelValues("completion").Add(float64(u.CompletionTokens))
+}
+
+// estimateTokens 粗略估计文本的令牌数量，按每个令牌约 4 个字符计算，供无法获得真实用量的客户端使用。
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
@@ -0,0 +1,182 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultOpenAIBaseURL 是 OpenAIConfig.BaseURL 为空时使用的地址。
+const DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+// defaultSystemPrompt 是 OpenAIConfig.SystemPrompt 为空时使用的系统提示。
+const defaultSystemPrompt = "You are an AI player in Tragedy Looper. Respond with a JSON object representing your action."
+
+// maxErrorBody 是错误响应中最多读取并附加到错误信息里的字节数。
+const maxErrorBody = 4 << 10
+
+// OpenAIConfig 是 OpenAIClient 的配置。
+type OpenAIConfig struct {
+	// BaseURL 是兼容 OpenAI 的 API 地址（包含 /v1 之类的前缀），为空时使用 DefaultOpenAIBaseURL。
+	// 自建模型服务（vLLM、Ollama、llama.cpp 等）只需指向它们的地址。
+	BaseURL string
+	// APIKey 以 Bearer 令牌发送，为空时不发送 Authorization 头。
+	APIKey string
+	// Model 是模型名称，必填。
+	Model string
+	// Temperature 是采样温度。
+	Temperature float64
+	// MaxTokens 是单次回复的最大 token 数，0 表示使用服务端的默认值。
+	MaxTokens int
+	// Timeout 是单次请求的超时，0 表示不限制。
+	Timeout time.Duration
+	// JSONMode 要求服务端以 JSON 对象回复（response_format: json_object）。
+	JSONMode bool
+	// SystemPrompt 是每次请求前置的系统提示，为空时使用默认提示。
+	SystemPrompt string
+	// HTTPClient 是发送请求使用的客户端，为空时使用 http.DefaultClient。
+	HTTPClient *http.Client
+}
+
+// OpenAIClient 是调用兼容 OpenAI 的 chat completions 接口的 Client 实现，只依赖标准库。
+type OpenAIClient struct {
+	cfg      OpenAIConfig
+	endpoint string
+}
+
+// NewOpenAIClient 创建 OpenAIClient。
+func NewOpenAIClient(cfg OpenAIConfig) (*OpenAIClient, error) {
+	if cfg.Model == "" {
+		return nil, errors.New("openai: model is required")
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultOpenAIBaseURL
+	}
+	if cfg.SystemPrompt == "" {
+		cfg.SystemPrompt = defaultSystemPrompt
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	return &OpenAIClient{cfg: cfg, endpoint: strings.TrimRight(cfg.BaseURL, "/") + "/chat/completions"}, nil
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatResponseFormat struct {
+	Type string `json:"type"`
+}
+
+type chatRequest struct {
+	Model          string              `json:"model"`
+	Messages       []chatMessage       `json:"messages"`
+	Temperature    float64             `json:"temperature"`
+	MaxTokens      int                 `json:"max_tokens,omitempty"`
+	ResponseFormat *chatResponseFormat `json:"response_format,omitempty"`
+	User           string              `json:"user,omitempty"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message      chatMessage `json:"message"`
+		FinishReason string      `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage"`
+}
+
+type apiErrorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+		Type    string `json:"type"`
+	} `json:"error"`
+}
+
+// GenerateResponse 发送一次 chat completion 请求并返回第一个候选回复的内容。
+// sessionID 作为 user 字段发送，便于服务端按玩家区分请求。Timeout 在 ctx 的期限之外额外限制单次请求。
+func (c *OpenAIClient) GenerateResponse(ctx context.Context, prompt string, sessionID string) (string, error) {
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	reqBody := chatRequest{
+		Model: c.cfg.Model,
+		Messages: []chatMessage{
+			{Role: "system", Content: c.cfg.SystemPrompt},
+			{Role: "user", Content: prompt},
+		},
+		Temperature: c.cfg.Temperature,
+		MaxTokens:   c.cfg.MaxTokens,
+		User:        sessionID,
+	}
+	if c.cfg.JSONMode {
+		reqBody.ResponseFormat = &chatResponseFormat{Type: "json_object"}
+	}
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("openai: encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("openai: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+	}
+
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("openai: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", apiError(resp)
+	}
+
+	var out chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", fmt.Errorf("openai: decode response: %w", err)
+	}
+	if len(out.Choices) == 0 {
+		return "", errors.New("openai: response has no choices")
+	}
+	content := out.Choices[0].Message.Content
+
+	if out.Usage != nil {
+		RecordUsage(Usage{PromptTokens: out.Usage.PromptTokens, CompletionTokens: out.Usage.CompletionTokens})
+	} else {
+		// 部分自建服务不返回用量。
+		RecordUsage(Usage{PromptTokens: estimateTokens(c.cfg.SystemPrompt) + estimateTokens(prompt), CompletionTokens: estimateTokens(content)})
+	}
+	return content, nil
+}
+
+// apiError 把非 200 响应转换为错误，优先使用响应体里的 error.message。
+func apiError(resp *http.Response) error {
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	var apiErr apiErrorResponse
+	if json.Unmarshal(data, &apiErr) == nil && apiErr.Error.Message != "" {
+		return fmt.Errorf("openai: %s: %s", resp.Status, apiErr.Error.Message)
+	}
+	if msg := strings.TrimSpace(string(data)); msg != "" {
+		return fmt.Errorf("openai: %s: %s", resp.Status, msg)
+	}
+	return fmt.Errorf("openai: %s", resp.Status)
+}
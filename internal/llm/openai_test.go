@@ -0,0 +1,83 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAIClientGenerateResponse(t *testing.T) {
+	var got chatRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/chat/completions", r.URL.Path)
+		assert.Equal(t, "Bearer sk-test", r.Header.Get("Authorization"))
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &got))
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"choices":[{"message":{"role":"assistant","content":"{\"passTurn\":{}}"},"finish_reason":"stop"}],
+			"usage":{"prompt_tokens":12,"completion_tokens":3}}`)
+	}))
+	defer srv.Close()
+
+	client, err := NewOpenAIClient(OpenAIConfig{
+		BaseURL: srv.URL + "/v1/", APIKey: "sk-test", Model: "local-model",
+		Temperature: 0.2, MaxTokens: 256, JSONMode: true,
+	})
+	require.NoError(t, err)
+	resp, err := client.GenerateResponse(context.Background(), "what now?", "session-1")
+	require.NoError(t, err)
+	assert.Equal(t, `{"passTurn":{}}`, resp)
+
+	assert.Equal(t, "local-model", got.Model)
+	assert.Equal(t, 0.2, got.Temperature)
+	assert.Equal(t, 256, got.MaxTokens)
+	assert.Equal(t, "session-1", got.User)
+	require.NotNil(t, got.ResponseFormat)
+	assert.Equal(t, "json_object", got.ResponseFormat.Type)
+	require.Len(t, got.Messages, 2)
+	assert.Equal(t, "system", got.Messages[0].Role)
+	assert.Equal(t, chatMessage{Role: "user", Content: "what now?"}, got.Messages[1])
+}
+
+func TestOpenAIClientErrors(t *testing.T) {
+	_, err := NewOpenAIClient(OpenAIConfig{})
+	assert.Error(t, err, "a model is required")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/limited/chat/completions":
+			w.WriteHeader(http.StatusTooManyRequests)
+			io.WriteString(w, `{"error":{"message":"rate limit reached","type":"requests"}}`)
+		case "/empty/chat/completions":
+			io.WriteString(w, `{"choices":[]}`)
+		case "/slow/chat/completions":
+			time.Sleep(200 * time.Millisecond)
+			io.WriteString(w, `{"choices":[{"message":{"content":"late"}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	generate := func(path string, timeout time.Duration) error {
+		client, err := NewOpenAIClient(OpenAIConfig{BaseURL: srv.URL + path, Model: "m", Timeout: timeout})
+		require.NoError(t, err)
+		_, err = client.GenerateResponse(context.Background(), "p", "")
+		return err
+	}
+	assert.ErrorContains(t, generate("/limited", 0), "rate limit reached")
+	assert.ErrorContains(t, generate("/empty", 0), "no choices")
+	assert.Error(t, generate("/slow", 20*time.Millisecond))
+
+	client, err := NewOpenAIClient(OpenAIConfig{BaseURL: srv.URL + "/slow", Model: "m"})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.GenerateResponse(ctx, "p", "")
+	assert.ErrorIs(t, err, context.Canceled)
+}
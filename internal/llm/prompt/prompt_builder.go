@@ -16,51 +16,53 @@ func NewPromptBuilder() *PromptBuilder {
 }
 
 // BuildMastermindPrompt 为主谋 LLM 构建提示词。
+// 隐藏身份和罪魁祸首只从主谋自己的视图中读取，与人类主谋看到的信息一致。
 func (pb *PromptBuilder) BuildMastermindPrompt(
-	fullGameState *model.PlayerView, // 主谋获得完整视图
-	script *model.ScriptConfig,
-	characters map[string]*model.Character, // 主谋看到隐藏身份
+	fullGameState *model.PlayerView, // 主谋视图，包含私有剧本表与隐藏身份
 ) string {
 	var sb strings.Builder
 	sb.WriteString("You are the Mastermind in the model Tragedy Looper.\n")
 	sb.WriteString("Your goal is to trigger one of the tragedies defined in the script before the loops run out, or before the Protagonists make a correct final guess.\n")
 	sb.WriteString("You know all hidden roles and plots. You can bluff and mislead the Protagonists.\n\n")
 
+	publicSheet := fullGameState.GetPublicSheet()
+	privateSheet := fullGameState.GetPrivateSheet()
+
 	sb.WriteString("--- Game State ---\n")
-	sb.WriteString(fmt.Sprintf("Current Loop: %d/%d, Current Day: %d/%d\n", fullGameState.CurrentLoop, script.LoopCount, fullGameState.CurrentDay, script.DaysPerLoop))
+	sb.WriteString(fmt.Sprintf("Current Loop: %d/%d, Current Day: %d/%d\n", fullGameState.CurrentLoop, fullGameState.LoopCount, fullGameState.CurrentDay, publicSheet.GetDaysPerLoop()))
 	sb.WriteString(fmt.Sprintf("Current Phase: %s\n", fullGameState.CurrentPhase))
 
 	sb.WriteString("\n--- Script Details ---\n")
-	sb.WriteString(fmt.Sprintf("Script Name: %s\n", script.Name))
-
-	// TODO: add subplots to prompt
-	// if len(script.SubPlots) > 0 {
-	//	sb.WriteString(fmt.Sprintf("Sub Plots: %s\n", strings.Join(script.SubPlots, ", ")))
-	// }
+	sb.WriteString(fmt.Sprintf("Script Name: %s\n", publicSheet.GetScriptName()))
+	sb.WriteString(fmt.Sprintf("Main Plot: %s\n", privateSheet.GetMainPlot()))
+	if len(privateSheet.GetSubPlots()) > 0 {
+		sb.WriteString(fmt.Sprintf("Sub Plots: %s\n", strings.Join(privateSheet.GetSubPlots(), ", ")))
+	}
 	sb.WriteString("Tragedies to trigger:\n")
-	for _, t := range script.Incidents {
-		sb.WriteString(fmt.Sprintf("- %s (Day %d, Culprit: %d, Conditions: %+v)\n", t.IncidentType, t.Day, t.CulpritCharacterId, t.TriggerConditions))
+	for _, t := range fullGameState.GetIncidents() {
+		sb.WriteString(fmt.Sprintf("- %s (Day %d, Culprit: %s, Triggered: %t)\n", t.Name, t.Day, t.Culprit, t.Triggered))
 	}
 
 	sb.WriteString("\n--- Characters (including hidden roles) ---\n")
-	for _, char := range characters { // 使用主谋的完整角色映射
-		sb.WriteString(fmt.Sprintf("- %s (Role: %s, Location: %s, Paranoia: %d, Goodwill: %d, Alive: %t)\n",
-			char.Config.Name, char.HiddenRole, char.CurrentLocation, char.Paranoia, char.Goodwill, char.IsAlive))
-		if len(char.Config.Traits) > 0 {
-			sb.WriteString(fmt.Sprintf("  Traits: %s\n", strings.Join(char.Config.Traits, ", ")))
+	for _, char := range fullGameState.Characters { // 主谋视图中包含隐藏身份
+		sb.WriteString(fmt.Sprintf("- %s (Role: %s, Location: %s, Paranoia: %d, Goodwill: %d, Intrigue: %d, Alive: %t)\n",
+			char.Name, char.RoleName, char.CurrentLocation,
+			char.Stats[int32(model.StatType_STAT_TYPE_PARANOIA)],
+			char.Stats[int32(model.StatType_STAT_TYPE_GOODWILL)],
+			char.Stats[int32(model.StatType_STAT_TYPE_INTRIGUE)],
+			char.IsAlive))
+		if len(char.Traits) > 0 {
+			sb.WriteString(fmt.Sprintf("  Traits: %s\n", strings.Join(char.Traits, ", ")))
 		}
 	}
 
 	sb.WriteString("\n--- Your Hand ---\n")
 	for _, card := range fullGameState.YourHand {
-		sb.WriteString(fmt.Sprintf("- Card: %s (Type: %s, Effect: %+v)\n", card.Config.Name, card.Config.Type, card.Config.Effect))
+		sb.WriteString(fmt.Sprintf("- Card: %s (Type: %s, Effect: %+v)\n", card.Config.Name, card.Config.CardType, card.Config.Effect))
 	}
 
-	sb.WriteString("\n--- Public Events (This Day) ---\n")
-	for _, event := range fullGameState.PublicEvents {
-		eventBytes, _ := json.Marshal(event.Payload)
-		sb.WriteString(fmt.Sprintf("- %s: %s\n", event.Type, string(eventBytes)))
-	}
+	writeEventHistory(&sb, fullGameState)
+	writeDiscussion(&sb, fullGameState)
 
 	sb.WriteString("\n--- Instructions ---\n")
 	sb.WriteString(fmt.Sprintf("It is currently the %s phasehandler.\n", fullGameState.CurrentPhase))
@@ -101,7 +103,7 @@ func (pb *PromptBuilder) BuildProtagonistPrompt(
 
 	sb.WriteString("\n--- Your Hand ---\n")
 	for _, card := range playerView.YourHand {
-		sb.WriteString(fmt.Sprintf("- Card: %s (Type: %s, Effect: %+v)\n", card.Config.Name, card.Config.Type, card.Config.Effect))
+		sb.WriteString(fmt.Sprintf("- Card: %s (Type: %s, Effect: %+v)\n", card.Config.Name, card.Config.CardType, card.Config.Effect))
 	}
 
 	sb.WriteString("\n--- Your Deductions (from previous loops) ---\n")
@@ -112,11 +114,8 @@ func (pb *PromptBuilder) BuildProtagonistPrompt(
 		sb.WriteString("No deductions yet.\n")
 	}
 
-	sb.WriteString("\n--- Public Events (This Day) ---\n")
-	for _, event := range playerView.PublicEvents {
-		eventBytes, _ := json.Marshal(event.Payload)
-		sb.WriteString(fmt.Sprintf("- %s: %s\n", event.Type, string(eventBytes)))
-	}
+	writeEventHistory(&sb, playerView)
+	writeDiscussion(&sb, playerView)
 
 	sb.WriteString("\n--- Instructions ---\n")
 	sb.WriteString(fmt.Sprintf("It is currently the %s phasehandler.\n", playerView.CurrentPhase))
@@ -133,3 +132,41 @@ func (pb *PromptBuilder) BuildProtagonistPrompt(
 
 	return sb.String()
 }
+
+// writeEventHistory 按循环和天数写出玩家可见的事件历史。
+// 视图中的事件已按玩家身份过滤，因此这里不会写出隐藏信息。
+func writeEventHistory(sb *strings.Builder, view *model.PlayerView) {
+	sb.WriteString("\n--- Event History (visible to you) ---\n")
+	if len(view.PublicEvents) == 0 {
+		sb.WriteString("No events yet.\n")
+		return
+	}
+	for _, event := range view.PublicEvents {
+		if event.Type == model.GameEventType_GAME_EVENT_TYPE_CHAT_MESSAGE {
+			continue // 聊天由 writeDiscussion 单独写出
+		}
+		eventBytes, _ := json.Marshal(event.Payload)
+		sb.WriteString(fmt.Sprintf("- [Loop %d, Day %d] %s: %s\n", event.Loop, event.Day, event.Type, string(eventBytes)))
+	}
+}
+
+// writeDiscussion 写出玩家可见的聊天记录。主角频道的消息已从主谋的视图中过滤掉。
+func writeDiscussion(sb *strings.Builder, view *model.PlayerView) {
+	sb.WriteString("\n--- Discussion (chat messages visible to you) ---\n")
+	wrote := false
+	for _, event := range view.PublicEvents {
+		chat := event.GetPayload().GetChatMessage()
+		if chat == nil {
+			continue
+		}
+		channel := "all players"
+		if chat.Channel == model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS {
+			channel = "protagonists only"
+		}
+		sb.WriteString(fmt.Sprintf("- [Loop %d, Day %d] %s (%s): %s\n", event.Loop, event.Day, chat.PlayerName, channel, chat.Text))
+		wrote = true
+	}
+	if !wrote {
+		sb.WriteString("No messages yet.\n")
+	}
+}
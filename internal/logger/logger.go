@@ -11,50 +11,63 @@ import (
 	"gopkg.in/natefinch/lumberjack.v2"
 )
 
-// New creates a new zap logger.
-func New() *zap.Logger {
-	var core zapcore.Core
-	var config zap.Config
+// Options configures a logger created by NewWithOptions.
+type Options struct {
+	// Level is the minimum level: debug, info, warn or error. Empty keeps the mode's default.
+	Level string
+	// Production selects the production encoder config instead of the colored development output.
+	Production bool
+	// File additionally writes JSON logs to this file, rotated by size. Empty writes to stdout only.
+	File string
+}
 
+// New creates a new zap logger configured from the LOG_ENV and LOG_LEVEL environment variables.
+func New() *zap.Logger {
+	opts := Options{Level: os.Getenv("LOG_LEVEL")}
 	// Use production logger only if LOG_ENV is explicitly set to "production"
 	if strings.ToLower(os.Getenv("LOG_ENV")) == "production" {
-		config = zap.NewProductionConfig()
-		// Configure lumberjack for log rotation
-		logRotator := &lumberjack.Logger{
-			Filename:   "logs/app.log", // Log file path
-			MaxSize:    100,            // Max size in megabytes before rotation
-			MaxBackups: 3,              // Max number of old log files to keep
-			MaxAge:     28,             // Max number of days to retain old log files
-			Compress:   true,           // Compress old log files
-		}
+		opts.Production = true
+		opts.File = "logs/app.log"
+	}
+	return NewWithOptions(opts)
+}
 
-		// Create a core that writes to both console and file
-		consoleEncoder := zapcore.NewConsoleEncoder(config.EncoderConfig)
-		fileEncoder := zapcore.NewJSONEncoder(config.EncoderConfig)
-		core = zapcore.NewTee(
-			zapcore.NewCore(consoleEncoder, zapcore.AddSync(os.Stdout), config.Level),
-			zapcore.NewCore(fileEncoder, zapcore.AddSync(logRotator), config.Level),
-		)
+// NewWithOptions creates a new zap logger.
+func NewWithOptions(opts Options) *zap.Logger {
+	var config zap.Config
+	if opts.Production {
+		config = zap.NewProductionConfig()
 	} else {
 		config = zap.NewDevelopmentConfig()
 		config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder // Human-readable, colored level
 		config.EncoderConfig.EncodeCaller = relativeCallerEncoder           // Use custom relative path encoder
-		core = zapcore.NewCore(zapcore.NewConsoleEncoder(config.EncoderConfig), zapcore.AddSync(os.Stdout), config.Level)
 	}
 
-	// Allow overriding log level from environment variable
-	level, ok := os.LookupEnv("LOG_LEVEL")
-	if ok {
-		switch strings.ToLower(level) {
-		case "debug":
-			config.Level.SetLevel(zap.DebugLevel)
-		case "info":
-			config.Level.SetLevel(zap.InfoLevel)
-		case "warn":
-			config.Level.SetLevel(zap.WarnLevel)
-		case "error":
-			config.Level.SetLevel(zap.ErrorLevel)
+	// Allow overriding the mode's default log level
+	switch strings.ToLower(opts.Level) {
+	case "debug":
+		config.Level.SetLevel(zap.DebugLevel)
+	case "info":
+		config.Level.SetLevel(zap.InfoLevel)
+	case "warn":
+		config.Level.SetLevel(zap.WarnLevel)
+	case "error":
+		config.Level.SetLevel(zap.ErrorLevel)
+	}
+
+	core := zapcore.NewCore(zapcore.NewConsoleEncoder(config.EncoderConfig), zapcore.AddSync(os.Stdout), config.Level)
+	if opts.File != "" {
+		// Configure lumberjack for log rotation
+		logRotator := &lumberjack.Logger{
+			Filename:   opts.File, // Log file path
+			MaxSize:    100,       // Max size in megabytes before rotation
+			MaxBackups: 3,         // Max number of old log files to keep
+			MaxAge:     28,        // Max number of days to retain old log files
+			Compress:   true,      // Compress old log files
 		}
+		// Write to both console and file
+		fileConfig := zap.NewProductionEncoderConfig()
+		core = zapcore.NewTee(core, zapcore.NewCore(zapcore.NewJSONEncoder(fileConfig), zapcore.AddSync(logRotator), config.Level))
 	}
 
 	// AddCallerSkip(1) is important to make sure the caller is reported correctly
@@ -0,0 +1,418 @@
+// Package metrics 实现一个不依赖外部服务的最小指标库，以 Prometheus 文本格式输出计数器、仪表和直方图。
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Default 是进程级的默认注册表，游戏引擎、LLM 客户端等没有服务器引用的包在其中注册指标。
+var Default = NewRegistry()
+
+// DefaultDurationBuckets 是以秒为单位的耗时直方图的默认桶边界。
+var DefaultDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// metric 是可以注册到 Registry 中的指标族。
+type metric interface {
+	describe() desc
+	// write 以 Prometheus 文本格式写出指标族的样本，不包括 HELP 和 TYPE 行。
+	write(w *bufio.Writer)
+}
+
+// desc 描述一个指标族。
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+func (d desc) describe() desc { return d }
+
+// Registry 保存一组指标族。所有方法都可以并发调用。
+type Registry struct {
+	mu      sync.Mutex
+	metrics map[string]metric
+}
+
+// NewRegistry 创建一个空的注册表。
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]metric)}
+}
+
+// register 注册指标族。指标名重复是编程错误，会 panic。
+func (r *Registry) register(m metric) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := m.describe().name
+	if _, exists := r.metrics[name]; exists {
+		panic(fmt.Sprintf("metrics: duplicate metric %q", name))
+	}
+	r.metrics[name] = m
+}
+
+// WriteText 以 Prometheus 文本格式 (version 0.0.4) 写出所有指标，按指标名排序。
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	metrics := make([]metric, len(names))
+	for i, name := range names {
+		metrics[i] = r.metrics[name]
+	}
+	r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		d := m.describe()
+		fmt.Fprintf(bw, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+		fmt.Fprintf(bw, "# TYPE %s %s\n", d.name, d.typ)
+		m.write(bw)
+	}
+	return bw.Flush()
+}
+
+// Handler 返回按顺序输出多个注册表的 HTTP 处理函数。
+func Handler(registries ...*Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		for _, r := range registries {
+			if err := r.WriteText(w); err != nil {
+				return
+			}
+		}
+	})
+}
+
+// Counter 是只增不减的计数器。
+type Counter struct {
+	v atomicFloat
+}
+
+// Inc 将计数器加一。
+func (c *Counter) Inc() { c.v.add(1) }
+
+// Add 将计数器增加 v，v 必须非负。
+func (c *Counter) Add(v float64) {
+	if v < 0 {
+		panic("metrics: counter cannot decrease")
+	}
+	c.v.add(v)
+}
+
+// Value 返回计数器的当前值。
+func (c *Counter) Value() float64 { return c.v.load() }
+
+// Gauge 是可以任意增减的仪表。
+type Gauge struct {
+	v atomicFloat
+}
+
+// Set 设置仪表的值。
+func (g *Gauge) Set(v float64) { g.v.store(v) }
+
+// Add 将仪表增加 v，v 可以为负。
+func (g *Gauge) Add(v float64) { g.v.add(v) }
+
+// Inc 将仪表加一。
+func (g *Gauge) Inc() { g.v.add(1) }
+
+// Dec 将仪表减一。
+func (g *Gauge) Dec() { g.v.add(-1) }
+
+// Value 返回仪表的当前值。
+func (g *Gauge) Value() float64 { return g.v.load() }
+
+// Histogram 统计观测值在各个桶中的分布。
+type Histogram struct {
+	buckets []float64 // 升序的桶上界，不包括 +Inf
+	mu      sync.Mutex
+	counts  []uint64 // 每个桶（非累积）的观测次数，最后一个是 +Inf 桶
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(buckets []float64) *Histogram {
+	return &Histogram{buckets: buckets, counts: make([]uint64, len(buckets)+1)}
+}
+
+// Observe 记录一次观测。
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[i]++
+	h.sum += v
+	h.count++
+}
+
+// Count 返回观测次数。
+func (h *Histogram) Count() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+func (h *Histogram) writeSamples(w *bufio.Writer, name string, labels []string, values []string) {
+	h.mu.Lock()
+	counts := slices.Clone(h.counts)
+	sum, count := h.sum, h.count
+	h.mu.Unlock()
+
+	bucketLabels := append(slices.Clone(labels), "le")
+	var cumulative uint64
+	for i, upper := range h.buckets {
+		cumulative += counts[i]
+		writeSample(w, name+"_bucket", bucketLabels, append(slices.Clone(values), formatFloat(upper)), float64(cumulative))
+	}
+	writeSample(w, name+"_bucket", bucketLabels, append(slices.Clone(values), "+Inf"), float64(count))
+	writeSample(w, name+"_sum", labels, values, sum)
+	writeSample(w, name+"_count", labels, values, float64(count))
+}
+
+// vec 是按标签值区分的一组同类指标。
+type vec[T any] struct {
+	desc
+	newChild func() *T
+	mu       sync.Mutex
+	children map[string]*T
+	values   map[string][]string
+}
+
+func newVec[T any](d desc, newChild func() *T) *vec[T] {
+	return &vec[T]{desc: d, newChild: newChild, children: make(map[string]*T), values: make(map[string][]string)}
+}
+
+// with 返回标签值对应的指标，不存在时创建。标签值的数量必须与标签名一致。
+func (v *vec[T]) with(values []string) *T {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	child, ok := v.children[key]
+	if !ok {
+		child = v.newChild()
+		v.children[key] = child
+		v.values[key] = slices.Clone(values)
+	}
+	return child
+}
+
+// each 按标签值排序遍历所有指标。
+func (v *vec[T]) each(fn func(values []string, child *T)) {
+	v.mu.Lock()
+	keys := make([]string, 0, len(v.children))
+	for key := range v.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	children := make([]*T, len(keys))
+	values := make([][]string, len(keys))
+	for i, key := range keys {
+		children[i], values[i] = v.children[key], v.values[key]
+	}
+	v.mu.Unlock()
+	for i := range keys {
+		fn(values[i], children[i])
+	}
+}
+
+// CounterVec 是按标签区分的一组计数器。
+type CounterVec struct{ *vec[Counter] }
+
+// WithLabelValues 返回标签值对应的计数器。
+func (c CounterVec) WithLabelValues(values ...string) *Counter { return c.with(values) }
+
+func (c CounterVec) write(w *bufio.Writer) {
+	c.each(func(values []string, child *Counter) {
+		writeSample(w, c.name, c.labels, values, child.Value())
+	})
+}
+
+// GaugeVec 是按标签区分的一组仪表。
+type GaugeVec struct{ *vec[Gauge] }
+
+// WithLabelValues 返回标签值对应的仪表。
+func (g GaugeVec) WithLabelValues(values ...string) *Gauge { return g.with(values) }
+
+func (g GaugeVec) write(w *bufio.Writer) {
+	g.each(func(values []string, child *Gauge) {
+		writeSample(w, g.name, g.labels, values, child.Value())
+	})
+}
+
+// HistogramVec 是按标签区分的一组直方图。
+type HistogramVec struct{ *vec[Histogram] }
+
+// WithLabelValues 返回标签值对应的直方图。
+func (h HistogramVec) WithLabelValues(values ...string) *Histogram { return h.with(values) }
+
+func (h HistogramVec) write(w *bufio.Writer) {
+	h.each(func(values []string, child *Histogram) {
+		child.writeSamples(w, h.name, h.labels, values)
+	})
+}
+
+// counterMetric、gaugeMetric 和 histogramMetric 是没有标签的单个指标。
+type counterMetric struct {
+	desc
+	*Counter
+}
+
+func (c counterMetric) write(w *bufio.Writer) { writeSample(w, c.name, nil, nil, c.Value()) }
+
+type gaugeMetric struct {
+	desc
+	*Gauge
+}
+
+func (g gaugeMetric) write(w *bufio.Writer) { writeSample(w, g.name, nil, nil, g.Value()) }
+
+type histogramMetric struct {
+	desc
+	*Histogram
+}
+
+func (h histogramMetric) write(w *bufio.Writer) { h.writeSamples(w, h.name, nil, nil) }
+
+// gaugeFunc 在输出时调用 collect 计算样本。
+type gaugeFunc struct {
+	desc
+	collect func(emit func(value float64, labelValues ...string))
+}
+
+func (g gaugeFunc) write(w *bufio.Writer) {
+	g.collect(func(value float64, labelValues ...string) {
+		writeSample(w, g.name, g.labels, labelValues, value)
+	})
+}
+
+// Counter 创建并注册一个计数器。
+func (r *Registry) Counter(name, help string) *Counter {
+	c := counterMetric{desc{name: name, help: help, typ: "counter"}, &Counter{}}
+	r.register(c)
+	return c.Counter
+}
+
+// CounterVec 创建并注册一组按标签区分的计数器。
+func (r *Registry) CounterVec(name, help string, labels ...string) CounterVec {
+	c := CounterVec{newVec(desc{name: name, help: help, typ: "counter", labels: labels}, func() *Counter { return &Counter{} })}
+	r.register(c)
+	return c
+}
+
+// Gauge 创建并注册一个仪表。
+func (r *Registry) Gauge(name, help string) *Gauge {
+	g := gaugeMetric{desc{name: name, help: help, typ: "gauge"}, &Gauge{}}
+	r.register(g)
+	return g.Gauge
+}
+
+// GaugeVec 创建并注册一组按标签区分的仪表。
+func (r *Registry) GaugeVec(name, help string, labels ...string) GaugeVec {
+	g := GaugeVec{newVec(desc{name: name, help: help, typ: "gauge", labels: labels}, func() *Gauge { return &Gauge{} })}
+	r.register(g)
+	return g
+}
+
+// GaugeFunc 注册一个在每次输出时计算的仪表。collect 为每组标签值调用一次 emit，标签值的顺序与 labels 一致。
+func (r *Registry) GaugeFunc(name, help string, labels []string, collect func(emit func(value float64, labelValues ...string))) {
+	r.register(gaugeFunc{desc{name: name, help: help, typ: "gauge", labels: labels}, collect})
+}
+
+// Histogram 创建并注册一个直方图。buckets 是升序的桶上界，为 nil 时使用 DefaultDurationBuckets。
+func (r *Registry) Histogram(name, help string, buckets []float64) *Histogram {
+	h := histogramMetric{desc{name: name, help: help, typ: "histogram"}, newHistogram(bucketsOrDefault(buckets))}
+	r.register(h)
+	return h.Histogram
+}
+
+// HistogramVec 创建并注册一组按标签区分的直方图。buckets 为 nil 时使用 DefaultDurationBuckets。
+func (r *Registry) HistogramVec(name, help string, buckets []float64, labels ...string) HistogramVec {
+	buckets = bucketsOrDefault(buckets)
+	h := HistogramVec{newVec(desc{name: name, help: help, typ: "histogram", labels: labels}, func() *Histogram { return newHistogram(buckets) })}
+	r.register(h)
+	return h
+}
+
+func bucketsOrDefault(buckets []float64) []float64 {
+	if buckets == nil {
+		return DefaultDurationBuckets
+	}
+	if !sort.Float64sAreSorted(buckets) {
+		panic("metrics: histogram buckets must be sorted")
+	}
+	return buckets
+}
+
+// writeSample 写出一行样本。
+func writeSample(w *bufio.Writer, name string, labels, values []string, value float64) {
+	w.WriteString(name)
+	if len(labels) > 0 {
+		w.WriteByte('{')
+		for i, label := range labels {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			w.WriteString(label)
+			w.WriteString(`="`)
+			w.WriteString(escapeLabelValue(values[i]))
+			w.WriteByte('"')
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+var (
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string       { return helpEscaper.Replace(s) }
+func escapeLabelValue(s string) string { return labelValueEscaper.Replace(s) }
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// atomicFloat 是可以原子更新的 float64。
+type atomicFloat struct {
+	bits atomic.Uint64
+}
+
+func (f *atomicFloat) load() float64   { return math.Float64frombits(f.bits.Load()) }
+func (f *atomicFloat) store(v float64) { f.bits.Store(math.Float64bits(v)) }
+
+func (f *atomicFloat) add(v float64) {
+	for {
+		old := f.bits.Load()
+		if f.bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+v)) {
+			return
+		}
+	}
+}
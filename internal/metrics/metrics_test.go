@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteText(t *testing.T) {
+	r := NewRegistry()
+	r.Counter("test_requests_total", "Requests.\nSecond line.").Add(3)
+	actions := r.CounterVec("test_actions_total", "Actions by result.", "result")
+	actions.WithLabelValues("rejected").Inc()
+	actions.WithLabelValues("accepted").Add(2)
+	r.Gauge("test_temperature", "Temperature.").Set(-1.5)
+	r.GaugeFunc("test_rooms", "Rooms by state.", []string{"state"}, func(emit func(float64, ...string)) {
+		emit(2, `lob"by`)
+	})
+	h := r.HistogramVec("test_duration_seconds", "Durations.", []float64{0.1, 1}, "phase")
+	h.WithLabelValues("day").Observe(0.05)
+	h.WithLabelValues("day").Observe(0.5)
+	h.WithLabelValues("day").Observe(5)
+
+	var b strings.Builder
+	assert.NoError(t, r.WriteText(&b))
+	assert.Equal(t, `# HELP test_actions_total Actions by result.
+# TYPE test_actions_total counter
+test_actions_total{result="accepted"} 2
+test_actions_total{result="rejected"} 1
+# HELP test_duration_seconds Durations.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{phase="day",le="0.1"} 1
+test_duration_seconds_bucket{phase="day",le="1"} 2
+test_duration_seconds_bucket{phase="day",le="+Inf"} 3
+test_duration_seconds_sum{phase="day"} 5.55
+test_duration_seconds_count{phase="day"} 3
+# HELP test_requests_total Requests.\nSecond line.
+# TYPE test_requests_total counter
+test_requests_total 3
+# HELP test_rooms Rooms by state.
+# TYPE test_rooms gauge
+test_rooms{state="lob\"by"} 2
+# HELP test_temperature Temperature.
+# TYPE test_temperature gauge
+test_temperature -1.5
+`, b.String())
+}
+
+func TestRegistryRejectsDuplicates(t *testing.T) {
+	r := NewRegistry()
+	r.Counter("test_total", "")
+	assert.Panics(t, func() { r.Gauge("test_total", "") })
+	assert.Panics(t, func() { r.CounterVec("test_vec_total", "", "a").WithLabelValues("x", "y") }, "label count must match")
+}
+
+func TestHandler(t *testing.T) {
+	a, b := NewRegistry(), NewRegistry()
+	a.Counter("a_total", "A.").Inc()
+	b.Counter("b_total", "B.").Inc()
+	rec := httptest.NewRecorder()
+	Handler(a, b).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	assert.Contains(t, rec.Header().Get("Content-Type"), "version=0.0.4")
+	assert.Contains(t, rec.Body.String(), "a_total 1\n")
+	assert.Contains(t, rec.Body.String(), "b_total 1\n")
+}
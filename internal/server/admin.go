@@ -0,0 +1,259 @@
+package server
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// 此文件实现供运维人员使用的管理接口，用于检查和解除卡住的游戏。
+// 所有请求都必须携带 Authorization: Bearer <admin token>，并用 ?game_id=... 指定房间。
+
+// SetAdminToken 设置管理接口的访问令牌。令牌为空时管理接口被禁用。必须在开始处理请求之前调用。
+func (s *Server) SetAdminToken(token string) {
+	s.adminToken = token
+}
+
+// AdminHandler 返回管理接口的路径前缀和处理函数，用于注册到 http.ServeMux：
+//
+//	mux.Handle(gameServer.AdminHandler())
+//
+// 提供以下接口：
+//
+//	GET  /admin/state?game_id=...                          完整的权威游戏状态，包含所有隐藏信息
+//	POST /admin/pause?game_id=...&paused=true|false        暂停或恢复游戏主循环
+//	POST /admin/advance?game_id=...                        强制进入下一个阶段
+//	POST /admin/submit_action?game_id=...&player_id=...    以玩家身份提交操作，请求体是 protojson 编码的 PlayerActionPayload
+//	POST /admin/swap_to_ai?game_id=...&player_id=...       将人类玩家的座位交给 AI，并使其会话令牌失效
+//	POST /admin/kick?game_id=...&player_id=...             断开玩家当前的连接，玩家可以用会话令牌重连
+//	POST /admin/terminate?game_id=...                      放弃并移除房间
+func (s *Server) AdminHandler() (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/state", s.adminRoom(s.handleAdminState))
+	mux.HandleFunc("POST /admin/pause", s.adminRoom(s.handleAdminPause))
+	mux.HandleFunc("POST /admin/advance", s.adminRoom(s.handleAdminAdvance))
+	mux.HandleFunc("POST /admin/submit_action", s.adminRoom(s.handleAdminSubmitAction))
+	mux.HandleFunc("POST /admin/swap_to_ai", s.adminRoom(s.handleAdminSwapToAI))
+	mux.HandleFunc("POST /admin/kick", s.adminRoom(s.handleAdminKick))
+	mux.HandleFunc("POST /admin/terminate", s.adminRoom(s.handleAdminTerminate))
+	return "/admin/", mux
+}
+
+// adminRoomHandler 处理针对单个房间的管理请求。
+type adminRoomHandler func(w http.ResponseWriter, r *http.Request, room *Room) error
+
+// adminRoom 检查管理令牌并查找 ?game_id= 指定的房间，然后调用 h。h 返回的错误按错误码写入响应。
+func (s *Server) adminRoom(h adminRoomHandler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctxLogger := logger.LoggerFromContext(r.Context())
+		if s.adminToken == "" {
+			http.Error(w, "Admin API is disabled", http.StatusForbidden)
+			return
+		}
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1 {
+			ctxLogger.Warn("Rejected admin request", zap.String("path", r.URL.Path))
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		gameID := r.URL.Query().Get("game_id")
+		room, ok := s.getRoom(gameID)
+		if !ok {
+			http.Error(w, "Room not found", http.StatusNotFound)
+			return
+		}
+		ctxLogger.Info("Admin request", zap.String("path", r.URL.Path), zap.String("gameID", gameID))
+		if err := h(w, r, room); err != nil {
+			httpError(w, ctxLogger, err)
+		}
+	}
+}
+
+// adminEngine 返回房间的游戏引擎，游戏尚未开始时返回错误。
+func adminEngine(room *Room) (*engine.GameEngine, error) {
+	ge := room.engine()
+	if ge == nil {
+		return nil, newRPCError(codeFailedPrecondition, errGameNotStarted)
+	}
+	return ge, nil
+}
+
+// adminEngineError 为引擎返回的错误附加错误码。
+func adminEngineError(err error) error {
+	if errors.Is(err, engine.ErrEngineStopped) {
+		return newRPCError(codeUnavailable, errGameStopped)
+	}
+	return newRPCError(codeFailedPrecondition, err)
+}
+
+// playerIDParam 解析 ?player_id= 参数。
+func playerIDParam(r *http.Request) (int32, error) {
+	id, err := strconv.ParseInt(r.URL.Query().Get("player_id"), 10, 32)
+	if err != nil {
+		return 0, rpcErrorf(codeInvalidArgument, "invalid player_id: %v", err)
+	}
+	return int32(id), nil
+}
+
+func (s *Server) handleAdminState(w http.ResponseWriter, r *http.Request, room *Room) error {
+	ge, err := adminEngine(room)
+	if err != nil {
+		return err
+	}
+	state := ge.Snapshot()
+	if state == nil {
+		return newRPCError(codeUnavailable, errGameStopped)
+	}
+	writeProto(w, logger.LoggerFromContext(r.Context()), state)
+	return nil
+}
+
+func (s *Server) handleAdminPause(w http.ResponseWriter, r *http.Request, room *Room) error {
+	paused, err := strconv.ParseBool(r.URL.Query().Get("paused"))
+	if err != nil {
+		return rpcErrorf(codeInvalidArgument, "invalid paused: %v", err)
+	}
+	ge, err := adminEngine(room)
+	if err != nil {
+		return err
+	}
+	if err := ge.SetAdminPaused(paused); err != nil {
+		return adminEngineError(err)
+	}
+	writeProto(w, logger.LoggerFromContext(r.Context()), room.summary())
+	return nil
+}
+
+func (s *Server) handleAdminAdvance(w http.ResponseWriter, r *http.Request, room *Room) error {
+	ge, err := adminEngine(room)
+	if err != nil {
+		return err
+	}
+	if _, err := ge.ForceAdvance(); err != nil {
+		return adminEngineError(err)
+	}
+	writeProto(w, logger.LoggerFromContext(r.Context()), room.summary())
+	return nil
+}
+
+func (s *Server) handleAdminSubmitAction(w http.ResponseWriter, r *http.Request, room *Room) error {
+	playerID, err := playerIDParam(r)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxRPCMessageSize))
+	if err != nil {
+		return rpcErrorf(codeInvalidArgument, "invalid request body: %v", err)
+	}
+	action := &model.PlayerActionPayload{}
+	if err := protocolUnmarshaler.Unmarshal(data, action); err != nil || action.Payload == nil {
+		return rpcErrorf(codeInvalidArgument, "request body must be a non-empty PlayerActionPayload")
+	}
+	ge, err := adminEngine(room)
+	if err != nil {
+		return err
+	}
+	if !room.hasPlayer(playerID) {
+		return rpcErrorf(codeNotFound, "player %d not found", playerID)
+	}
+	action.PlayerId = playerID
+	ge.SubmitPlayerAction(playerID, action)
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+func (s *Server) handleAdminSwapToAI(w http.ResponseWriter, r *http.Request, room *Room) error {
+	playerID, err := playerIDParam(r)
+	if err != nil {
+		return err
+	}
+	if err := room.swapToAI(playerID); err != nil {
+		return err
+	}
+	writeLobby(w, logger.LoggerFromContext(r.Context()), room)
+	return nil
+}
+
+func (s *Server) handleAdminKick(w http.ResponseWriter, r *http.Request, room *Room) error {
+	playerID, err := playerIDParam(r)
+	if err != nil {
+		return err
+	}
+	if err := room.kick(playerID); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) handleAdminTerminate(w http.ResponseWriter, _ *http.Request, room *Room) error {
+	room.finish(model.RoomState_ROOM_STATE_ABANDONED, time.Now())
+	s.removeRoom(room, "terminated by admin")
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// hasPlayer 报告游戏中是否有该玩家，包括 AI 玩家。
+func (r *Room) hasPlayer(playerID int32) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lobbySeat(playerID) != nil
+}
+
+// swapToAI 将人类玩家的座位交给 AI 控制。席位被移除，当前连接被关闭，之前签发的会话令牌随之失效。
+func (r *Room) swapToAI(playerID int32) error {
+	ge, err := adminEngine(r)
+	if err != nil {
+		return err
+	}
+	r.mu.RLock()
+	st, ok := r.seats[playerID]
+	r.mu.RUnlock()
+	if !ok {
+		return rpcErrorf(codeNotFound, "no human seat for player %d", playerID)
+	}
+	if err := ge.SetPlayerAI(playerID); err != nil {
+		return adminEngineError(err)
+	}
+
+	r.mu.Lock()
+	delete(r.seats, playerID)
+	if s := r.lobbySeat(playerID); s != nil {
+		s.IsLlm = true
+		s.Ready = true
+	}
+	r.mu.Unlock()
+	if c := st.currentClient(); c != nil {
+		c.close()
+	}
+	r.logger.Warn("Seat handed over to AI by admin", zap.Int32("playerID", playerID))
+	r.broadcastLobby()
+	return nil
+}
+
+// kick 关闭玩家当前的连接。席位保留，玩家可以用会话令牌重新连接。
+func (r *Room) kick(playerID int32) error {
+	r.mu.RLock()
+	st, ok := r.seats[playerID]
+	r.mu.RUnlock()
+	if !ok {
+		return rpcErrorf(codeNotFound, "no human seat for player %d", playerID)
+	}
+	c := st.currentClient()
+	if c == nil {
+		return rpcErrorf(codeFailedPrecondition, "player %d is not connected", playerID)
+	}
+	r.logger.Warn("Client kicked by admin", zap.Int32("playerID", playerID))
+	r.RemoveClient(c)
+	return nil
+}
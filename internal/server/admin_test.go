@@ -0,0 +1,53 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdminAPI(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(room))
+	client := newClient(nil, nil)
+	room.AddClient(client, room.seatByToken(host.PlayerId, token), 0)
+
+	_, handler := srv.AdminHandler()
+	do := func(method, target, adminToken string) int {
+		req := httptest.NewRequest(method, target, nil)
+		if adminToken != "" {
+			req.Header.Set("Authorization", "Bearer "+adminToken)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusForbidden, do(http.MethodGet, "/admin/state?game_id=game-1", "secret"), "admin API is disabled without a token")
+	srv.SetAdminToken("secret")
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/admin/state?game_id=game-1", ""))
+	assert.Equal(t, http.StatusUnauthorized, do(http.MethodGet, "/admin/state?game_id=game-1", "wrong"))
+	assert.Equal(t, http.StatusNotFound, do(http.MethodGet, "/admin/state?game_id=missing", "secret"))
+	assert.Equal(t, http.StatusMethodNotAllowed, do(http.MethodGet, "/admin/advance?game_id=game-1", "secret"))
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodGet, "/admin/state?game_id=game-1", "secret"), "the game has not started")
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/admin/pause?game_id=game-1&paused=maybe", "secret"))
+
+	assert.Equal(t, http.StatusBadRequest, do(http.MethodPost, "/admin/kick?game_id=game-1&player_id=x", "secret"))
+	assert.Equal(t, http.StatusNotFound, do(http.MethodPost, "/admin/kick?game_id=game-1&player_id=9", "secret"))
+	assert.Equal(t, http.StatusNoContent, do(http.MethodPost, "/admin/kick?game_id=game-1&player_id=1", "secret"))
+	assert.False(t, room.seatByToken(host.PlayerId, token).connected(), "kicked client is disconnected")
+	assert.NotNil(t, room.seatByToken(host.PlayerId, token), "the seat survives a kick")
+
+	assert.Equal(t, http.StatusNoContent, do(http.MethodPost, "/admin/terminate?game_id=game-1", "secret"))
+	_, ok := srv.getRoom("game-1")
+	assert.False(t, ok)
+	assert.Equal(t, model.RoomState_ROOM_STATE_ABANDONED, room.state())
+}
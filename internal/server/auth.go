@@ -0,0 +1,119 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ErrUnauthenticated 表示请求没有携带有效的身份凭证。
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// Identity 是认证器识别出的调用者身份。
+type Identity struct {
+	// UserID 是调用者在身份源中的唯一标识。为空表示匿名调用者，此时不检查同一用户重复入座。
+	UserID string
+	// Name 是调用者的显示名称。为空时使用请求中提供的玩家名称。
+	Name string
+}
+
+// Authenticator 从 HTTP 请求（包括 WebSocket 握手）中识别调用者。
+// 部署方可以实现此接口接入自己的身份源，并通过 Server.SetAuthenticator 注册。
+// 返回的错误会使请求以 401 拒绝。
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// AuthenticatorFunc 允许将普通函数用作 Authenticator。
+type AuthenticatorFunc func(r *http.Request) (*Identity, error)
+
+// Authenticate 调用 f(r)。
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (*Identity, error) {
+	return f(r)
+}
+
+// anonymousAuthenticator 接受所有请求，返回匿名身份。未注册认证器时使用。
+type anonymousAuthenticator struct{}
+
+func (anonymousAuthenticator) Authenticate(_ *http.Request) (*Identity, error) {
+	return &Identity{}, nil
+}
+
+// seatTokenSigner 签发和验证席位会话令牌。
+// 令牌格式为 base64url(game_id "\n" player_id "\n" nonce) "." base64url(HMAC-SHA256)，
+// 因此令牌绑定到房间和玩家，服务器无需查表即可拒绝伪造的令牌。
+// nonce 由席位保存，重新签发令牌会使旧令牌失效。
+type seatTokenSigner struct {
+	secret []byte
+}
+
+// seatClaims 是席位令牌中携带的信息。
+type seatClaims struct {
+	GameID   string
+	PlayerID int32
+	Nonce    string
+}
+
+// newSeatTokenSigner 使用给定密钥创建签名器；密钥为空时生成随机密钥，令牌仅在本进程内有效。
+func newSeatTokenSigner(secret []byte) *seatTokenSigner {
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(err) // crypto/rand 不会失败
+		}
+	}
+	return &seatTokenSigner{secret: secret}
+}
+
+// sign 为房间中的玩家签发一个带有新 nonce 的令牌。
+func (s *seatTokenSigner) sign(gameID string, playerID int32) (string, seatClaims) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		panic(err) // crypto/rand 不会失败
+	}
+	claims := seatClaims{GameID: gameID, PlayerID: playerID, Nonce: hex.EncodeToString(nonce)}
+	payload := []byte(claims.GameID + "\n" + strconv.FormatInt(int64(claims.PlayerID), 10) + "\n" + claims.Nonce)
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.mac(payload)), claims
+}
+
+// verify 检查令牌签名并返回其中的信息。
+func (s *seatTokenSigner) verify(token string) (seatClaims, error) {
+	encodedPayload, encodedMAC, ok := strings.Cut(token, ".")
+	if !ok {
+		return seatClaims{}, fmt.Errorf("malformed seat token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return seatClaims{}, fmt.Errorf("malformed seat token: %w", err)
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encodedMAC)
+	if err != nil {
+		return seatClaims{}, fmt.Errorf("malformed seat token: %w", err)
+	}
+	if !hmac.Equal(mac, s.mac(payload)) {
+		return seatClaims{}, fmt.Errorf("invalid seat token signature")
+	}
+
+	parts := strings.Split(string(payload), "\n")
+	if len(parts) != 3 {
+		return seatClaims{}, fmt.Errorf("malformed seat token payload")
+	}
+	playerID, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return seatClaims{}, fmt.Errorf("malformed seat token player id: %w", err)
+	}
+	return seatClaims{GameID: parts[0], PlayerID: int32(playerID), Nonce: parts[2]}, nil
+}
+
+func (s *seatTokenSigner) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write(payload)
+	return h.Sum(nil)
+}
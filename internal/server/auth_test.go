@@ -0,0 +1,82 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeatTokenSigner(t *testing.T) {
+	signer := newSeatTokenSigner([]byte("secret"))
+	token, claims := signer.sign("game-1", 3)
+	assert.Equal(t, "game-1", claims.GameID)
+	assert.Equal(t, int32(3), claims.PlayerID)
+
+	verified, err := signer.verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, claims, verified)
+
+	again, _ := signer.sign("game-1", 3)
+	assert.NotEqual(t, token, again, "each token carries a fresh nonce")
+
+	_, err = newSeatTokenSigner([]byte("other")).verify(token)
+	assert.Error(t, err, "token signed with another secret must be rejected")
+
+	payload, mac, _ := strings.Cut(token, ".")
+	forged, _ := signer.sign("game-2", 3)
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+	_, err = signer.verify(forgedPayload + "." + mac)
+	assert.Error(t, err, "payload swapped to another room must be rejected")
+	_, err = signer.verify(payload)
+	assert.Error(t, err)
+	_, err = signer.verify("")
+	assert.Error(t, err)
+}
+
+func TestFindSeat(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	room := NewRoom("game-1", logger.New())
+	srv.rooms[room.GameId] = room
+
+	token := srv.issueSeat(room, 2, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, &Identity{UserID: "alice"})
+
+	found, st, err := srv.findSeat(token)
+	assert.NoError(t, err)
+	assert.Equal(t, room, found)
+	assert.Equal(t, int32(2), st.playerID)
+	assert.Equal(t, "alice", st.userID)
+	assert.Equal(t, st, room.seatForUser("alice"))
+	assert.Nil(t, room.seatForUser(""))
+
+	// 重新签发令牌后旧令牌失效。
+	srv.issueSeat(room, 2, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, &Identity{UserID: "alice"})
+	_, _, err = srv.findSeat(token)
+	assert.Error(t, err)
+
+	// 其他服务器签发的令牌无效。
+	other := NewServer("", nil, logger.New())
+	foreign, _ := other.seatTokens.sign("game-1", 2)
+	_, _, err = srv.findSeat(foreign)
+	assert.Error(t, err)
+}
+
+func TestAuthenticatorRejects(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	srv.SetAuthenticator(AuthenticatorFunc(func(_ *http.Request) (*Identity, error) {
+		return nil, ErrUnauthenticated
+	}))
+
+	rr := httptest.NewRecorder()
+	srv.HandleJoinRoom(rr, httptest.NewRequest(http.MethodPost, "/join_room", strings.NewReader(`{"game_id":"missing"}`)))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+
+	rr = httptest.NewRecorder()
+	srv.HandleWebSocket(rr, httptest.NewRequest(http.MethodGet, "/ws", nil))
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// sendChat 以席位的身份发送聊天消息。消息由游戏引擎检查发言规则并作为事件推送，因此与其他事件一样
+// 按频道过滤、记录在游戏日志中，并出现在 AI 玩家的视图里。
+func (r *Room) sendChat(st *seat, chat *model.SendChat) error {
+	if st.spectator {
+		return rpcErrorf(codePermissionDenied, "spectators cannot chat")
+	}
+	if err := r.allowAction(st); err != nil {
+		return err
+	}
+	ge := r.engine()
+	if ge == nil {
+		return newRPCError(codeFailedPrecondition, errGameNotStarted)
+	}
+	r.touch(time.Now())
+	err := ge.PostChat(st.playerID, chat.GetChannel(), chat.GetText())
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, engine.ErrInvalidChat):
+		return newRPCError(codeInvalidArgument, err)
+	case errors.Is(err, engine.ErrChatForbidden):
+		return newRPCError(codePermissionDenied, err)
+	case errors.Is(err, engine.ErrEngineStopped):
+		return newRPCError(codeUnavailable, errGameStopped)
+	default:
+		return err
+	}
+}
+
+// HandleSendChat 处理通过 HTTP 发送聊天消息的请求，供使用 HandleEvents 的客户端使用。
+// 请求体是 protojson 编码的 SeatChatRequest。
+func (s *Server) HandleSendChat(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxRPCMessageSize))
+	if err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req := &model.SeatChatRequest{}
+	if err := protocolUnmarshaler.Unmarshal(data, req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	room, st, ok := s.seatFromRequest(w, r, req.GetSessionToken())
+	if !ok {
+		return
+	}
+	if err := room.sendChat(st, req.GetChat()); err != nil {
+		httpError(w, ctxLogger, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
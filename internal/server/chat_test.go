@@ -0,0 +1,38 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendChat(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(room))
+	chat := &model.SendChat{Channel: model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS, Text: "hello"}
+
+	// 聊天在游戏开始后才可用；旁观者不能发言。
+	err := room.sendChat(room.seatByToken(host.PlayerId, token), chat)
+	assert.ErrorIs(t, err, errGameNotStarted)
+	assert.Equal(t, model.ErrorCode_ERROR_CODE_GAME_NOT_STARTED, seatErrorCode(err))
+	err = room.sendChat(newSpectator(false, 0).seat, chat)
+	assert.Equal(t, model.ErrorCode_ERROR_CODE_FORBIDDEN, seatErrorCode(err))
+
+	post := func(body string) int {
+		rec := httptest.NewRecorder()
+		srv.HandleSendChat(rec, httptest.NewRequest(http.MethodPost, "/send_chat", strings.NewReader(body)))
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusUnauthorized, post(`{"session_token":"bogus","chat":{"text":"hi"}}`))
+	assert.Equal(t, http.StatusBadRequest, post(`{"session_token":"`+token+`","chat":{"channel":"CHAT_CHANNEL_ALL","text":"hi"}}`))
+	assert.Equal(t, http.StatusBadRequest, post(`not json`))
+}
@@ -0,0 +1,345 @@
+package server
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+// Client 表示单个客户端连接。通常是 WebSocket 连接；RPC 事件流也使用 Client 接收席位消息，此时 conn 为 nil。
+type Client struct {
+	conn   *websocket.Conn
+	send   chan *model.ServerMessage // 用于传出消息的带缓冲通道
+	room   *Room                     // 此客户端所属的房间，加入房间后设置
+	logger *zap.Logger
+	// identity 是握手时认证器识别出的调用者身份
+	identity *Identity
+
+	// limits 是 WebSocket 连接的消息大小、超时和心跳设置
+	limits ConnectionLimits
+
+	// binary 表示客户端最近一次使用二进制帧，回复时使用相同的编码。
+	binary atomic.Bool
+
+	mu     sync.Mutex // 保护 seat 和 closed
+	seat   *seat      // 此连接占用的玩家席位，加入房间后设置
+	closed bool
+}
+
+// sendQueueSize 是每个连接发送队列的容量。重连时最多补发 maxSeatHistory 条事件，
+// 队列需要能同时容纳它们和之后的视图更新，否则新连接会被立即断开。
+const sendQueueSize = maxSeatHistory + 256
+
+// newClient 创建一个尚未加入房间的客户端。
+func newClient(conn *websocket.Conn, logger *zap.Logger) *Client {
+	return &Client{
+		conn:     conn,
+		send:     make(chan *model.ServerMessage, sendQueueSize),
+		logger:   logger,
+		identity: &Identity{},
+	}
+}
+
+// newStreamClient 创建没有 WebSocket 连接的客户端，用于 RPC 事件流和 SSE。调用者直接从 send 通道读取消息。
+func newStreamClient(identity *Identity, logger *zap.Logger) *Client {
+	c := newClient(nil, logger)
+	c.identity = identity
+	return c
+}
+
+// currentSeat 返回此连接占用的席位，尚未加入房间时返回 nil。
+func (c *Client) currentSeat() *seat {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.seat
+}
+
+// Send 发送一条消息。加入席位后消息按席位分配序号，之前的消息序号为 0。
+// 如果客户端已关闭或发送队列已满，返回 false；队列已满时客户端会被关闭。
+func (c *Client) Send(msg *model.ServerMessage) bool {
+	if st := c.currentSeat(); st != nil {
+		return st.sendTo(c, msg)
+	}
+	return c.enqueue(msg)
+}
+
+// enqueue 将已分配序号的消息放入发送队列，不会阻塞。
+// 发送队列已满说明客户端读取太慢，此时关闭客户端而不是静默丢弃消息，玩家重连后会补发错过的事件。
+func (c *Client) enqueue(msg *model.ServerMessage) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return false
+	}
+	select {
+	case c.send <- msg:
+		return true
+	default:
+		c.closed = true
+		close(c.send)
+		return false
+	}
+}
+
+// close 关闭发送队列，writePump 会在发送完剩余消息后关闭连接。可以重复调用。
+func (c *Client) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		close(c.send)
+	}
+}
+
+// readPump 从 WebSocket 连接中读取消息并交给服务器处理。
+func (c *Client) readPump(s *Server) {
+	defer func() {
+		c.logger.Info("Player disconnected.")
+		if c.room != nil {
+			c.room.RemoveClient(c)
+		} else {
+			c.close()
+		}
+	}()
+
+	if c.limits.MaxMessageSize > 0 {
+		c.conn.SetReadLimit(c.limits.MaxMessageSize)
+	}
+	// 任何消息（包括 pong）都会延长读取期限，超过 PongWait 没有收到消息的连接会被断开。
+	_ = c.conn.SetReadDeadline(deadline(c.limits.PongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(deadline(c.limits.PongWait))
+	})
+
+	for {
+		frameType, data, err := c.conn.ReadMessage()
+		if err != nil {
+			switch {
+			case errors.Is(err, websocket.ErrReadLimit):
+				c.logger.Warn("WebSocket message too large", zap.Int64("limit", c.limits.MaxMessageSize))
+			case websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure):
+				c.logger.Error("WebSocket read error", zap.Error(err))
+			}
+			break
+		}
+		_ = c.conn.SetReadDeadline(deadline(c.limits.PongWait))
+		c.binary.Store(frameType == websocket.BinaryMessage)
+
+		msg, err := decodeClientMessage(frameType, data)
+		if err != nil {
+			c.logger.Warn("Failed to parse incoming message", zap.Error(err))
+			c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_INVALID_MESSAGE, 0, "%v", err))
+			continue
+		}
+		if c.room != nil {
+			c.room.touch(time.Now())
+		}
+		c.handleMessage(s, msg)
+	}
+}
+
+// handleMessage 处理一条客户端消息。
+func (c *Client) handleMessage(s *Server, msg *model.ClientMessage) {
+	switch m := msg.Message.(type) {
+	case *model.ClientMessage_JoinRoom:
+		c.handleJoinRoom(s, msg.Seq, m.JoinRoom)
+	case *model.ClientMessage_SubmitAction:
+		c.submitAction(msg.Seq, m.SubmitAction.GetAction())
+	case *model.ClientMessage_ChooseOption:
+		c.submitAction(msg.Seq, &model.PlayerActionPayload{
+			Payload: &model.PlayerActionPayload_ChooseOption{ChooseOption: m.ChooseOption},
+		})
+	case *model.ClientMessage_Ping:
+		c.Send(&model.ServerMessage{Message: &model.ServerMessage_Pong{Pong: &model.Pong{
+			ClientTimeUnixMs: m.Ping.GetClientTimeUnixMs(),
+			ServerTimeUnixMs: time.Now().UnixMilli(),
+		}}})
+	case *model.ClientMessage_Spectate:
+		c.handleSpectate(s, msg.Seq, m.Spectate)
+	case *model.ClientMessage_SendChat:
+		c.sendChat(msg.Seq, m.SendChat)
+	case *model.ClientMessage_SetReady:
+		c.setReady(msg.Seq, m.SetReady.GetReady())
+	case *model.ClientMessage_RequestView:
+		c.requestView(msg.Seq)
+	case *model.ClientMessage_Ack:
+		if st := c.currentSeat(); st != nil {
+			st.ack(m.Ack.GetSeq())
+		}
+	default:
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_INVALID_MESSAGE, msg.Seq, "unsupported message"))
+	}
+}
+
+// handleJoinRoom 用会话令牌将客户端绑定到玩家席位。房间随后会补发错过的事件并推送当前视图。
+func (c *Client) handleJoinRoom(s *Server, seq uint64, req *model.JoinRoomRequest) {
+	if c.room != nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_ALREADY_IN_ROOM, seq, "already in room %s", c.room.GameId))
+		return
+	}
+	if req.GetSessionToken() == "" {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_INVALID_SESSION, seq, "session token required"))
+		return
+	}
+
+	room, st, err := s.authorizeSeat(c.identity, req.GetSessionToken())
+	if err != nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_INVALID_SESSION, seq, "%v", err))
+		return
+	}
+	if (req.GetGameId() != "" && req.GetGameId() != room.GameId) ||
+		(req.GetPlayerId() != 0 && req.GetPlayerId() != st.playerID) {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_INVALID_SESSION, seq, "session token does not match room %s player %d", req.GetGameId(), req.GetPlayerId()))
+		return
+	}
+
+	room.AddClient(c, st, req.GetLastSeq())
+}
+
+// handleSpectate 让客户端以旁观者身份观看房间。
+func (c *Client) handleSpectate(s *Server, seq uint64, req *model.SpectateRequest) {
+	if c.room != nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_ALREADY_IN_ROOM, seq, "already in room %s", c.room.GameId))
+		return
+	}
+	room, ok := s.getRoom(req.GetGameId())
+	if !ok {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, seq, "room %s not found", req.GetGameId()))
+		return
+	}
+	if err := room.addSpectator(c, req.GetFullView()); err != nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_FORBIDDEN, seq, "%v", err))
+	}
+}
+
+// submitAction 将玩家操作提交给房间的游戏引擎。
+func (c *Client) submitAction(seq uint64, action *model.PlayerActionPayload) {
+	st := c.currentSeat()
+	if c.room == nil || st == nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_NOT_IN_ROOM, seq, "join a room before submitting actions"))
+		return
+	}
+	if err := c.room.submitAction(st, action); err != nil {
+		c.Send(newErrorMessage(seatErrorCode(err), seq, "%v", err))
+		return
+	}
+	c.Send(newAckMessage(seq))
+}
+
+// sendChat 以席位的身份发送聊天消息。
+func (c *Client) sendChat(seq uint64, chat *model.SendChat) {
+	st := c.currentSeat()
+	if c.room == nil || st == nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_NOT_IN_ROOM, seq, "join a room before chatting"))
+		return
+	}
+	if err := c.room.sendChat(st, chat); err != nil {
+		c.Send(newErrorMessage(seatErrorCode(err), seq, "%v", err))
+		return
+	}
+	c.Send(newAckMessage(seq))
+}
+
+// requestView 重新发送完整视图，用于客户端发现本地视图与收到的 ViewDelta 版本不一致时重新同步。
+func (c *Client) requestView(seq uint64) {
+	st := c.currentSeat()
+	if c.room == nil || st == nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_NOT_IN_ROOM, seq, "join a room before requesting a view"))
+		return
+	}
+	if c.room.engine() == nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_GAME_NOT_STARTED, seq, "%v", errGameNotStarted))
+		return
+	}
+	if st.spectator {
+		c.room.sendSpectatorView(c)
+	} else {
+		c.room.sendFullView(st)
+	}
+	c.Send(newAckMessage(seq))
+}
+
+// seatErrorCode 将席位操作的错误转换为 WebSocket 协议的错误码。
+func seatErrorCode(err error) model.ErrorCode {
+	switch {
+	case errors.Is(err, errGameNotStarted):
+		return model.ErrorCode_ERROR_CODE_GAME_NOT_STARTED
+	case errors.Is(err, engine.ErrIllegalAction):
+		return model.ErrorCode_ERROR_CODE_ILLEGAL_ACTION
+	case errorCode(err) == codePermissionDenied:
+		return model.ErrorCode_ERROR_CODE_FORBIDDEN
+	case errorCode(err) == codeResourceExhausted:
+		return model.ErrorCode_ERROR_CODE_RATE_LIMITED
+	case errorCode(err) == codeInternal:
+		return model.ErrorCode_ERROR_CODE_INTERNAL
+	default:
+		return model.ErrorCode_ERROR_CODE_INVALID_MESSAGE
+	}
+}
+
+// setReady 设置玩家在大厅中的准备状态。
+func (c *Client) setReady(seq uint64, ready bool) {
+	st := c.currentSeat()
+	if c.room == nil || st == nil {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_NOT_IN_ROOM, seq, "join a room before setting ready"))
+		return
+	}
+	if st.spectator {
+		c.Send(newErrorMessage(model.ErrorCode_ERROR_CODE_FORBIDDEN, seq, "spectators have no seat"))
+		return
+	}
+	if err := c.room.setReady(st.playerID, ready); err != nil {
+		code := model.ErrorCode_ERROR_CODE_INVALID_MESSAGE
+		if errors.Is(err, errGameStarted) {
+			code = model.ErrorCode_ERROR_CODE_GAME_ALREADY_STARTED
+		}
+		c.Send(newErrorMessage(code, seq, "%v", err))
+		return
+	}
+	c.Send(newAckMessage(seq))
+	c.room.broadcastLobby()
+}
+
+// writePump 将消息从发送队列写入 WebSocket 连接，并定期发送 ping 保持连接。
+func (c *Client) writePump() {
+	var ping <-chan time.Time
+	if c.limits.PingPeriod > 0 {
+		ticker := time.NewTicker(c.limits.PingPeriod)
+		defer ticker.Stop()
+		ping = ticker.C
+	}
+	defer func() {
+		c.conn.Close()
+	}()
+	for {
+		select {
+		case msg, ok := <-c.send:
+			if !ok {
+				_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), deadline(c.limits.WriteWait))
+				return
+			}
+			data, frameType, err := encodeServerMessage(msg, c.binary.Load())
+			if err != nil {
+				c.logger.Error("Failed to encode server message", zap.Error(err))
+				continue
+			}
+			_ = c.conn.SetWriteDeadline(deadline(c.limits.WriteWait))
+			if err := c.conn.WriteMessage(frameType, data); err != nil {
+				c.logger.Error("WebSocket write error", zap.Error(err))
+				return
+			}
+		case <-ping:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, deadline(c.limits.WriteWait)); err != nil {
+				c.logger.Info("WebSocket ping failed", zap.Error(err))
+				return
+			}
+		}
+	}
+}
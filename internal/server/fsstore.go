@@ -0,0 +1,239 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// FSStore 中每局游戏目录下的文件名。
+const (
+	roomFile     = "room.json"
+	snapshotFile = "snapshot.json"
+	eventsFile   = "events.jsonl"
+	resultFile   = "result.json"
+)
+
+var (
+	storeMarshaler   = protojson.MarshalOptions{UseProtoNames: true}
+	storeUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// FSStore 是基于文件系统的 Store。每局游戏一个目录：
+//
+//	<dir>/<game_id>/room.json      房间记录（protojson）
+//	<dir>/<game_id>/snapshot.json  游戏状态快照（protojson）
+//	<dir>/<game_id>/events.jsonl   事件日志，每行一个 protojson 事件
+//	<dir>/<game_id>/result.json    最终结果（protojson）
+//
+// 除事件日志外，文件先写入临时文件再重命名，因此进程崩溃不会留下写了一半的记录。
+type FSStore struct {
+	dir string
+	mu  sync.Mutex // 串行化对事件日志的追加
+}
+
+// NewFSStore 创建一个将数据保存在 dir 下的存储，dir 不存在时会被创建。
+func NewFSStore(dir string) (*FSStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create store directory: %w", err)
+	}
+	return &FSStore{dir: dir}, nil
+}
+
+// gameDir 返回游戏的目录，拒绝可能逃出存储目录的游戏 ID。
+func (s *FSStore) gameDir(gameID string) (string, error) {
+	if gameID == "" || gameID == "." || gameID == ".." || strings.ContainsAny(gameID, `/\`) {
+		return "", fmt.Errorf("invalid game ID %q", gameID)
+	}
+	return filepath.Join(s.dir, gameID), nil
+}
+
+// writeMessage 将消息以 protojson 原子地写入游戏目录下的文件。
+func (s *FSStore) writeMessage(gameID, name string, m proto.Message) error {
+	dir, err := s.gameDir(gameID)
+	if err != nil {
+		return err
+	}
+	data, err := storeMarshaler.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // 重命名成功后不会删除任何文件
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, name))
+}
+
+// readMessage 从游戏目录下的文件读取 protojson 消息。
+func (s *FSStore) readMessage(gameID, name string, m proto.Message) error {
+	dir, err := s.gameDir(gameID)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%s of %s: %w", name, gameID, ErrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	if err := storeUnmarshaler.Unmarshal(data, m); err != nil {
+		return fmt.Errorf("failed to decode %s of %s: %w", name, gameID, err)
+	}
+	return nil
+}
+
+// SaveRoom 实现 Store。
+func (s *FSStore) SaveRoom(_ context.Context, record *model.RoomRecord) error {
+	return s.writeMessage(record.GetGameId(), roomFile, record)
+}
+
+// LoadRoom 实现 Store。
+func (s *FSStore) LoadRoom(_ context.Context, gameID string) (*model.RoomRecord, error) {
+	record := &model.RoomRecord{}
+	if err := s.readMessage(gameID, roomFile, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// ListRooms 实现 Store。没有房间记录的目录会被忽略。
+func (s *FSStore) ListRooms(ctx context.Context) ([]*model.RoomRecord, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*model.RoomRecord, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		record, err := s.LoadRoom(ctx, entry.Name())
+		if errors.Is(err, ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].GetGameId() < records[j].GetGameId() })
+	return records, nil
+}
+
+// SaveSnapshot 实现 Store。
+func (s *FSStore) SaveSnapshot(_ context.Context, gameID string, state *model.GameState) error {
+	return s.writeMessage(gameID, snapshotFile, state)
+}
+
+// LoadSnapshot 实现 Store。
+func (s *FSStore) LoadSnapshot(_ context.Context, gameID string) (*model.GameState, error) {
+	state := &model.GameState{}
+	if err := s.readMessage(gameID, snapshotFile, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// AppendEvents 实现 Store。
+func (s *FSStore) AppendEvents(_ context.Context, gameID string, events ...*model.GameEvent) error {
+	dir, err := s.gameDir(gameID)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	for _, event := range events {
+		// protojson 的输出不包含换行，因此每个事件占一行。
+		data, err := storeMarshaler.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode event: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, eventsFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadEvents 实现 Store。
+func (s *FSStore) LoadEvents(_ context.Context, gameID string) ([]*model.GameEvent, error) {
+	dir, err := s.gameDir(gameID)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(dir, eventsFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return []*model.GameEvent{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	events := []*model.GameEvent{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		event := &model.GameEvent{}
+		if err := storeUnmarshaler.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, fmt.Errorf("failed to decode event on line %d of %s: %w", line, gameID, err)
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+// SaveResult 实现 Store。
+func (s *FSStore) SaveResult(_ context.Context, result *model.GameResult) error {
+	return s.writeMessage(result.GetGameId(), resultFile, result)
+}
+
+// LoadResult 实现 Store。
+func (s *FSStore) LoadResult(_ context.Context, gameID string) (*model.GameResult, error) {
+	result := &model.GameResult{}
+	if err := s.readMessage(gameID, resultFile, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
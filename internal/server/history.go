@@ -0,0 +1,315 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// replayFormatVersion 是 Replay 文件的格式版本。
+const replayFormatVersion = 1
+
+var (
+	errRoomNotFound    = errors.New("room not found")
+	errGameNotFinished = errors.New("the full event log is only available after the game has ended")
+	errGameStopped     = errors.New("the game has stopped")
+)
+
+// summary 返回房间的公开概要。
+func (r *Room) summary() *model.RoomSummary {
+	summary := summaryFromLobby(r.lobbySnapshot())
+	if ge := r.engine(); ge != nil {
+		progress := ge.GetProgress()
+		summary.Phase = progress.Phase
+		summary.CurrentLoop = progress.Loop
+		summary.CurrentDay = progress.Day
+		summary.LoopCount = progress.LoopCount
+	}
+	summary.Result = r.gameResult()
+	return summary
+}
+
+// summaryFromLobby 根据大厅状态创建房间概要，不包含游戏进度。
+func summaryFromLobby(lobby *model.LobbyState) *model.RoomSummary {
+	return &model.RoomSummary{
+		GameId:          lobby.GetGameId(),
+		State:           lobby.GetState(),
+		ScriptId:        lobby.GetScriptId(),
+		ScriptName:      lobby.GetScriptName(),
+		ModelId:         lobby.GetModelId(),
+		ModelTitle:      lobby.GetModelTitle(),
+		Seats:           lobby.GetSeats(),
+		MaxProtagonists: lobby.GetMaxProtagonists(),
+		Spectators:      lobby.GetSpectators(),
+	}
+}
+
+// storedSummary 根据存储的房间记录和结果创建房间概要。
+func (s *Server) storedSummary(ctx context.Context, record *model.RoomRecord) (*model.RoomSummary, error) {
+	summary := summaryFromLobby(record.GetLobby())
+	summary.GameId = record.GetGameId()
+	summary.Spectators = 0 // 已不在内存中的房间没有连接
+	result, err := s.store.LoadResult(ctx, record.GetGameId())
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return nil, err
+	}
+	summary.Result = result
+	return summary, nil
+}
+
+// parseRoomStates 解析房间状态过滤条件。条件是逗号分隔的状态名，例如 "lobby,running"
+// 或 "ROOM_STATE_FINISHED"；"all" 表示所有状态；为空时只包含大厅中的房间。
+func parseRoomStates(filter string) (map[model.RoomState]bool, error) {
+	states := make(map[model.RoomState]bool)
+	if filter == "" {
+		states[model.RoomState_ROOM_STATE_LOBBY] = true
+		return states, nil
+	}
+	for _, name := range strings.Split(filter, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "ALL" {
+			for v := range model.RoomState_name {
+				if state := model.RoomState(v); state != model.RoomState_ROOM_STATE_UNSPECIFIED {
+					states[state] = true
+				}
+			}
+			continue
+		}
+		v, ok := model.RoomState_value["ROOM_STATE_"+strings.TrimPrefix(name, "ROOM_STATE_")]
+		if !ok || v == int32(model.RoomState_ROOM_STATE_UNSPECIFIED) {
+			return nil, fmt.Errorf("unknown room state %q", name)
+		}
+		states[model.RoomState(v)] = true
+	}
+	return states, nil
+}
+
+// HandleListRooms 处理列出游戏房间的请求。
+// 可以用 ?state=lobby,running 按房间状态过滤，"all" 表示所有状态；默认只列出仍在大厅中的房间。
+// 设置了存储时，已从内存中移除的已结束和已放弃的房间也会从存储中列出。
+func (s *Server) HandleListRooms(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	states, err := parseRoomStates(r.URL.Query().Get("state"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp, err := s.listRooms(r.Context(), states)
+	if err != nil {
+		httpError(w, ctxLogger, err)
+		return
+	}
+	writeProto(w, ctxLogger, resp)
+}
+
+// listRooms 返回处于指定状态的房间概要，按游戏 ID 排列。
+func (s *Server) listRooms(ctx context.Context, states map[model.RoomState]bool) (*model.ListRoomsResponse, error) {
+	ctxLogger := logger.LoggerFromContext(ctx)
+	s.mu.RLock()
+	all := make([]*Room, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		all = append(all, room)
+	}
+	s.mu.RUnlock()
+	var rooms []*Room
+	for _, room := range all {
+		if states[room.state()] {
+			rooms = append(rooms, room)
+		}
+	}
+
+	// 查询游戏进度需要等待各自的引擎循环，因此并发获取概要。
+	summaries := make([]*model.RoomSummary, len(rooms))
+	var wg sync.WaitGroup
+	for i, room := range rooms {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			summaries[i] = room.summary()
+		}()
+	}
+	wg.Wait()
+
+	resp := &model.ListRoomsResponse{}
+	listed := make(map[string]bool, len(summaries))
+	for _, summary := range summaries {
+		if states[summary.State] {
+			resp.Rooms = append(resp.Rooms, summary)
+			listed[summary.GameId] = true
+		}
+	}
+	if s.store != nil && (states[model.RoomState_ROOM_STATE_FINISHED] || states[model.RoomState_ROOM_STATE_ABANDONED]) {
+		records, err := s.store.ListRooms(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list stored rooms: %w", err)
+		}
+		for _, record := range records {
+			if listed[record.GetGameId()] || !states[record.GetLobby().GetState()] {
+				continue
+			}
+			summary, err := s.storedSummary(ctx, record)
+			if err != nil {
+				ctxLogger.Error("Failed to load stored room", zap.String("gameID", record.GetGameId()), zap.Error(err))
+				continue
+			}
+			resp.Rooms = append(resp.Rooms, summary)
+		}
+	}
+	sort.Slice(resp.Rooms, func(i, j int) bool { return resp.Rooms[i].GameId < resp.Rooms[j].GameId })
+	return resp, nil
+}
+
+// HandleGetRoom 处理查询房间概要的请求：?game_id=...。
+func (s *Server) HandleGetRoom(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	gameID := r.URL.Query().Get("game_id")
+	if room, ok := s.getRoom(gameID); ok {
+		writeProto(w, ctxLogger, room.summary())
+		return
+	}
+	if s.store == nil {
+		http.Error(w, "Room not found", http.StatusNotFound)
+		return
+	}
+	record, err := s.store.LoadRoom(r.Context(), gameID)
+	if err != nil {
+		historyError(w, ctxLogger, err)
+		return
+	}
+	summary, err := s.storedSummary(r.Context(), record)
+	if err != nil {
+		historyError(w, ctxLogger, err)
+		return
+	}
+	writeProto(w, ctxLogger, summary)
+}
+
+// HandlePlayerView 处理通过 HTTP 查询玩家当前视图的请求：?session_token=...。
+// 返回的视图与 WebSocket 推送的完全相同，已按玩家身份过滤。
+func (s *Server) HandlePlayerView(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	room, st, ok := s.seatFromRequest(w, r, r.URL.Query().Get("session_token"))
+	if !ok {
+		return
+	}
+	ge := room.engine()
+	if ge == nil {
+		http.Error(w, "Game has not started", http.StatusConflict)
+		return
+	}
+	view := ge.GetPlayerView(st.playerID)
+	if view == nil {
+		http.Error(w, errGameStopped.Error(), http.StatusGone)
+		return
+	}
+	writeProto(w, ctxLogger, view)
+}
+
+// HandleEventLog 处理下载已结束游戏完整事件日志的请求：?game_id=...。
+// 日志包含所有隐藏信息，因此游戏进行中不可用。
+func (s *Server) HandleEventLog(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	gameID := r.URL.Query().Get("game_id")
+	_, events, _, err := s.finishedGame(r.Context(), gameID)
+	if err != nil {
+		historyError(w, ctxLogger, err)
+		return
+	}
+	writeProto(w, ctxLogger, &model.EventLog{GameId: gameID, Events: events})
+}
+
+// HandleReplay 处理下载已结束游戏回放文件的请求：?game_id=...。
+func (s *Server) HandleReplay(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	gameID := r.URL.Query().Get("game_id")
+	summary, events, finalState, err := s.finishedGame(r.Context(), gameID)
+	if err != nil {
+		historyError(w, ctxLogger, err)
+		return
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="tragedylooper-%s.replay.json"`, gameID))
+	writeProto(w, ctxLogger, &model.Replay{
+		FormatVersion: replayFormatVersion,
+		Summary:       summary,
+		Events:        events,
+		FinalState:    finalState,
+	})
+}
+
+// finishedGame 返回已结束游戏的概要、完整事件日志和最终状态。
+// 房间仍在内存中时从游戏引擎读取，否则从存储读取。
+func (s *Server) finishedGame(ctx context.Context, gameID string) (*model.RoomSummary, []*model.GameEvent, *model.GameState, error) {
+	if room, ok := s.getRoom(gameID); ok {
+		if room.state() != model.RoomState_ROOM_STATE_FINISHED {
+			return nil, nil, nil, errGameNotFinished
+		}
+		if ge := room.engine(); ge != nil {
+			events, finalState := ge.GetEventLog(), ge.Snapshot()
+			if finalState != nil {
+				return room.summary(), events, finalState, nil
+			}
+		}
+	}
+	if s.store == nil {
+		return nil, nil, nil, errRoomNotFound
+	}
+
+	record, err := s.store.LoadRoom(ctx, gameID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if record.GetLobby().GetState() != model.RoomState_ROOM_STATE_FINISHED {
+		return nil, nil, nil, errGameNotFinished
+	}
+	summary, err := s.storedSummary(ctx, record)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	events, err := s.store.LoadEvents(ctx, gameID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	finalState, err := s.store.LoadSnapshot(ctx, gameID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return summary, events, finalState, nil
+}
+
+// historyError 将查询历史记录的错误转换为 HTTP 状态码。
+func historyError(w http.ResponseWriter, ctxLogger *zap.Logger, err error) {
+	switch {
+	case errors.Is(err, errRoomNotFound), errors.Is(err, ErrNotFound):
+		http.Error(w, "Room not found", http.StatusNotFound)
+	case errors.Is(err, errGameNotFinished):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		ctxLogger.Error("Failed to load game history", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
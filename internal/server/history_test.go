@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseRoomStates(t *testing.T) {
+	states, err := parseRoomStates("")
+	assert.NoError(t, err)
+	assert.Equal(t, map[model.RoomState]bool{model.RoomState_ROOM_STATE_LOBBY: true}, states)
+
+	states, err = parseRoomStates("running, ROOM_STATE_FINISHED")
+	assert.NoError(t, err)
+	assert.Equal(t, map[model.RoomState]bool{model.RoomState_ROOM_STATE_RUNNING: true, model.RoomState_ROOM_STATE_FINISHED: true}, states)
+
+	states, err = parseRoomStates("all")
+	assert.NoError(t, err)
+	assert.Len(t, states, len(model.RoomState_name)-1)
+
+	_, err = parseRoomStates("unspecified")
+	assert.Error(t, err)
+	_, err = parseRoomStates("paused")
+	assert.Error(t, err)
+}
+
+// get 调用处理函数并返回响应。
+func get(handler http.HandlerFunc, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	return rec
+}
+
+// decode 将 protojson 响应解码为 msg。
+func decode[M proto.Message](t *testing.T, rec *httptest.ResponseRecorder, msg M) M {
+	t.Helper()
+	assert.NoError(t, protocolUnmarshaler.Unmarshal(rec.Body.Bytes(), msg))
+	return msg
+}
+
+func TestHistoryEndpoints(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	srv := NewServer("", nil, logger.New())
+	srv.SetStore(store)
+
+	lobby := NewRoom("lobby", logger.New())
+	host, _ := lobby.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	assert.NoError(t, lobby.configure(host.PlayerId, lobbySettings{ScriptID: "first_steps", ModelID: 1}, stubScriptConfig{}))
+	token := srv.issueSeat(lobby, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(lobby))
+	abandoned := NewRoom("abandoned", logger.New())
+	assert.NoError(t, srv.addRoom(abandoned))
+	abandoned.finish(model.RoomState_ROOM_STATE_ABANDONED, time.Now())
+
+	// 已从内存中移除、只保存在存储中的已结束游戏。
+	assert.NoError(t, store.SaveRoom(ctx, &model.RoomRecord{
+		GameId: "finished",
+		Lobby:  &model.LobbyState{GameId: "finished", State: model.RoomState_ROOM_STATE_FINISHED, ScriptId: "first_steps"},
+	}))
+	assert.NoError(t, store.AppendEvents(ctx, "finished", &model.GameEvent{Type: model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED}))
+	assert.NoError(t, store.SaveSnapshot(ctx, "finished", &model.GameState{GameId: "finished", CurrentLoop: 3}))
+	assert.NoError(t, store.SaveResult(ctx, &model.GameResult{GameId: "finished", Winner: model.PlayerRole_PLAYER_ROLE_MASTERMIND}))
+
+	// 默认只列出大厅中的房间，并包含剧本名称和模型标题。
+	rec := get(srv.HandleListRooms, "/list_rooms")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	list := decode(t, rec, &model.ListRoomsResponse{})
+	if assert.Len(t, list.Rooms, 1) {
+		assert.Equal(t, "lobby", list.Rooms[0].GameId)
+		assert.Equal(t, "First Steps", list.Rooms[0].ScriptName)
+		assert.Equal(t, "The Beginning", list.Rooms[0].ModelTitle)
+		assert.Len(t, list.Rooms[0].Seats, 1)
+	}
+
+	list = decode(t, get(srv.HandleListRooms, "/list_rooms?state=finished,abandoned"), &model.ListRoomsResponse{})
+	if assert.Len(t, list.Rooms, 2) {
+		assert.Equal(t, "abandoned", list.Rooms[0].GameId)
+		assert.Equal(t, "finished", list.Rooms[1].GameId)
+		assert.Equal(t, model.PlayerRole_PLAYER_ROLE_MASTERMIND, list.Rooms[1].GetResult().GetWinner())
+	}
+	assert.Equal(t, http.StatusBadRequest, get(srv.HandleListRooms, "/list_rooms?state=bogus").Code)
+
+	// 房间概要：内存中的和存储中的房间都可以查询。
+	summary := decode(t, get(srv.HandleGetRoom, "/room?game_id=lobby"), &model.RoomSummary{})
+	assert.Equal(t, model.RoomState_ROOM_STATE_LOBBY, summary.State)
+	summary = decode(t, get(srv.HandleGetRoom, "/room?game_id=finished"), &model.RoomSummary{})
+	assert.Equal(t, model.RoomState_ROOM_STATE_FINISHED, summary.State)
+	assert.Equal(t, http.StatusNotFound, get(srv.HandleGetRoom, "/room?game_id=missing").Code)
+
+	// 游戏开始前没有玩家视图。
+	assert.Equal(t, http.StatusConflict, get(srv.HandlePlayerView, "/player_view?session_token="+token).Code)
+	assert.Equal(t, http.StatusUnauthorized, get(srv.HandlePlayerView, "/player_view?session_token=bogus").Code)
+
+	// 完整事件日志和回放只在游戏结束后提供。
+	assert.Equal(t, http.StatusForbidden, get(srv.HandleEventLog, "/event_log?game_id=lobby").Code)
+	assert.Equal(t, http.StatusNotFound, get(srv.HandleEventLog, "/event_log?game_id=missing").Code)
+	log := decode(t, get(srv.HandleEventLog, "/event_log?game_id=finished"), &model.EventLog{})
+	assert.Len(t, log.Events, 1)
+
+	rec = get(srv.HandleReplay, "/replay?game_id=finished")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Disposition"), "attachment")
+	replay := decode(t, rec, &model.Replay{})
+	assert.Equal(t, int32(replayFormatVersion), replay.FormatVersion)
+	assert.Equal(t, int32(3), replay.GetFinalState().GetCurrentLoop())
+	assert.Len(t, replay.Events, 1)
+	assert.Equal(t, model.PlayerRole_PLAYER_ROLE_MASTERMIND, replay.GetSummary().GetResult().GetWinner())
+}
@@ -0,0 +1,216 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// roomSweepInterval 是检查过期房间的间隔。
+const roomSweepInterval = 30 * time.Second
+
+var (
+	errTooManyRooms = errors.New("too many rooms")
+	errShuttingDown = errors.New("server is shutting down")
+)
+
+// RoomLimits 配置房间数量上限和房间的生命周期。
+type RoomLimits struct {
+	// MaxRooms 是服务器同时保留的房间数量上限，0 表示不限制。
+	MaxRooms int
+	// IdleTimeout 是房间在没有任何连接和游戏事件时保留的时间，超时后房间被放弃；0 表示永不过期。
+	IdleTimeout time.Duration
+	// FinishedRetention 是游戏结束后房间继续保留的时间，便于玩家查看结果；0 表示下一次清理时即移除。
+	FinishedRetention time.Duration
+}
+
+// DefaultRoomLimits 返回默认的房间限制。
+func DefaultRoomLimits() RoomLimits {
+	return RoomLimits{
+		MaxRooms:          100,
+		IdleTimeout:       30 * time.Minute,
+		FinishedRetention: 10 * time.Minute,
+	}
+}
+
+// ResultRecorder 在已结束的房间被移除之前保存其结果。
+// 返回错误时房间会保留，并在下一次清理时重试。
+type ResultRecorder func(room *Room) error
+
+// SetRoomLimits 设置房间数量上限和生命周期。必须在开始处理请求之前调用。
+func (s *Server) SetRoomLimits(limits RoomLimits) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limits = limits
+}
+
+// SetResultRecorder 注册保存游戏结果的函数。必须在开始处理请求之前调用。
+func (s *Server) SetResultRecorder(recorder ResultRecorder) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resultRecorder = recorder
+}
+
+// addRoom 注册新房间，超过房间上限或服务器正在关闭时返回错误。
+func (s *Server) addRoom(room *Room) error {
+	s.mu.Lock()
+	select {
+	case <-s.shutdownChan:
+		s.mu.Unlock()
+		return errShuttingDown
+	default:
+	}
+	if s.limits.MaxRooms > 0 && len(s.rooms) >= s.limits.MaxRooms {
+		s.mu.Unlock()
+		return errTooManyRooms
+	}
+	if _, exists := s.rooms[room.GameId]; exists {
+		s.mu.Unlock()
+		return fmt.Errorf("game ID %s already exists", room.GameId)
+	}
+	room.store = s.store
+	room.connLimits = s.connLimits
+	s.rooms[room.GameId] = room
+	s.mu.Unlock()
+
+	room.saveRecord()
+	return nil
+}
+
+// removeRoom 移除并停止房间。
+func (s *Server) removeRoom(room *Room, reason string) {
+	s.mu.Lock()
+	if s.rooms[room.GameId] == room {
+		delete(s.rooms, room.GameId)
+	}
+	s.mu.Unlock()
+	room.Stop()
+	s.logger.Info("Room removed", zap.String("gameID", room.GameId), zap.String("reason", reason))
+}
+
+// runJanitor 定期清理过期和已结束的房间，直到服务器关闭。
+func (s *Server) runJanitor() {
+	ticker := time.NewTicker(roomSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.shutdownChan:
+			return
+		case now := <-ticker.C:
+			s.sweepRooms(now)
+		}
+	}
+}
+
+// sweepRooms 保存并移除保留期已过的已结束房间，移除被放弃的房间，并放弃空闲超时的房间。
+func (s *Server) sweepRooms(now time.Time) {
+	s.mu.RLock()
+	limits, recorder := s.limits, s.resultRecorder
+	rooms := make([]*Room, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		rooms = append(rooms, room)
+	}
+	s.mu.RUnlock()
+
+	for _, room := range rooms {
+		if room.hasConnections() {
+			room.touch(now)
+		}
+		switch room.state() {
+		case model.RoomState_ROOM_STATE_FINISHED:
+			if now.Sub(room.finishedTime()) < limits.FinishedRetention {
+				continue
+			}
+			if recorder != nil {
+				if err := recorder(room); err != nil {
+					s.logger.Error("Failed to record game result, keeping room", zap.String("gameID", room.GameId), zap.Error(err))
+					continue
+				}
+			}
+			s.removeRoom(room, "finished")
+		case model.RoomState_ROOM_STATE_ABANDONED:
+			s.removeRoom(room, "abandoned")
+		default:
+			if limits.IdleTimeout > 0 && room.idleFor(now) >= limits.IdleTimeout {
+				room.finish(model.RoomState_ROOM_STATE_ABANDONED, now)
+				s.removeRoom(room, "idle")
+			}
+		}
+	}
+}
+
+// touch 记录房间的最近一次活动。
+func (r *Room) touch(now time.Time) {
+	r.lastActive.Store(now.UnixNano())
+}
+
+// idleFor 返回房间自最近一次活动以来经过的时间。
+func (r *Room) idleFor(now time.Time) time.Duration {
+	return now.Sub(time.Unix(0, r.lastActive.Load()))
+}
+
+// hasConnections 报告房间中是否有已连接的玩家或旁观者。
+func (r *Room) hasConnections() bool {
+	for _, st := range r.seatList() {
+		if st.connected() {
+			return true
+		}
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.spectators) > 0
+}
+
+// state 返回房间的生命周期状态。
+func (r *Room) state() model.RoomState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lobby.State
+}
+
+// finishedTime 返回房间进入已结束或已放弃状态的时间。
+func (r *Room) finishedTime() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.finishedAt
+}
+
+// finish 将房间标记为已结束或已放弃，并报告状态是否改变。已结束或已放弃的房间不会再改变状态。
+func (r *Room) finish(state model.RoomState, now time.Time) bool {
+	r.mu.Lock()
+	switch r.lobby.State {
+	case model.RoomState_ROOM_STATE_FINISHED, model.RoomState_ROOM_STATE_ABANDONED:
+		r.mu.Unlock()
+		return false
+	}
+	r.lobby.State = state
+	r.finishedAt = now
+	r.mu.Unlock()
+
+	r.logger.Info("Room finished", zap.String("state", state.String()))
+	r.broadcastLobby()
+	return true
+}
+
+// wait 等待房间的游戏引擎和事件广播 goroutine 退出。必须在 Stop 之后调用。
+func (r *Room) wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		if ge := r.engine(); ge != nil {
+			<-ge.Done()
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("room %s: %w", r.GameId, ctx.Err())
+	}
+}
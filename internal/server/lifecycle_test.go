@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoomLimit(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	srv.SetRoomLimits(RoomLimits{MaxRooms: 1})
+
+	assert.NoError(t, srv.addRoom(NewRoom("game-1", logger.New())))
+	assert.Error(t, srv.addRoom(NewRoom("game-1", logger.New())), "duplicate game ID")
+	assert.ErrorIs(t, srv.addRoom(NewRoom("game-2", logger.New())), errTooManyRooms)
+
+	assert.NoError(t, srv.Shutdown(context.Background()))
+	assert.ErrorIs(t, srv.addRoom(NewRoom("game-3", logger.New())), errShuttingDown)
+	// 信号处理和 main 中的 defer 都可能调用 Shutdown。
+	assert.NoError(t, srv.Shutdown(context.Background()))
+}
+
+func TestSweepRooms(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	srv.SetRoomLimits(RoomLimits{IdleTimeout: time.Minute, FinishedRetention: time.Minute})
+	now := time.Now()
+
+	idle := NewRoom("idle", logger.New())
+	busy := NewRoom("busy", logger.New())
+	finished := NewRoom("finished", logger.New())
+	for _, room := range []*Room{idle, busy, finished} {
+		assert.NoError(t, srv.addRoom(room))
+	}
+	st := newSeat(1, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, "token", "")
+	busy.addSeat(st)
+	busy.AddClient(newClient(nil, nil), st, 0)
+	assert.True(t, finished.finish(model.RoomState_ROOM_STATE_FINISHED, now))
+	assert.False(t, finished.finish(model.RoomState_ROOM_STATE_ABANDONED, now), "finished rooms keep their state")
+
+	var recorded []string
+	fail := true
+	srv.SetResultRecorder(func(room *Room) error {
+		if fail {
+			return errors.New("disk full")
+		}
+		recorded = append(recorded, room.GameId)
+		return nil
+	})
+
+	// 保留期内的已结束房间不会被移除。
+	srv.sweepRooms(now.Add(30 * time.Second))
+	_, ok := srv.getRoom("finished")
+	assert.True(t, ok)
+
+	// 空闲超时的房间被放弃，有连接的房间保留；结果保存失败的已结束房间保留到下一次清理。
+	srv.sweepRooms(now.Add(2 * time.Minute))
+	_, ok = srv.getRoom("idle")
+	assert.False(t, ok)
+	assert.Equal(t, model.RoomState_ROOM_STATE_ABANDONED, idle.state())
+	_, ok = srv.getRoom("busy")
+	assert.True(t, ok)
+
+	_, ok = srv.getRoom("finished")
+	assert.True(t, ok, "result recording failed")
+
+	fail = false
+	srv.sweepRooms(now.Add(2 * time.Minute))
+	_, ok = srv.getRoom("finished")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"finished"}, recorded)
+
+	assert.NoError(t, srv.Shutdown(context.Background()))
+	busy.Stop() // 重复停止是安全的
+}
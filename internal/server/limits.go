@@ -0,0 +1,105 @@
+package server
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// ConnectionLimits 限制单个连接和席位可以使用的资源。
+type ConnectionLimits struct {
+	// MaxMessageSize 是客户端通过 WebSocket 发送的单条消息的最大字节数，超过时断开连接。
+	MaxMessageSize int64
+	// WriteWait 是向客户端写入一条消息的超时。
+	WriteWait time.Duration
+	// PongWait 是等待客户端任何消息（包括 pong）的超时，超时后断开连接。
+	PongWait time.Duration
+	// PingPeriod 是服务器发送 ping 的间隔，必须小于 PongWait。
+	PingPeriod time.Duration
+	// ActionRate 是每个席位每秒可以提交的操作和聊天消息数量，0 表示不限制。
+	ActionRate float64
+	// ActionBurst 是席位可以连续提交的操作数量上限。
+	ActionBurst int
+}
+
+// DefaultConnectionLimits 返回默认的连接限制。
+func DefaultConnectionLimits() ConnectionLimits {
+	return ConnectionLimits{
+		MaxMessageSize: 64 << 10,
+		WriteWait:      10 * time.Second,
+		PongWait:       60 * time.Second,
+		PingPeriod:     54 * time.Second,
+		ActionRate:     5,
+		ActionBurst:    10,
+	}
+}
+
+// SetConnectionLimits 设置连接和席位的限制。必须在开始处理请求之前调用。
+func (s *Server) SetConnectionLimits(limits ConnectionLimits) {
+	s.connLimits = limits
+}
+
+// SetAllowedOrigins 设置允许建立 WebSocket 连接的来源，例如 "https://example.com"；"*" 允许所有来源。
+// 未设置时只接受与服务器同源的浏览器请求，以及不带 Origin 头的非浏览器客户端。
+// 必须在开始处理请求之前调用。
+func (s *Server) SetAllowedOrigins(origins []string) {
+	s.allowedOrigins = origins
+}
+
+// checkOrigin 检查 WebSocket 握手的 Origin 头，防止其他网站借用户的浏览器连接到服务器。
+func (s *Server) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range s.allowedOrigins {
+		if allowed == "*" || strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin) {
+			return true
+		}
+	}
+	u, err := url.Parse(origin)
+	return err == nil && strings.EqualFold(u.Host, r.Host)
+}
+
+// allowAction 按连接限制检查席位能否再提交一次操作或聊天消息，超出限制时返回 codeResourceExhausted 错误。
+func (r *Room) allowAction(st *seat) error {
+	if !st.allowAction(time.Now(), r.connLimits.ActionRate, r.connLimits.ActionBurst) {
+		return rpcErrorf(codeResourceExhausted, "too many actions, retry later")
+	}
+	return nil
+}
+
+// deadline 返回从现在起 d 之后的时间；d 小于等于 0 时返回零值，表示没有期限。
+func deadline(d time.Duration) time.Time {
+	if d <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(d)
+}
+
+// rateLimiter 是令牌桶限流器。调用者负责同步。
+type rateLimiter struct {
+	tokens float64
+	last   time.Time
+}
+
+// allow 报告在 now 时刻能否再执行一次操作。令牌以每秒 rate 个的速度补充，最多积累 burst 个。
+// rate 小于等于 0 时不限制。
+func (l *rateLimiter) allow(now time.Time, rate float64, burst int) bool {
+	if rate <= 0 {
+		return true
+	}
+	if l.last.IsZero() {
+		l.tokens = float64(burst)
+	} else {
+		l.tokens += now.Sub(l.last).Seconds() * rate
+	}
+	l.tokens = min(l.tokens, float64(burst))
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	var l rateLimiter
+	now := time.Unix(1000, 0)
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.allow(now, 2, 3), "burst of 3 should be allowed")
+	}
+	assert.False(t, l.allow(now, 2, 3))
+	assert.True(t, l.allow(now.Add(500*time.Millisecond), 2, 3), "one token refills every 500ms")
+	assert.False(t, l.allow(now.Add(500*time.Millisecond), 2, 3))
+
+	// 长时间空闲后令牌数不超过 burst。
+	later := now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.allow(later, 2, 3))
+	}
+	assert.False(t, l.allow(later, 2, 3))
+
+	assert.True(t, l.allow(later, 0, 0), "zero rate disables limiting")
+}
+
+func TestSubmitActionRateLimited(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	srv.SetConnectionLimits(ConnectionLimits{ActionRate: 1, ActionBurst: 1})
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(room))
+	st := room.seatByToken(host.PlayerId, token)
+	action := &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PassTurn{PassTurn: &model.PassTurnAction{}}}
+
+	err := room.submitAction(st, action)
+	assert.ErrorIs(t, err, errGameNotStarted, "first action passes the rate limit")
+	err = room.submitAction(st, action)
+	assert.Equal(t, model.ErrorCode_ERROR_CODE_RATE_LIMITED, seatErrorCode(err))
+	err = room.sendChat(st, &model.SendChat{Text: "hi"})
+	assert.Equal(t, model.ErrorCode_ERROR_CODE_RATE_LIMITED, seatErrorCode(err), "chat shares the seat's limit")
+}
+
+func TestClientDisconnectedWhenQueueFull(t *testing.T) {
+	c := newClient(nil, nil)
+	for i := 0; i < sendQueueSize; i++ {
+		assert.True(t, c.Send(newAckMessage(uint64(i))))
+	}
+	assert.False(t, c.Send(newAckMessage(0)))
+	received := 0
+	for range c.send {
+		received++
+	}
+	assert.Equal(t, sendQueueSize, received, "a client whose queue overflows is closed after the queued messages")
+}
+
+func TestCheckOrigin(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	request := func(origin string) bool {
+		r := httptest.NewRequest("GET", "http://game.example.com/ws", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		return srv.checkOrigin(r)
+	}
+	assert.True(t, request(""), "non-browser clients send no Origin")
+	assert.True(t, request("http://game.example.com"))
+	assert.False(t, request("https://evil.example.com"))
+
+	srv.SetAllowedOrigins([]string{"https://evil.example.com/"})
+	assert.True(t, request("https://evil.example.com"))
+	assert.False(t, request("https://other.example.com"))
+
+	srv.SetAllowedOrigins([]string{"*"})
+	assert.True(t, request("https://other.example.com"))
+}
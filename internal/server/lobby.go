@@ -0,0 +1,449 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// errGameStarted 表示游戏已经开始，大厅操作不再可用。
+	errGameStarted = errors.New("game already started")
+	// errNotHost 表示只有房主可以执行该操作。
+	errNotHost = errors.New("only the host can do this")
+	// errGameNotStarted 表示游戏尚未开始，房间仍在大厅中。
+	errGameNotStarted = errors.New("game has not started")
+)
+
+// addLobbySeat 在大厅中为玩家分配座位和服务器端玩家 ID。
+// 每个房间只有一个主谋座位和最多 engine.MaxProtagonists 个主角座位。第一个人类玩家成为房主。
+func (r *Room) addLobbySeat(name string, role model.PlayerRole, isLlm bool) (*model.LobbySeat, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lobby.State != model.RoomState_ROOM_STATE_LOBBY {
+		return nil, errGameStarted
+	}
+	if err := checkSeatAvailable(r.lobby.Seats, role); err != nil {
+		return nil, err
+	}
+
+	hasHost := false
+	for _, s := range r.lobby.Seats {
+		hasHost = hasHost || s.Host
+	}
+	lobbySeat := &model.LobbySeat{
+		PlayerId: r.nextPlayerID,
+		Name:     name,
+		Role:     role,
+		IsLlm:    isLlm,
+		Ready:    isLlm,
+		Host:     !hasHost && !isLlm,
+	}
+	r.nextPlayerID++
+	r.lobby.Seats = append(r.lobby.Seats, lobbySeat)
+	r.logger.Info("Seat taken in lobby", zap.Int32("playerID", lobbySeat.PlayerId), zap.String("role", role.String()), zap.Bool("isLlm", isLlm))
+	return proto.Clone(lobbySeat).(*model.LobbySeat), nil
+}
+
+// checkSeatAvailable 检查座位列表中是否还有指定身份的空座位。
+func checkSeatAvailable(seats []*model.LobbySeat, role model.PlayerRole) error {
+	count := 0
+	for _, s := range seats {
+		if s.Role == role {
+			count++
+		}
+	}
+	switch role {
+	case model.PlayerRole_PLAYER_ROLE_MASTERMIND:
+		if count >= 1 {
+			return fmt.Errorf("mastermind seat is already taken")
+		}
+	case model.PlayerRole_PLAYER_ROLE_PROTAGONIST:
+		if count >= engine.MaxProtagonists {
+			return fmt.Errorf("all %d protagonist seats are taken", engine.MaxProtagonists)
+		}
+	default:
+		return fmt.Errorf("invalid role %s", role)
+	}
+	return nil
+}
+
+// lobbySeat 返回玩家的大厅座位，调用者必须持有 r.mu。
+func (r *Room) lobbySeat(playerID int32) *model.LobbySeat {
+	for _, s := range r.lobby.Seats {
+		if s.PlayerId == playerID {
+			return s
+		}
+	}
+	return nil
+}
+
+// lobbySettings 是房主在大厅中可以修改的设置。
+type lobbySettings struct {
+	ScriptID      string               `json:"script_id"`
+	ModelID       int32                `json:"model_id"`
+	DifficultySet *model.DifficultySet `json:"difficulty_set"` // 可选：所选的难度组合，必须是剧本模型提供的组合之一
+	FillWithAI    bool                 `json:"fill_with_ai"`   // 开始游戏时是否用 AI 填补空座位
+	// 可选：允许旁观者在游戏进行中获得完整视图，并按此延迟发送；0 表示不允许
+	SpectatorFullViewDelaySeconds int32 `json:"spectator_full_view_delay_seconds"`
+}
+
+// configure 修改大厅中的游戏设置。只有房主可以修改；修改后所有人类玩家需要重新准备。
+func (r *Room) configure(playerID int32, settings lobbySettings, gameConfig loader.ScriptConfig) error {
+	if settings.SpectatorFullViewDelaySeconds < 0 {
+		return fmt.Errorf("spectator delay must not be negative")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lobby.State != model.RoomState_ROOM_STATE_LOBBY {
+		return errGameStarted
+	}
+	if !r.lobbySeat(playerID).GetHost() {
+		return errNotHost
+	}
+
+	r.lobby.ScriptId = settings.ScriptID
+	r.lobby.ModelId = settings.ModelID
+	r.lobby.DifficultySet = settings.DifficultySet
+	r.lobby.FillWithAi = settings.FillWithAI
+	r.lobby.SpectatorFullViewDelaySeconds = settings.SpectatorFullViewDelaySeconds
+	r.lobby.ScriptName = gameConfig.GetScript().GetName()
+	r.lobby.ModelTitle = gameConfig.GetModel().GetMetadata().GetTitle()
+	r.gameConfig = gameConfig
+	for _, s := range r.lobby.Seats {
+		s.Ready = s.IsLlm
+	}
+	return nil
+}
+
+// isHost 报告玩家是否为房主。
+func (r *Room) isHost(playerID int32) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.lobbySeat(playerID).GetHost()
+}
+
+// setReady 设置玩家在大厅中的准备状态。
+func (r *Room) setReady(playerID int32, ready bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.lobby.State != model.RoomState_ROOM_STATE_LOBBY {
+		return errGameStarted
+	}
+	s := r.lobbySeat(playerID)
+	if s == nil {
+		return fmt.Errorf("player %d has no seat in room %s", playerID, r.GameId)
+	}
+	s.Ready = ready
+	return nil
+}
+
+// newEngineFunc 用最终的玩家列表和剧本配置创建游戏引擎。
+type newEngineFunc func(players []*model.Player, gameConfig loader.ScriptConfig) (*engine.GameEngine, error)
+
+// start 检查准备状态，按需用 AI 填补空座位，用最终的玩家列表创建并启动游戏引擎。
+// 只有房主可以开始游戏。
+func (r *Room) start(playerID int32, newEngine newEngineFunc) error {
+	r.mu.Lock()
+	if err := r.startLocked(playerID, newEngine); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	scriptID, modelID := r.lobby.GetScriptId(), r.lobby.GetModelId()
+	r.mu.Unlock()
+
+	r.run()
+	r.logger.Info("Game started", zap.String("scriptID", scriptID), zap.Int32("modelID", modelID))
+	return nil
+}
+
+// run 启动房间的游戏引擎和事件广播。引擎必须已经设置。
+func (r *Room) run() {
+	ge := r.engine()
+	ge.Start()
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.broadcastGameEvents()
+	}()
+
+	// 尚未连接的人类玩家视为断线，按引擎的断线策略处理。
+	for _, st := range r.seatList() {
+		if !st.connected() {
+			ge.SetPlayerConnected(st.playerID, false)
+		}
+	}
+	r.broadcastLobby()
+}
+
+func (r *Room) startLocked(playerID int32, newEngine newEngineFunc) error {
+	if r.lobby.State != model.RoomState_ROOM_STATE_LOBBY {
+		return errGameStarted
+	}
+	if !r.lobbySeat(playerID).GetHost() {
+		return errNotHost
+	}
+	if r.gameConfig == nil {
+		return fmt.Errorf("no script selected")
+	}
+	for _, s := range r.lobby.Seats {
+		if !s.Ready {
+			return fmt.Errorf("player %d (%s) is not ready", s.PlayerId, s.Name)
+		}
+	}
+
+	seats := make([]*model.LobbySeat, len(r.lobby.Seats))
+	copy(seats, r.lobby.Seats)
+	nextPlayerID := r.nextPlayerID
+	if r.lobby.FillWithAi {
+		fill := func(role model.PlayerRole, name string) {
+			for checkSeatAvailable(seats, role) == nil {
+				seats = append(seats, &model.LobbySeat{
+					PlayerId: nextPlayerID,
+					Name:     fmt.Sprintf("%s %d", name, nextPlayerID),
+					Role:     role,
+					IsLlm:    true,
+					Ready:    true,
+				})
+				nextPlayerID++
+			}
+		}
+		fill(model.PlayerRole_PLAYER_ROLE_MASTERMIND, "AI Mastermind")
+		fill(model.PlayerRole_PLAYER_ROLE_PROTAGONIST, "AI Protagonist")
+	}
+
+	if checkSeatAvailable(seats, model.PlayerRole_PLAYER_ROLE_MASTERMIND) == nil {
+		return fmt.Errorf("the mastermind seat is empty")
+	}
+	players := make([]*model.Player, 0, len(seats))
+	protagonists := 0
+	for _, s := range seats {
+		if s.Role == model.PlayerRole_PLAYER_ROLE_PROTAGONIST {
+			protagonists++
+		}
+		players = append(players, &model.Player{
+			Id:                 s.PlayerId,
+			Name:               s.Name,
+			Role:               s.Role,
+			IsLlm:              s.IsLlm,
+			DeductionKnowledge: &model.PlayerDeductionKnowledge{},
+		})
+	}
+	if protagonists == 0 {
+		return fmt.Errorf("at least one protagonist is required")
+	}
+
+	ge, err := newEngine(players, r.gameConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create game engine: %w", err)
+	}
+	r.gameEngine = ge
+	r.lobby.Seats = seats
+	r.lobby.State = model.RoomState_ROOM_STATE_RUNNING
+	r.nextPlayerID = nextPlayerID
+	return nil
+}
+
+// lobbySnapshot 返回大厅状态的副本，并填写每个座位当前的连接状态。
+func (r *Room) lobbySnapshot() *model.LobbyState {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	snapshot := proto.Clone(r.lobby).(*model.LobbyState)
+	snapshot.Spectators = int32(len(r.spectators))
+	for _, s := range snapshot.Seats {
+		if st, ok := r.seats[s.PlayerId]; ok {
+			s.Connected = st.connected()
+		}
+	}
+	return snapshot
+}
+
+// broadcastLobby 向所有已连接的席位和旁观者推送大厅状态。大厅信息是公开的，因此旁观者不会延迟收到。
+// 大厅状态的每次变化都会经过这里，因此房间记录也在此保存。
+func (r *Room) broadcastLobby() {
+	r.touch(time.Now())
+	r.saveRecord()
+	snapshot := r.lobbySnapshot()
+	for _, st := range r.seatList() {
+		if st.connected() {
+			r.send(st, &model.ServerMessage{Message: &model.ServerMessage_LobbyUpdate{LobbyUpdate: snapshot}})
+		}
+	}
+	for _, sp := range r.spectatorList() {
+		sp.seat.broadcast(&model.ServerMessage{Message: &model.ServerMessage_LobbyUpdate{LobbyUpdate: snapshot}})
+	}
+}
+
+// wrapLobbyError 为大厅操作的错误附加错误码。
+func wrapLobbyError(err error) error {
+	if errors.Is(err, errNotHost) {
+		return newRPCError(codePermissionDenied, err)
+	}
+	return newRPCError(codeAborted, err)
+}
+
+// lobbyError 将大厅操作的错误转换为 HTTP 状态码。
+func lobbyError(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), errorCode(wrapLobbyError(err)).httpStatus())
+}
+
+// seatFromRequest 认证调用者并验证席位会话令牌。失败时写入错误响应并返回 false。
+func (s *Server) seatFromRequest(w http.ResponseWriter, r *http.Request, token string) (*Room, *seat, bool) {
+	identity, ok := s.authenticate(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	room, st, err := s.authorizeSeat(identity, token)
+	if err != nil {
+		httpError(w, logger.LoggerFromContext(r.Context()), err)
+		return nil, nil, false
+	}
+	return room, st, true
+}
+
+// loadGameConfig 加载剧本配置并应用所选的难度组合。
+func (s *Server) loadGameConfig(scriptID string, modelID int32, difficultySet *model.DifficultySet) (loader.ScriptConfig, error) {
+	gameConfig, err := loader.LoadConfig(s.gameDataDir, scriptID, modelID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading game data: %w", err)
+	}
+	if err := gameConfig.SetDifficultySet(difficultySet); err != nil {
+		return nil, err
+	}
+	return gameConfig, nil
+}
+
+// HandleConfigureRoom 处理房主在大厅中修改剧本、模型、难度和 AI 填补设置的请求。
+func (s *Server) HandleConfigureRoom(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		SessionToken string `json:"session_token"`
+		lobbySettings
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	room, st, ok := s.seatFromRequest(w, r, req.SessionToken)
+	if !ok {
+		return
+	}
+	// 先检查房主身份再加载剧本，其他玩家不能触发加载，也看不到加载错误。
+	if !room.isHost(st.playerID) {
+		lobbyError(w, errNotHost)
+		return
+	}
+
+	gameConfig, err := s.loadGameConfig(req.ScriptID, req.ModelID, req.DifficultySet)
+	if err != nil {
+		ctxLogger.Warn("Invalid room configuration", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := room.configure(st.playerID, req.lobbySettings, gameConfig); err != nil {
+		lobbyError(w, err)
+		return
+	}
+	room.broadcastLobby()
+	writeLobby(w, ctxLogger, room)
+}
+
+// HandleSetReady 处理玩家在大厅中设置准备状态的请求。
+func (s *Server) HandleSetReady(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		SessionToken string `json:"session_token"`
+		Ready        bool   `json:"ready"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	room, st, ok := s.seatFromRequest(w, r, req.SessionToken)
+	if !ok {
+		return
+	}
+
+	if err := room.setReady(st.playerID, req.Ready); err != nil {
+		lobbyError(w, err)
+		return
+	}
+	room.broadcastLobby()
+	writeLobby(w, ctxLogger, room)
+}
+
+// HandleStartGame 处理房主开始游戏的请求。
+func (s *Server) HandleStartGame(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		SessionToken string `json:"session_token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	room, st, ok := s.seatFromRequest(w, r, req.SessionToken)
+	if !ok {
+		return
+	}
+
+	err := room.start(st.playerID, func(players []*model.Player, gameConfig loader.ScriptConfig) (*engine.GameEngine, error) {
+		engineLogger := s.logger.With(zap.String("gameID", room.GameId))
+		ge, err := engine.NewGameEngine(engineLogger, players, s.newActionGenerator(engineLogger), gameConfig)
+		if err != nil {
+			return nil, err
+		}
+		s.configureEngine(ge)
+		return ge, nil
+	})
+	if err != nil {
+		ctxLogger.Warn("Failed to start game", zap.String("gameID", room.GameId), zap.Error(err))
+		lobbyError(w, err)
+		return
+	}
+	writeLobby(w, ctxLogger, room)
+}
+
+// writeLobby 将房间的大厅状态以 JSON 写入响应。
+func writeLobby(w http.ResponseWriter, ctxLogger *zap.Logger, room *Room) {
+	writeProto(w, ctxLogger, room.lobbySnapshot())
+}
+
+// writeProto 将消息以 protojson 写入响应。
+func writeProto(w http.ResponseWriter, ctxLogger *zap.Logger, msg proto.Message) {
+	data, err := protocolMarshaler.Marshal(msg)
+	if err != nil {
+		ctxLogger.Error("Error encoding response", zap.String("message", string(msg.ProtoReflect().Descriptor().Name())), zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		ctxLogger.Error("Error writing response", zap.Error(err))
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubScriptConfig 满足 loader.ScriptConfig，仅用于不需要真正加载剧本的测试。
+type stubScriptConfig struct {
+	loader.ScriptConfig
+}
+
+func (stubScriptConfig) GetScript() *model.ScriptConfig {
+	return &model.ScriptConfig{Id: 1, Name: "First Steps"}
+}
+
+func (stubScriptConfig) GetModel() *model.ScriptModel {
+	return &model.ScriptModel{Id: 1, Metadata: &model.ScriptMetadata{Title: "The Beginning"}}
+}
+
+func TestLobbySeats(t *testing.T) {
+	room := NewRoom("game-1", logger.New())
+
+	host, err := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), host.PlayerId)
+	assert.True(t, host.Host)
+	assert.False(t, host.Ready)
+
+	mastermind, err := room.addLobbySeat("bob", model.PlayerRole_PLAYER_ROLE_MASTERMIND, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), mastermind.PlayerId)
+	assert.False(t, mastermind.Host)
+
+	_, err = room.addLobbySeat("carol", model.PlayerRole_PLAYER_ROLE_MASTERMIND, false)
+	assert.Error(t, err, "second mastermind must be rejected")
+	_, err = room.addLobbySeat("dave", model.PlayerRole_PLAYER_ROLE_UNSPECIFIED, false)
+	assert.Error(t, err)
+
+	for i := 1; i < engine.MaxProtagonists; i++ {
+		_, err = room.addLobbySeat("protagonist", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+		assert.NoError(t, err)
+	}
+	_, err = room.addLobbySeat("extra", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	assert.Error(t, err, "protagonist seats are limited")
+
+	assert.ErrorIs(t, room.configure(mastermind.PlayerId, lobbySettings{ScriptID: "s", ModelID: 1}, stubScriptConfig{}), errNotHost)
+	assert.NoError(t, room.setReady(mastermind.PlayerId, true))
+	assert.NoError(t, room.configure(host.PlayerId, lobbySettings{ScriptID: "s", ModelID: 1}, stubScriptConfig{}))
+	assert.False(t, room.lobbySnapshot().Seats[1].Ready, "changing settings resets readiness")
+	assert.Error(t, room.setReady(99, true))
+}
+
+func TestLobbyStart(t *testing.T) {
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+
+	var got []*model.Player
+	errStub := errors.New("stub engine")
+	newEngine := func(players []*model.Player, _ loader.ScriptConfig) (*engine.GameEngine, error) {
+		got = players
+		return nil, errStub
+	}
+
+	err := room.start(host.PlayerId, newEngine)
+	assert.ErrorContains(t, err, "no script selected")
+
+	assert.NoError(t, room.configure(host.PlayerId, lobbySettings{ScriptID: "s", ModelID: 1}, stubScriptConfig{}))
+	err = room.start(host.PlayerId, newEngine)
+	assert.ErrorContains(t, err, "not ready")
+
+	assert.NoError(t, room.setReady(host.PlayerId, true))
+	err = room.start(host.PlayerId, newEngine)
+	assert.ErrorContains(t, err, "mastermind seat is empty")
+
+	// 开启 AI 填补后，空座位由 AI 补齐，引擎收到完整的玩家列表。
+	assert.NoError(t, room.configure(host.PlayerId, lobbySettings{ScriptID: "s", ModelID: 1, FillWithAI: true}, stubScriptConfig{}))
+	assert.NoError(t, room.setReady(host.PlayerId, true))
+	err = room.start(host.PlayerId, newEngine)
+	assert.ErrorIs(t, err, errStub)
+	if assert.Len(t, got, 1+engine.MaxProtagonists) {
+		assert.Equal(t, int32(1), got[0].Id)
+		assert.False(t, got[0].IsLlm)
+		roles := map[model.PlayerRole]int{}
+		for _, p := range got[1:] {
+			assert.True(t, p.IsLlm)
+			roles[p.Role]++
+		}
+		assert.Equal(t, 1, roles[model.PlayerRole_PLAYER_ROLE_MASTERMIND])
+		assert.Equal(t, engine.MaxProtagonists-1, roles[model.PlayerRole_PLAYER_ROLE_PROTAGONIST])
+	}
+
+	// 引擎创建失败时大厅保持不变。
+	lobby := room.lobbySnapshot()
+	assert.Equal(t, model.RoomState_ROOM_STATE_LOBBY, lobby.State)
+	assert.Len(t, lobby.Seats, 1)
+}
+
+func TestConfigureRoomRequiresHost(t *testing.T) {
+	srv := NewServer(t.TempDir(), nil, logger.New())
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	guest, _ := room.addLobbySeat("bob", model.PlayerRole_PLAYER_ROLE_MASTERMIND, false)
+	assert.NoError(t, srv.addRoom(room))
+
+	configure := func(playerID int32, role model.PlayerRole) *httptest.ResponseRecorder {
+		token := srv.issueSeat(room, playerID, role, &Identity{})
+		body := `{"session_token":"` + token + `","script_id":"missing","model_id":1}`
+		rec := httptest.NewRecorder()
+		srv.HandleConfigureRoom(rec, httptest.NewRequest(http.MethodPost, "/configure_room", strings.NewReader(body)))
+		return rec
+	}
+
+	// 非房主在加载剧本之前就被拒绝，看不到加载错误。
+	rec := configure(guest.PlayerId, guest.Role)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "game data")
+
+	rec = configure(host.PlayerId, host.Role)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "game data")
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/constellation39/tragedyLooper/internal/metrics"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// actionsTotal 按结果统计玩家通过任意传输提交的操作。被拒绝的操作以 Connect 错误码作为原因，例如
+// resource_exhausted 表示超出频率限制，failed_precondition 表示游戏尚未开始。
+var actionsTotal = metrics.Default.CounterVec("tragedylooper_actions_total",
+	"Player actions submitted to rooms, by result and rejection reason.", "result", "reason")
+
+// observeAction 记录一次操作提交的结果。
+func observeAction(err error) {
+	if err == nil {
+		actionsTotal.WithLabelValues("accepted", "").Inc()
+		return
+	}
+	actionsTotal.WithLabelValues("rejected", string(errorCode(err))).Inc()
+}
+
+// registerMetrics 在服务器的注册表中注册按需计算的房间和连接指标。
+func (s *Server) registerMetrics() {
+	s.metrics.GaugeFunc("tragedylooper_rooms", "Rooms by state.", []string{"state"}, func(emit func(float64, ...string)) {
+		counts := make(map[model.RoomState]int)
+		for _, room := range s.roomList() {
+			counts[room.state()]++
+		}
+		for _, state := range []model.RoomState{
+			model.RoomState_ROOM_STATE_LOBBY,
+			model.RoomState_ROOM_STATE_RUNNING,
+			model.RoomState_ROOM_STATE_FINISHED,
+			model.RoomState_ROOM_STATE_ABANDONED,
+		} {
+			emit(float64(counts[state]), state.String())
+		}
+	})
+	s.metrics.GaugeFunc("tragedylooper_connected_clients", "Connected clients by kind.", []string{"kind"}, func(emit func(float64, ...string)) {
+		var players, spectators int
+		for _, room := range s.roomList() {
+			for _, st := range room.seatList() {
+				if st.connected() {
+					players++
+				}
+			}
+			spectators += len(room.spectatorList())
+		}
+		emit(float64(players), "player")
+		emit(float64(spectators), "spectator")
+	})
+}
+
+// roomList 返回所有房间的快照。
+func (s *Server) roomList() []*Room {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rooms := make([]*Room, 0, len(s.rooms))
+	for _, room := range s.rooms {
+		rooms = append(rooms, room)
+	}
+	return rooms
+}
+
+// HandleMetrics 以 Prometheus 文本格式输出服务器、游戏引擎和 LLM 客户端的指标。
+func (s *Server) HandleMetrics(w http.ResponseWriter, r *http.Request) {
+	metrics.Handler(s.metrics, metrics.Default).ServeHTTP(w, r)
+}
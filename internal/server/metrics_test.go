@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleMetrics(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(room))
+	room.AddClient(newClient(nil, nil), room.seatByToken(host.PlayerId, token), 0)
+
+	rejected := actionsTotal.WithLabelValues("rejected", string(codeFailedPrecondition)).Value()
+	_ = room.submitAction(room.seatByToken(host.PlayerId, token), &model.PlayerActionPayload{
+		Payload: &model.PlayerActionPayload_PassTurn{PassTurn: &model.PassTurnAction{}},
+	})
+	assert.Equal(t, rejected+1, actionsTotal.WithLabelValues("rejected", string(codeFailedPrecondition)).Value())
+
+	rec := httptest.NewRecorder()
+	srv.HandleMetrics(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	assert.Contains(t, body, `tragedylooper_rooms{state="ROOM_STATE_LOBBY"} 1`)
+	assert.Contains(t, body, `tragedylooper_connected_clients{kind="player"} 1`)
+	assert.Contains(t, body, `tragedylooper_actions_total{result="rejected",reason="failed_precondition"}`)
+	assert.Contains(t, body, "# TYPE tragedylooper_phase_duration_seconds histogram", "engine metrics are served from the default registry")
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/ticker"
+	"github.com/constellation39/tragedyLooper/internal/llm"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// GameOptions 是服务器创建或恢复的每局游戏的设置。
+type GameOptions struct {
+	// PhaseTimeouts 是没有自定义超时的阶段的默认超时，未列出的阶段不超时。
+	PhaseTimeouts map[model.GamePhase]time.Duration
+	// DisconnectPolicy 决定人类玩家断线时如何推进游戏。
+	DisconnectPolicy engine.DisconnectPolicy
+	// DisconnectGrace 是 DisconnectPolicyTimeout 的宽限期，0 表示使用引擎的默认值。
+	DisconnectGrace time.Duration
+	// AIActionTimeout 是 AI 玩家做出一次决定的期限，0 表示使用引擎的默认值。
+	AIActionTimeout time.Duration
+}
+
+// SetGameOptions 设置新游戏的选项，已经开始的游戏不受影响。必须在开始处理请求之前调用。
+func (s *Server) SetGameOptions(options GameOptions) {
+	s.gameOptions = options
+}
+
+// SetRoleLLMClient 为某一角色的 AI 玩家指定 LLM 客户端，未指定的角色使用 NewServer 传入的客户端。
+// 必须在开始处理请求之前调用。
+func (s *Server) SetRoleLLMClient(role model.PlayerRole, client llm.Client) {
+	if s.roleLLMClients == nil {
+		s.roleLLMClients = make(map[model.PlayerRole]llm.Client)
+	}
+	s.roleLLMClients[role] = client
+}
+
+// newActionGenerator 创建游戏引擎为 AI 玩家生成操作使用的生成器。
+func (s *Server) newActionGenerator(logger *zap.Logger) *llm.LLMActionGenerator {
+	generator := llm.NewLLMActionGenerator(s.llmClient, logger)
+	generator.RoleClients = s.roleLLMClients
+	return generator
+}
+
+// configureEngine 将游戏选项应用到尚未启动的游戏引擎。
+func (s *Server) configureEngine(ge *engine.GameEngine) {
+	ge.SetPhaseTimeouts(s.gameOptions.PhaseTimeouts)
+	ge.SetDisconnectPolicy(s.gameOptions.DisconnectPolicy, ticker.FromDuration(s.gameOptions.DisconnectGrace))
+	if s.gameOptions.AIActionTimeout > 0 {
+		ge.SetAIActionTimeout(s.gameOptions.AIActionTimeout)
+	}
+}
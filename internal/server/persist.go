@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetStore 设置持久化房间和游戏的存储。未设置时房间只保存在内存中。
+// 如果没有通过 SetResultRecorder 注册其他函数，已结束房间的结果会在移除前保存到该存储。
+// 必须在 LoadRooms 和开始处理请求之前调用。
+func (s *Server) SetStore(store Store) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.store = store
+	if s.resultRecorder == nil {
+		s.resultRecorder = s.recordResult
+	}
+}
+
+// recordResult 是设置存储后默认的 ResultRecorder。
+func (s *Server) recordResult(room *Room) error {
+	return room.persistResult()
+}
+
+// LoadRooms 从存储中恢复未结束的房间（大厅中或游戏进行中的），并返回恢复的房间数量。
+// 进行中的游戏从最近的快照继续；所有玩家在重新连接之前视为断线。
+// 无法恢复的房间在存储中被标记为已放弃。必须在开始处理请求之前调用。
+func (s *Server) LoadRooms(ctx context.Context) (int, error) {
+	if s.store == nil {
+		return 0, nil
+	}
+	records, err := s.store.ListRooms(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list stored rooms: %w", err)
+	}
+
+	restored := 0
+	for _, record := range records {
+		switch record.GetLobby().GetState() {
+		case model.RoomState_ROOM_STATE_LOBBY, model.RoomState_ROOM_STATE_RUNNING:
+		default:
+			continue
+		}
+		room, err := s.restoreRoom(ctx, record)
+		if err != nil {
+			s.logger.Error("Failed to restore room, marking it abandoned", zap.String("gameID", record.GetGameId()), zap.Error(err))
+			record.Lobby.State = model.RoomState_ROOM_STATE_ABANDONED
+			if err := s.store.SaveRoom(ctx, record); err != nil {
+				s.logger.Error("Failed to save abandoned room", zap.String("gameID", record.GetGameId()), zap.Error(err))
+			}
+			continue
+		}
+
+		s.mu.Lock()
+		s.rooms[room.GameId] = room
+		s.mu.Unlock()
+		if room.engine() != nil {
+			room.run()
+		}
+		restored++
+		s.logger.Info("Room restored", zap.String("gameID", room.GameId), zap.String("state", record.GetLobby().GetState().String()))
+	}
+	return restored, nil
+}
+
+// restoreRoom 根据房间记录重建房间；进行中的游戏用存储的快照和事件日志恢复游戏引擎，但不启动它。
+func (s *Server) restoreRoom(ctx context.Context, record *model.RoomRecord) (*Room, error) {
+	room := NewRoom(record.GetGameId(), s.logger)
+	room.store = s.store
+	room.connLimits = s.connLimits
+	room.lobby = proto.Clone(record.GetLobby()).(*model.LobbyState)
+	room.lobby.GameId = room.GameId
+	room.nextPlayerID = record.GetNextPlayerId()
+	for _, st := range record.GetSeats() {
+		room.seats[st.GetPlayerId()] = newSeat(st.GetPlayerId(), st.GetRole(), st.GetSessionToken(), st.GetUserId())
+	}
+
+	if scriptID := room.lobby.GetScriptId(); scriptID != "" {
+		gameConfig, err := s.loadGameConfig(scriptID, room.lobby.GetModelId(), room.lobby.GetDifficultySet())
+		if err != nil {
+			return nil, err
+		}
+		room.gameConfig = gameConfig
+	}
+	if room.lobby.GetState() != model.RoomState_ROOM_STATE_RUNNING {
+		return room, nil
+	}
+
+	if room.gameConfig == nil {
+		return nil, fmt.Errorf("running room has no script")
+	}
+	snapshot, err := s.store.LoadSnapshot(ctx, room.GameId)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.store.LoadEvents(ctx, room.GameId)
+	if err != nil {
+		return nil, err
+	}
+	engineLogger := s.logger.With(zap.String("gameID", room.GameId))
+	ge, err := engine.RestoreGameEngine(engineLogger, snapshot, events, s.newActionGenerator(engineLogger), room.gameConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore game engine: %w", err)
+	}
+	s.configureEngine(ge)
+	room.gameEngine = ge
+	return room, nil
+}
+
+// record 返回房间当前的持久化记录。
+func (r *Room) record() *model.RoomRecord {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	record := &model.RoomRecord{
+		GameId:       r.GameId,
+		Lobby:        proto.Clone(r.lobby).(*model.LobbyState),
+		NextPlayerId: r.nextPlayerID,
+		UpdatedAt:    timestamppb.Now(),
+	}
+	for _, st := range r.seats {
+		record.Seats = append(record.Seats, &model.SeatRecord{
+			PlayerId:     st.playerID,
+			Role:         st.role,
+			SessionToken: st.token,
+			UserId:       st.userID,
+		})
+	}
+	sort.Slice(record.Seats, func(i, j int) bool { return record.Seats[i].PlayerId < record.Seats[j].PlayerId })
+	return record
+}
+
+// saveRecord 将房间记录保存到存储；没有存储时不做任何事。
+func (r *Room) saveRecord() {
+	if r.store == nil {
+		return
+	}
+	if err := r.store.SaveRoom(context.Background(), r.record()); err != nil {
+		r.logger.Error("Failed to save room", zap.Error(err))
+	}
+}
+
+// saveSnapshot 将游戏状态快照保存到存储；没有存储或游戏尚未开始时不做任何事。
+func (r *Room) saveSnapshot() {
+	ge := r.engine()
+	if r.store == nil || ge == nil {
+		return
+	}
+	snapshot := ge.Snapshot()
+	if snapshot == nil {
+		return
+	}
+	if err := r.store.SaveSnapshot(context.Background(), r.GameId, snapshot); err != nil {
+		r.logger.Error("Failed to save game snapshot", zap.Error(err))
+	}
+}
+
+// saveEvent 将未经过滤的事件追加到存储的事件日志；没有存储时不做任何事。
+func (r *Room) saveEvent(event *model.GameEvent) {
+	if r.store == nil {
+		return
+	}
+	if err := r.store.AppendEvents(context.Background(), r.GameId, event); err != nil {
+		r.logger.Error("Failed to save game event", zap.Error(err))
+	}
+}
+
+// persistResult 将已结束房间的最终快照、结果和房间记录保存到存储；没有存储时不做任何事。
+// 游戏结束时立即保存一次，移除房间之前再由 ResultRecorder 确认一次。
+func (r *Room) persistResult() error {
+	if r.store == nil {
+		return nil
+	}
+	ctx := context.Background()
+	if ge := r.engine(); ge != nil {
+		if snapshot := ge.Snapshot(); snapshot != nil {
+			if err := r.store.SaveSnapshot(ctx, r.GameId, snapshot); err != nil {
+				return err
+			}
+		}
+	}
+	if result := r.gameResult(); result != nil {
+		if err := r.store.SaveResult(ctx, result); err != nil {
+			return err
+		}
+	}
+	return r.store.SaveRoom(ctx, r.record())
+}
+
+// setResult 根据游戏结束事件记录游戏结果。
+func (r *Room) setResult(event *model.GameEvent) {
+	ended := event.GetPayload().GetGameEnded()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result = &model.GameResult{
+		GameId:      r.GameId,
+		ScriptId:    r.lobby.GetScriptId(),
+		ModelId:     r.lobby.GetModelId(),
+		Winner:      ended.GetWinner(),
+		Reason:      ended.GetReason(),
+		LoopsPlayed: event.GetLoop(),
+		EndedAt:     event.GetTimestamp(),
+	}
+}
+
+// gameResult 返回游戏结果，游戏尚未结束时返回 nil。
+func (r *Room) gameResult() *model.GameResult {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.result
+}
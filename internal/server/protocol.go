@@ -0,0 +1,76 @@
+package server
+
+import (
+	"fmt"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/gorilla/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	protocolMarshaler   = protojson.MarshalOptions{UseProtoNames: true}
+	protocolUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// decodeClientMessage 按帧类型解码客户端消息：文本帧为 protojson，二进制帧为 protobuf 二进制。
+func decodeClientMessage(frameType int, data []byte) (*model.ClientMessage, error) {
+	msg := &model.ClientMessage{}
+	switch frameType {
+	case websocket.TextMessage:
+		if err := protocolUnmarshaler.Unmarshal(data, msg); err != nil {
+			return nil, fmt.Errorf("invalid json message: %w", err)
+		}
+	case websocket.BinaryMessage:
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return nil, fmt.Errorf("invalid binary message: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported frame type %d", frameType)
+	}
+	if msg.Message == nil {
+		return nil, fmt.Errorf("empty message")
+	}
+	return msg, nil
+}
+
+// encodeServerMessage 编码服务器消息，并返回应使用的 WebSocket 帧类型。
+func encodeServerMessage(msg *model.ServerMessage, binary bool) ([]byte, int, error) {
+	if binary {
+		data, err := proto.Marshal(msg)
+		return data, websocket.BinaryMessage, err
+	}
+	data, err := protocolMarshaler.Marshal(msg)
+	return data, websocket.TextMessage, err
+}
+
+// newErrorMessage 创建一个错误消息。replyTo 为引发错误的客户端消息序号。
+func newErrorMessage(code model.ErrorCode, replyTo uint64, format string, args ...any) *model.ServerMessage {
+	return &model.ServerMessage{Message: &model.ServerMessage_Error{Error: &model.ErrorMessage{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		ReplyTo: replyTo,
+	}}}
+}
+
+// newAckMessage 创建一个确认客户端消息的 Ack。
+func newAckMessage(seq uint64) *model.ServerMessage {
+	return &model.ServerMessage{Message: &model.ServerMessage_Ack{Ack: &model.Ack{Seq: seq}}}
+}
+
+// newViewUpdateMessage 创建一个完整视图更新消息。旁观者视图没有版本，version 为 0。
+func newViewUpdateMessage(view *model.PlayerView, version uint64) *model.ServerMessage {
+	return &model.ServerMessage{Message: &model.ServerMessage_ViewUpdate{ViewUpdate: &model.ViewUpdate{View: view, Version: version}}}
+}
+
+// newViewDeltaMessage 创建一个视图增量更新消息。
+func newViewDeltaMessage(delta *model.ViewDelta) *model.ServerMessage {
+	return &model.ServerMessage{Message: &model.ServerMessage_ViewDelta{ViewDelta: delta}}
+}
+
+// newEventMessage 创建一个游戏事件消息。
+func newEventMessage(event *model.GameEvent) *model.ServerMessage {
+	return &model.ServerMessage{Message: &model.ServerMessage_Event{Event: event}}
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"testing"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecodeClientMessage(t *testing.T) {
+	want := &model.ClientMessage{
+		Seq:     3,
+		Message: &model.ClientMessage_JoinRoom{JoinRoom: &model.JoinRoomRequest{GameId: "g1", PlayerId: 2}},
+	}
+
+	t.Run("text frame", func(t *testing.T) {
+		msg, err := decodeClientMessage(websocket.TextMessage, []byte(`{"seq":"3","join_room":{"game_id":"g1","player_id":2},"unknown":1}`))
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(want, msg))
+	})
+
+	t.Run("binary frame", func(t *testing.T) {
+		data, err := proto.Marshal(want)
+		assert.NoError(t, err)
+		msg, err := decodeClientMessage(websocket.BinaryMessage, data)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(want, msg))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := decodeClientMessage(websocket.TextMessage, []byte(`not json`))
+		assert.Error(t, err)
+		_, err = decodeClientMessage(websocket.TextMessage, []byte(`{"seq":"1"}`))
+		assert.Error(t, err, "message without payload should be rejected")
+		_, err = decodeClientMessage(websocket.PingMessage, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestEncodeServerMessage(t *testing.T) {
+	msg := newErrorMessage(model.ErrorCode_ERROR_CODE_ROOM_NOT_FOUND, 7, "room %s not found", "g1")
+	msg.Seq = 1
+
+	data, frameType, err := encodeServerMessage(msg, false)
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.TextMessage, frameType)
+	assert.Contains(t, string(data), `"reply_to"`)
+	assert.Contains(t, string(data), "ERROR_CODE_ROOM_NOT_FOUND")
+
+	data, frameType, err = encodeServerMessage(msg, true)
+	assert.NoError(t, err)
+	assert.Equal(t, websocket.BinaryMessage, frameType)
+	decoded := &model.ServerMessage{}
+	assert.NoError(t, proto.Unmarshal(data, decoded))
+	assert.True(t, proto.Equal(msg, decoded))
+}
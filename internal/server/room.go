@@ -0,0 +1,296 @@
+package server
+
+import (
+	"crypto/subtle"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// Room 管理单个游戏实例及其玩家席位。
+// 房间创建后处于大厅状态，玩家在大厅中入座、选择剧本并准备；房主开始游戏后才创建游戏引擎。
+type Room struct {
+	GameId       string
+	gameEngine   *engine.GameEngine     // 开始游戏后设置，通过 engine() 读取
+	lobby        *model.LobbyState      // 大厅状态；游戏开始后保留座位信息
+	gameConfig   loader.ScriptConfig    // 大厅中选定的剧本配置
+	seats        map[int32]*seat        // 玩家 ID 到人类玩家席位的映射
+	spectators   map[*Client]*spectator // 旁观连接，没有席位
+	nextPlayerID int32                  // 下一个分配给新玩家的 ID
+	mu           sync.RWMutex
+	joinMu       sync.Mutex    // 串行化入座流程，使身份检查和加入玩家成为原子操作
+	stopChan     chan struct{} // 用于发出房间停止信号的通道
+	stopOnce     sync.Once
+	wg           sync.WaitGroup    // 跟踪事件广播 goroutine
+	lastActive   atomic.Int64      // 最近一次活动的 Unix 纳秒时间，见 idleFor
+	finishedAt   time.Time         // 进入已结束或已放弃状态的时间
+	result       *model.GameResult // 游戏结束后设置
+	store        Store             // 持久化房间的存储，为 nil 时不持久化
+	connLimits   ConnectionLimits  // 席位提交操作的频率限制，零值表示不限制
+	logger       *zap.Logger
+}
+
+// NewRoom 创建一个处于大厅状态的游戏房间。
+func NewRoom(gameID string, logger *zap.Logger) *Room {
+	r := &Room{
+		GameId: gameID,
+		lobby: &model.LobbyState{
+			GameId:          gameID,
+			State:           model.RoomState_ROOM_STATE_LOBBY,
+			MaxProtagonists: engine.MaxProtagonists,
+		},
+		seats:        make(map[int32]*seat),
+		spectators:   make(map[*Client]*spectator),
+		nextPlayerID: 1,
+		stopChan:     make(chan struct{}),
+		logger:       logger.With(zap.String("gameID", gameID)), // 将 gameID 添加到所有房间日志中
+	}
+	r.touch(time.Now())
+	return r
+}
+
+// engine 返回房间的游戏引擎，游戏尚未开始时返回 nil。
+func (r *Room) engine() *engine.GameEngine {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.gameEngine
+}
+
+// addSeat 为人类玩家添加席位。
+func (r *Room) addSeat(st *seat) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.seats[st.playerID] = st
+}
+
+// seatByToken 返回与令牌完全一致的席位，不存在时返回 nil。
+func (r *Room) seatByToken(playerID int32, token string) *seat {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	st, ok := r.seats[playerID]
+	if !ok || subtle.ConstantTimeCompare([]byte(st.token), []byte(token)) != 1 {
+		return nil
+	}
+	return st
+}
+
+// seatForUser 返回用户已占用的席位，匿名用户或未入座时返回 nil。
+func (r *Room) seatForUser(userID string) *seat {
+	if userID == "" {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, st := range r.seats {
+		if st.userID == userID {
+			return st
+		}
+	}
+	return nil
+}
+
+// AddClient 将客户端绑定到席位。如果该席位已有连接，旧连接会被关闭并替换。
+// lastSeq 是客户端已确认的最后一条消息序号，重连时会补发其后的事件。
+func (r *Room) AddClient(client *Client, st *seat, lastSeq uint64) {
+	client.mu.Lock()
+	client.seat = st
+	client.mu.Unlock()
+	client.room = r
+
+	joined := &model.JoinedRoom{GameId: r.GameId, PlayerId: st.playerID, Role: st.role}
+	if old := st.attach(client, joined, lastSeq); old != nil {
+		old.close()
+		r.logger.Info("Replacing existing client connection", zap.Int32("clientID", st.playerID))
+	}
+	r.logger.Info("Client added to room", zap.Int32("clientID", st.playerID), zap.String("roomID", r.GameId), zap.Uint64("lastSeq", lastSeq))
+
+	r.broadcastLobby()
+	if ge := r.engine(); ge != nil {
+		ge.SetPlayerConnected(st.playerID, true)
+		r.sendFullView(st)
+	}
+}
+
+// RemoveClient 关闭客户端，并在它仍是席位的当前连接时将席位标记为断线。
+// 席位本身会保留，玩家可以用会话令牌重新连接。
+func (r *Room) RemoveClient(client *Client) {
+	client.close()
+	if r.removeSpectator(client) {
+		return
+	}
+	st := client.currentSeat()
+	if st == nil || !st.detach(client) {
+		return
+	}
+	r.logger.Info("Client removed from room", zap.Int32("clientID", st.playerID), zap.String("roomID", r.GameId))
+
+	r.broadcastLobby()
+	if ge := r.engine(); ge != nil {
+		ge.SetPlayerConnected(st.playerID, false)
+	}
+}
+
+// Stop 停止房间的游戏引擎和广播，并关闭所有连接。可以重复调用；使用 wait 等待其退出。
+func (r *Room) Stop() {
+	r.stopOnce.Do(func() {
+		if ge := r.engine(); ge != nil {
+			ge.Stop()
+		}
+		close(r.stopChan)
+
+		r.mu.RLock()
+		clients := make([]*Client, 0, len(r.seats)+len(r.spectators))
+		for _, st := range r.seats {
+			if c := st.currentClient(); c != nil {
+				clients = append(clients, c)
+			}
+		}
+		for c, sp := range r.spectators {
+			sp.stop()
+			clients = append(clients, c)
+		}
+		r.mu.RUnlock()
+		for _, c := range clients {
+			c.close()
+		}
+		r.logger.Info("Room signaled to stop.", zap.String("roomID", r.GameId))
+	})
+}
+
+// submitAction 以席位的身份将玩家操作提交给游戏引擎。
+func (r *Room) submitAction(st *seat, action *model.PlayerActionPayload) (err error) {
+	defer func() { observeAction(err) }()
+	if st.spectator {
+		return rpcErrorf(codePermissionDenied, "spectators cannot submit actions")
+	}
+	if action == nil || action.Payload == nil {
+		return rpcErrorf(codeInvalidArgument, "empty action")
+	}
+	if err := r.allowAction(st); err != nil {
+		return err
+	}
+	ge := r.engine()
+	if ge == nil {
+		return newRPCError(codeFailedPrecondition, errGameNotStarted)
+	}
+	if err := ge.CheckAction(st.playerID, action); err != nil {
+		return newRPCError(codeFailedPrecondition, err)
+	}
+	r.touch(time.Now())
+	action.PlayerId = st.playerID
+	ge.SubmitPlayerAction(st.playerID, action)
+	return nil
+}
+
+// seatList 返回房间中所有席位的快照。
+func (r *Room) seatList() []*seat {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	seats := make([]*seat, 0, len(r.seats))
+	for _, st := range r.seats {
+		seats = append(seats, st)
+	}
+	return seats
+}
+
+// broadcastGameEvents 监听游戏事件和视图批次，将事件按每个席位的身份过滤后广播，并推送视图更新。
+// 断线席位的事件也会被保留，以便重连时补发。
+func (r *Room) broadcastGameEvents() {
+	ge := r.engine()
+	eventChan := ge.GetGameEvents()
+	viewChan := ge.ViewUpdates()
+	r.saveSnapshot()
+	for {
+		select {
+		case <-r.stopChan:
+			r.logger.Info("Event broadcaster stopped.", zap.String("roomID", r.GameId))
+			return
+		case event, ok := <-eventChan:
+			if !ok {
+				r.logger.Info("Event channel closed, broadcaster stopped.", zap.String("roomID", r.GameId))
+				select {
+				case <-r.stopChan:
+				default:
+					// 引擎在房间停止之前退出，游戏无法继续。
+					r.finish(model.RoomState_ROOM_STATE_ABANDONED, time.Now())
+				}
+				return
+			}
+			r.broadcastEvent(ge, event)
+			// 一批事件处理完后保存快照，重启后游戏从这里继续。
+			if len(eventChan) == 0 {
+				r.saveSnapshot()
+			}
+		case batch, ok := <-viewChan:
+			if !ok {
+				viewChan = nil
+				continue
+			}
+			// 视图批次在同一 tick 的事件之后发出，先广播已到达的事件，使客户端在视图更新之前收到它们。
+			for len(eventChan) > 0 {
+				event, ok := <-eventChan
+				if !ok {
+					break
+				}
+				r.broadcastEvent(ge, event)
+			}
+			r.broadcastViewBatch(batch)
+		}
+	}
+}
+
+// broadcastEvent 记录一个游戏事件，并按每个席位和旁观者的身份过滤后广播。
+func (r *Room) broadcastEvent(ge *engine.GameEngine, event *model.GameEvent) {
+	r.touch(time.Now())
+	r.saveEvent(event)
+	r.logger.Debug("Broadcasting event", zap.String("roomID", r.GameId), zap.String("eventType", event.Type.String()))
+	for _, st := range r.seatList() {
+		if redacted := ge.RedactEvent(st.viewer(), event); redacted != nil {
+			r.send(st, newEventMessage(redacted))
+		}
+	}
+	r.broadcastSpectatorEvent(event)
+	if event.Type == model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED {
+		r.setResult(event)
+		r.finish(model.RoomState_ROOM_STATE_FINISHED, time.Now())
+		if err := r.persistResult(); err != nil {
+			r.logger.Error("Failed to save game result", zap.Error(err))
+		}
+	}
+}
+
+// broadcastViewBatch 向视图发生变化的席位和旁观者推送视图更新。
+func (r *Room) broadcastViewBatch(batch *engine.ViewBatch) {
+	for _, st := range r.seatList() {
+		if pv, ok := batch.Views[st.playerID]; ok && !st.sendView(pv) {
+			r.logger.Warn("Client send queue full or closed, disconnecting client.", zap.String("roomID", r.GameId), zap.Int32("playerID", st.playerID))
+		}
+	}
+	r.broadcastSpectatorViews(batch.Spectators)
+}
+
+// sendFullView 向席位推送引擎最新发布的完整视图，用于新连接和客户端的 RequestView 请求。
+// 引擎尚未发布视图时不做任何事，席位会在下一个视图批次中收到完整视图。
+func (r *Room) sendFullView(st *seat) {
+	st.resetView()
+	ge := r.engine()
+	if ge == nil {
+		return
+	}
+	if pv := ge.GetPublishedView(st.playerID); pv != nil {
+		st.sendView(pv)
+	}
+}
+
+// send 向席位发送消息。发送队列已满的连接会被断开，并记录日志；玩家重连后可以补发错过的事件。
+func (r *Room) send(st *seat, msg *model.ServerMessage) {
+	if !st.broadcast(msg) && st.connected() {
+		r.logger.Warn("Client send queue full or closed, disconnecting client.", zap.String("roomID", r.GameId), zap.Int32("playerID", st.playerID))
+	}
+}
@@ -0,0 +1,416 @@
+package server
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// 此文件实现 Connect 协议 (https://connectrpc.com/docs/protocol) 的服务端部分，
+// 用于提供 service.proto 中定义的 GameService。只支持一元调用和服务端流，编码为 proto 或 json，不支持压缩。
+// 使用 buf 为其他语言生成的 Connect 客户端可以直接调用；gRPC 客户端需要经过支持 Connect 的代理。
+
+const (
+	// maxRPCMessageSize 是单条 RPC 请求消息的大小上限。
+	maxRPCMessageSize = 1 << 20
+
+	connectFlagCompressed = 0x01 // 信封中的消息已压缩
+	connectFlagEndStream  = 0x02 // 流的最后一个信封，内容是 JSON 格式的结束消息
+)
+
+// rpcCode 是 Connect 协议的错误码，HTTP 处理函数也用它选择响应状态。
+type rpcCode string
+
+const (
+	codeCanceled           rpcCode = "canceled"
+	codeInvalidArgument    rpcCode = "invalid_argument"
+	codeDeadlineExceeded   rpcCode = "deadline_exceeded"
+	codeNotFound           rpcCode = "not_found"
+	codeAlreadyExists      rpcCode = "already_exists"
+	codePermissionDenied   rpcCode = "permission_denied"
+	codeResourceExhausted  rpcCode = "resource_exhausted"
+	codeFailedPrecondition rpcCode = "failed_precondition"
+	codeAborted            rpcCode = "aborted"
+	codeUnimplemented      rpcCode = "unimplemented"
+	codeInternal           rpcCode = "internal"
+	codeUnavailable        rpcCode = "unavailable"
+	codeUnauthenticated    rpcCode = "unauthenticated"
+)
+
+// httpStatus 返回错误码对应的 HTTP 状态码，与 Connect 协议的映射一致。
+func (c rpcCode) httpStatus() int {
+	switch c {
+	case codeCanceled:
+		return 499
+	case codeInvalidArgument, codeFailedPrecondition:
+		return http.StatusBadRequest
+	case codeDeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codeNotFound:
+		return http.StatusNotFound
+	case codeAlreadyExists, codeAborted:
+		return http.StatusConflict
+	case codePermissionDenied:
+		return http.StatusForbidden
+	case codeResourceExhausted:
+		return http.StatusTooManyRequests
+	case codeUnimplemented:
+		return http.StatusNotImplemented
+	case codeUnavailable:
+		return http.StatusServiceUnavailable
+	case codeUnauthenticated:
+		return http.StatusUnauthorized
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// rpcError 是带有错误码的请求错误。
+type rpcError struct {
+	code rpcCode
+	err  error
+}
+
+func (e *rpcError) Error() string { return e.err.Error() }
+func (e *rpcError) Unwrap() error { return e.err }
+
+// newRPCError 为错误附加错误码。
+func newRPCError(code rpcCode, err error) error {
+	return &rpcError{code: code, err: err}
+}
+
+// rpcErrorf 创建带有错误码的格式化错误。
+func rpcErrorf(code rpcCode, format string, args ...any) error {
+	return &rpcError{code: code, err: fmt.Errorf(format, args...)}
+}
+
+// errorCode 返回错误的错误码。没有附加错误码的错误视为内部错误。
+func errorCode(err error) rpcCode {
+	var rpcErr *rpcError
+	switch {
+	case errors.As(err, &rpcErr):
+		return rpcErr.code
+	case errors.Is(err, context.Canceled):
+		return codeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codeDeadlineExceeded
+	default:
+		return codeInternal
+	}
+}
+
+// httpError 将请求错误写入 HTTP 响应。内部错误只记录日志，不向调用者暴露细节。
+func httpError(w http.ResponseWriter, ctxLogger *zap.Logger, err error) {
+	code := errorCode(err)
+	if code == codeInternal {
+		ctxLogger.Error("Request failed", zap.Error(err))
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	http.Error(w, err.Error(), code.httpStatus())
+}
+
+// connectError 是 Connect 协议中错误的 JSON 表示。
+type connectError struct {
+	Code    rpcCode `json:"code"`
+	Message string  `json:"message,omitempty"`
+}
+
+// newConnectError 将请求错误转换为 Connect 错误。内部错误只记录日志，不向调用者暴露细节。
+func newConnectError(ctxLogger *zap.Logger, err error) *connectError {
+	code := errorCode(err)
+	if code == codeInternal {
+		ctxLogger.Error("RPC failed", zap.Error(err))
+		return &connectError{Code: code, Message: "internal server error"}
+	}
+	return &connectError{Code: code, Message: err.Error()}
+}
+
+// rpcCodec 是 RPC 消息的编码。
+type rpcCodec interface {
+	Marshal(msg proto.Message) ([]byte, error)
+	Unmarshal(data []byte, msg proto.Message) error
+}
+
+type protoCodec struct{}
+
+func (protoCodec) Marshal(msg proto.Message) ([]byte, error)      { return proto.Marshal(msg) }
+func (protoCodec) Unmarshal(data []byte, msg proto.Message) error { return proto.Unmarshal(data, msg) }
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(msg proto.Message) ([]byte, error) { return protocolMarshaler.Marshal(msg) }
+func (jsonCodec) Unmarshal(data []byte, msg proto.Message) error {
+	return protocolUnmarshaler.Unmarshal(data, msg)
+}
+
+var (
+	// unaryCodecs 是一元调用按 Content-Type 选择的编码。
+	unaryCodecs = map[string]rpcCodec{
+		"application/proto": protoCodec{},
+		"application/json":  jsonCodec{},
+	}
+	// streamCodecs 是流式调用按 Content-Type 选择的编码。
+	streamCodecs = map[string]rpcCodec{
+		"application/connect+proto": protoCodec{},
+		"application/connect+json":  jsonCodec{},
+	}
+)
+
+// unaryFunc 和 streamFunc 是 RPC 方法的实现。identity 是认证器识别出的调用者身份。
+type (
+	unaryFunc  func(ctx context.Context, identity *Identity, req proto.Message) (proto.Message, error)
+	streamFunc func(ctx context.Context, identity *Identity, req proto.Message, send func(proto.Message) error) error
+)
+
+// unary 将类型化的一元方法转换为 unaryFunc。
+func unary[Req, Res proto.Message](fn func(context.Context, *Identity, Req) (Res, error)) unaryFunc {
+	return func(ctx context.Context, identity *Identity, req proto.Message) (proto.Message, error) {
+		return fn(ctx, identity, req.(Req))
+	}
+}
+
+// serverStream 将类型化的服务端流方法转换为 streamFunc。
+func serverStream[Req, Res proto.Message](fn func(context.Context, *Identity, Req, func(Res) error) error) streamFunc {
+	return func(ctx context.Context, identity *Identity, req proto.Message, send func(proto.Message) error) error {
+		return fn(ctx, identity, req.(Req), func(res Res) error { return send(res) })
+	}
+}
+
+// rpcProcedure 是一个已注册的 RPC 方法。
+type rpcProcedure struct {
+	server  *Server
+	method  protoreflect.MethodDescriptor
+	request protoreflect.MessageType
+	unary   unaryFunc
+	stream  streamFunc
+}
+
+// GameServiceHandler 返回 GameService 的路径前缀和处理函数，用于注册到 http.ServeMux：
+//
+//	mux.Handle(gameServer.GameServiceHandler())
+func (s *Server) GameServiceHandler() (string, http.Handler) {
+	unaryMethods := map[protoreflect.Name]unaryFunc{
+		"CreateRoom":    unary(s.rpcCreateRoom),
+		"JoinRoom":      unary(s.rpcJoinRoom),
+		"ListRooms":     unary(s.rpcListRooms),
+		"SubmitAction":  unary(s.rpcSubmitAction),
+		"GetPlayerView": unary(s.rpcGetPlayerView),
+		"SendChat":      unary(s.rpcSendChat),
+	}
+	streamMethods := map[protoreflect.Name]streamFunc{
+		"StreamEvents": serverStream(s.rpcStreamEvents),
+	}
+
+	// 按 service.proto 中的定义注册方法，使服务定义和实现保持一致。
+	service := model.File_tragedylooper_v1_service_proto.Services().ByName("GameService")
+	prefix := "/" + string(service.FullName()) + "/"
+	mux := http.NewServeMux()
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		method := methods.Get(i)
+		request, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+		if err != nil {
+			panic(fmt.Sprintf("rpc: request type of %s: %v", method.FullName(), err))
+		}
+		p := &rpcProcedure{server: s, method: method, request: request}
+		switch {
+		case method.IsStreamingClient():
+			panic(fmt.Sprintf("rpc: client streaming method %s is not supported", method.FullName()))
+		case method.IsStreamingServer():
+			p.stream = streamMethods[method.Name()]
+		default:
+			p.unary = unaryMethods[method.Name()]
+		}
+		if p.unary == nil && p.stream == nil {
+			panic(fmt.Sprintf("rpc: method %s is not implemented", method.FullName()))
+		}
+		mux.Handle(prefix+string(method.Name()), p)
+	}
+	return prefix, mux
+}
+
+// ServeHTTP 处理一次 RPC 调用。
+func (p *rpcProcedure) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	codecs := unaryCodecs
+	if p.stream != nil {
+		codecs = streamCodecs
+	}
+	codec, ok := codecs[mediaType]
+	if !ok {
+		http.Error(w, "Unsupported content type", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	ctx := r.Context()
+	if timeout := r.Header.Get("Connect-Timeout-Ms"); timeout != "" {
+		ms, err := strconv.ParseInt(timeout, 10, 64)
+		if err == nil && ms > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(ms)*time.Millisecond)
+			defer cancel()
+		}
+	}
+	w.Header().Set("Content-Type", mediaType)
+	if p.stream != nil {
+		p.serveStream(ctx, w, r, codec)
+	} else {
+		p.serveUnary(ctx, w, r, codec)
+	}
+}
+
+// authenticate 检查请求的压缩方式并识别调用者。
+func (p *rpcProcedure) authenticate(r *http.Request) (*Identity, error) {
+	if encoding := r.Header.Get("Content-Encoding"); encoding != "" && encoding != "identity" {
+		return nil, rpcErrorf(codeUnimplemented, "unsupported compression %q", encoding)
+	}
+	identity, err := p.server.authenticator.Authenticate(r)
+	if err != nil || identity == nil {
+		logger.LoggerFromContext(r.Context()).Warn("Authentication failed", zap.Error(err))
+		return nil, newRPCError(codeUnauthenticated, ErrUnauthenticated)
+	}
+	return identity, nil
+}
+
+// decode 解码请求消息。
+func (p *rpcProcedure) decode(codec rpcCodec, data []byte) (proto.Message, error) {
+	req := p.request.New().Interface()
+	if err := codec.Unmarshal(data, req); err != nil {
+		return nil, rpcErrorf(codeInvalidArgument, "invalid %s: %v", p.method.Input().Name(), err)
+	}
+	return req, nil
+}
+
+// serveUnary 处理一元调用：请求体和响应体各是一条消息，错误以 JSON 返回。
+func (p *rpcProcedure) serveUnary(ctx context.Context, w http.ResponseWriter, r *http.Request, codec rpcCodec) {
+	ctxLogger := logger.LoggerFromContext(ctx)
+	res, err := func() (proto.Message, error) {
+		identity, err := p.authenticate(r)
+		if err != nil {
+			return nil, err
+		}
+		data, err := io.ReadAll(io.LimitReader(r.Body, maxRPCMessageSize+1))
+		if err != nil {
+			return nil, rpcErrorf(codeInvalidArgument, "read request: %v", err)
+		}
+		if len(data) > maxRPCMessageSize {
+			return nil, rpcErrorf(codeResourceExhausted, "request exceeds %d bytes", maxRPCMessageSize)
+		}
+		req, err := p.decode(codec, data)
+		if err != nil {
+			return nil, err
+		}
+		return p.unary(ctx, identity, req)
+	}()
+	if err == nil {
+		var data []byte
+		if data, err = codec.Marshal(res); err == nil {
+			if _, err := w.Write(data); err != nil {
+				ctxLogger.Error("Error writing response", zap.Error(err))
+			}
+			return
+		}
+	}
+
+	connectErr := newConnectError(ctxLogger, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(connectErr.Code.httpStatus())
+	if err := json.NewEncoder(w).Encode(connectErr); err != nil {
+		ctxLogger.Error("Error writing response", zap.Error(err))
+	}
+}
+
+// serveStream 处理服务端流：请求和每条响应消息都带有 5 字节的信封头，
+// 最后一个信封是结束消息，其中包含错误（如果有）。HTTP 状态码总是 200。
+func (p *rpcProcedure) serveStream(ctx context.Context, w http.ResponseWriter, r *http.Request, codec rpcCodec) {
+	ctxLogger := logger.LoggerFromContext(ctx)
+	rc := http.NewResponseController(w)
+	err := func() error {
+		identity, err := p.authenticate(r)
+		if err != nil {
+			return err
+		}
+		data, err := readEnvelope(r.Body)
+		if err != nil {
+			return err
+		}
+		req, err := p.decode(codec, data)
+		if err != nil {
+			return err
+		}
+		return p.stream(ctx, identity, req, func(msg proto.Message) error {
+			data, err := codec.Marshal(msg)
+			if err != nil {
+				return err
+			}
+			if err := writeEnvelope(w, 0, data); err != nil {
+				return err
+			}
+			return rc.Flush()
+		})
+	}()
+
+	var end struct {
+		Error *connectError `json:"error,omitempty"`
+	}
+	if err != nil {
+		end.Error = newConnectError(ctxLogger, err)
+	}
+	data, _ := json.Marshal(end)
+	if err := writeEnvelope(w, connectFlagEndStream, data); err != nil {
+		ctxLogger.Debug("Error writing end of stream", zap.Error(err))
+	}
+}
+
+// readEnvelope 读取一个带信封的消息。
+func readEnvelope(r io.Reader) ([]byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, rpcErrorf(codeInvalidArgument, "read envelope: %v", err)
+	}
+	if header[0]&connectFlagCompressed != 0 {
+		return nil, rpcErrorf(codeUnimplemented, "compressed messages are not supported")
+	}
+	size := binary.BigEndian.Uint32(header[1:])
+	if size > maxRPCMessageSize {
+		return nil, rpcErrorf(codeResourceExhausted, "request exceeds %d bytes", maxRPCMessageSize)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, rpcErrorf(codeInvalidArgument, "read envelope: %v", err)
+	}
+	return data, nil
+}
+
+// writeEnvelope 写入一个带信封的消息。
+func writeEnvelope(w io.Writer, flags byte, data []byte) error {
+	var header [5]byte
+	header[0] = flags
+	binary.BigEndian.PutUint32(header[1:], uint32(len(data)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// callUnary 以 Connect 协议的 JSON 编码发起一元调用，成功时将响应解码到 res。
+func callUnary(t *testing.T, url, method string, req, res proto.Message) (int, *connectError) {
+	t.Helper()
+	body, err := protocolMarshaler.Marshal(req)
+	assert.NoError(t, err)
+	resp, err := http.Post(url+"/tragedylooper.v1.GameService/"+method, "application/json", bytes.NewReader(body))
+	if !assert.NoError(t, err) {
+		return 0, nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		connectErr := &connectError{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(connectErr))
+		return resp.StatusCode, connectErr
+	}
+	var buf bytes.Buffer
+	_, _ = buf.ReadFrom(resp.Body)
+	assert.NoError(t, protocolUnmarshaler.Unmarshal(buf.Bytes(), res))
+	return resp.StatusCode, nil
+}
+
+func TestGameServiceUnary(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	mux := http.NewServeMux()
+	mux.Handle(srv.GameServiceHandler())
+	ts := httptest.NewServer(srv.LoggingMiddleware(mux))
+	defer ts.Close()
+
+	host := &model.SeatGrant{}
+	status, connectErr := callUnary(t, ts.URL, "CreateRoom", &model.CreateRoomRequest{PlayerName: "alice", PlayerRole: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}, host)
+	assert.Equal(t, http.StatusOK, status, "%v", connectErr)
+	assert.Equal(t, int32(1), host.PlayerId)
+	assert.NotEmpty(t, host.SessionToken)
+
+	// 二进制编码。
+	body, _ := proto.Marshal(&model.TakeSeatRequest{GameId: host.GameId, PlayerName: "bob", PlayerRole: model.PlayerRole_PLAYER_ROLE_MASTERMIND})
+	resp, err := http.Post(ts.URL+"/tragedylooper.v1.GameService/JoinRoom", "application/proto", bytes.NewReader(body))
+	if assert.NoError(t, err) {
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/proto", resp.Header.Get("Content-Type"))
+		var buf bytes.Buffer
+		_, _ = buf.ReadFrom(resp.Body)
+		resp.Body.Close()
+		grant := &model.SeatGrant{}
+		assert.NoError(t, proto.Unmarshal(buf.Bytes(), grant))
+		assert.Equal(t, int32(2), grant.PlayerId)
+	}
+
+	list := &model.ListRoomsResponse{}
+	callUnary(t, ts.URL, "ListRooms", &model.ListRoomsRequest{}, list)
+	if assert.Len(t, list.Rooms, 1) {
+		assert.Len(t, list.Rooms[0].Seats, 2)
+	}
+	callUnary(t, ts.URL, "ListRooms", &model.ListRoomsRequest{States: []model.RoomState{model.RoomState_ROOM_STATE_RUNNING}}, list)
+	assert.Empty(t, list.Rooms)
+
+	// 错误按 Connect 协议返回错误码和对应的 HTTP 状态。
+	status, connectErr = callUnary(t, ts.URL, "JoinRoom", &model.TakeSeatRequest{GameId: "missing", PlayerRole: model.PlayerRole_PLAYER_ROLE_PROTAGONIST}, nil)
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.Equal(t, codeNotFound, connectErr.Code)
+	status, connectErr = callUnary(t, ts.URL, "GetPlayerView", &model.GetPlayerViewRequest{SessionToken: host.SessionToken}, nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, codeFailedPrecondition, connectErr.Code)
+	_, connectErr = callUnary(t, ts.URL, "SubmitAction", &model.SeatActionRequest{SessionToken: "bogus"}, nil)
+	assert.Equal(t, codeUnauthenticated, connectErr.Code)
+
+	resp, err = http.Post(ts.URL+"/tragedylooper.v1.GameService/ListRooms", "text/plain", nil)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	}
+}
+
+func TestGameServiceStreamEvents(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	mux := http.NewServeMux()
+	mux.Handle(srv.GameServiceHandler())
+	ts := httptest.NewServer(srv.LoggingMiddleware(mux))
+	defer ts.Close()
+
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(room))
+
+	stream := func(req *model.StreamEventsRequest) *http.Response {
+		data, _ := protocolMarshaler.Marshal(req)
+		var body bytes.Buffer
+		assert.NoError(t, writeEnvelope(&body, 0, data))
+		resp, err := http.Post(ts.URL+"/tragedylooper.v1.GameService/StreamEvents", "application/connect+json", &body)
+		assert.NoError(t, err)
+		return resp
+	}
+
+	// 流作为席位的连接加入房间，首先收到 joined 消息。
+	resp := stream(&model.StreamEventsRequest{SessionToken: token})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	data, err := readEnvelope(resp.Body)
+	assert.NoError(t, err)
+	msg := &model.ServerMessage{}
+	assert.NoError(t, protocolUnmarshaler.Unmarshal(data, msg))
+	assert.Equal(t, uint64(1), msg.Seq)
+	assert.Equal(t, host.PlayerId, msg.GetJoined().GetPlayerId())
+	assert.Eventually(t, func() bool { return room.lobbySnapshot().Seats[0].Connected }, time.Second, 10*time.Millisecond)
+
+	// 房间停止时流以结束消息结束。
+	room.Stop()
+	var end []byte
+	for {
+		var header [5]byte
+		if _, err := io.ReadFull(resp.Body, header[:]); !assert.NoError(t, err) {
+			break
+		}
+		payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		_, _ = io.ReadFull(resp.Body, payload)
+		if header[0]&connectFlagEndStream != 0 {
+			end = payload
+			break
+		}
+	}
+	resp.Body.Close()
+	assert.JSONEq(t, `{}`, string(end))
+
+	// 无效令牌的错误放在结束消息中，HTTP 状态仍为 200。
+	resp = stream(&model.StreamEventsRequest{SessionToken: "bogus"})
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var header [5]byte
+	_, _ = io.ReadFull(resp.Body, header[:])
+	assert.Equal(t, byte(connectFlagEndStream), header[0])
+	payload, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(payload), `"code":"unauthenticated"`)
+}
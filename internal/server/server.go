@@ -1,17 +1,18 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 	"sync"
 	"time"
 
-	"github.com/constellation39/tragedyLooper/internal/game/engine"
-	"github.com/constellation39/tragedyLooper/internal/game/loader"
 	"github.com/constellation39/tragedyLooper/internal/llm"
 	"github.com/constellation39/tragedyLooper/internal/logger"
+	"github.com/constellation39/tragedyLooper/internal/metrics"
 	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
 
 	"github.com/google/uuid"
@@ -24,43 +25,120 @@ type Server struct {
 	upgrader websocket.Upgrader
 	rooms    map[string]*Room // 游戏 ID 到 Room 的映射
 	mu       sync.RWMutex     // rooms 映射的互斥锁
-	// 用于发出服务器关闭信号的通道
+	// 用于发出服务器关闭信号的通道，由 shutdownOnce 保证只关闭一次
 	shutdownChan chan struct{}
+	shutdownOnce sync.Once
 	// 可用游戏剧本的映射
 	gameDataDir string
 	// LLM 客户端用于 AI 玩家
 	llmClient llm.Client
-	logger    *zap.Logger
+	// 按角色覆盖 llmClient，见 SetRoleLLMClient
+	roleLLMClients map[model.PlayerRole]llm.Client
+	logger         *zap.Logger
+	// 调用者身份来源，默认接受所有匿名调用者
+	authenticator Authenticator
+	// 签发和验证席位会话令牌
+	seatTokens *seatTokenSigner
+	// 房间数量上限和生命周期，见 SetRoomLimits
+	limits RoomLimits
+	// 移除已结束的房间之前保存游戏结果，见 SetResultRecorder
+	resultRecorder ResultRecorder
+	// 持久化房间和游戏，见 SetStore
+	store Store
+	// 单个连接和席位的资源限制，见 SetConnectionLimits
+	connLimits ConnectionLimits
+	// 允许建立 WebSocket 连接的来源，见 SetAllowedOrigins
+	allowedOrigins []string
+	// 服务器自身的指标，见 HandleMetrics
+	metrics *metrics.Registry
+	// 管理接口的访问令牌，为空时禁用管理接口，见 SetAdminToken
+	adminToken string
+	// 新游戏的选项，见 SetGameOptions
+	gameOptions GameOptions
 }
 
-// NewServer 创建一个新的游戏服务器实例。
+// NewServer 创建一个新的游戏服务器实例，并开始定期清理过期的房间。
 func NewServer(dataDir string, llmClient llm.Client, logger *zap.Logger) *Server {
-	return &Server{
-		upgrader: websocket.Upgrader{
-			ReadBufferSize:  1024,
-			WriteBufferSize: 1024,
-			CheckOrigin: func(_ *http.Request) bool {
-				return true // 允许所有来源进行开发，在生产环境中限制
-			},
-		},
-		rooms:        make(map[string]*Room),
-		shutdownChan: make(chan struct{}),
-		gameDataDir:  dataDir,
-		llmClient:    llmClient,
-		logger:       logger,
+	s := &Server{
+		rooms:         make(map[string]*Room),
+		shutdownChan:  make(chan struct{}),
+		gameDataDir:   dataDir,
+		llmClient:     llmClient,
+		logger:        logger,
+		authenticator: anonymousAuthenticator{},
+		seatTokens:    newSeatTokenSigner(nil),
+		limits:        DefaultRoomLimits(),
+		connLimits:    DefaultConnectionLimits(),
+		metrics:       metrics.NewRegistry(),
 	}
+	s.registerMetrics()
+	s.upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin:     s.checkOrigin,
+	}
+	go s.runJanitor()
+	return s
+}
+
+// SetAuthenticator 注册用于识别调用者的认证器。传入 nil 恢复为接受所有匿名调用者。
+// 必须在开始处理请求之前调用。
+func (s *Server) SetAuthenticator(a Authenticator) {
+	if a == nil {
+		a = anonymousAuthenticator{}
+	}
+	s.authenticator = a
 }
 
-// Shutdown 优雅地关闭服务器和所有活跃房间。
-func (s *Server) Shutdown() {
-	close(s.shutdownChan)
+// SetSeatTokenSecret 设置签发席位会话令牌使用的 HMAC 密钥。未设置时使用进程启动时生成的随机密钥。
+// 必须在开始处理请求之前调用，之前签发的令牌会失效。
+func (s *Server) SetSeatTokenSecret(secret []byte) {
+	s.seatTokens = newSeatTokenSigner(secret)
+}
+
+// authenticate 识别请求的调用者。认证失败时写入 401 响应并返回 false。
+func (s *Server) authenticate(w http.ResponseWriter, r *http.Request) (*Identity, bool) {
+	identity, err := s.authenticator.Authenticate(r)
+	if err != nil || identity == nil {
+		logger.LoggerFromContext(r.Context()).Warn("Authentication failed", zap.Error(err))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+	return identity, true
+}
+
+// issueSeat 为房间中的人类玩家签发席位会话令牌。
+func (s *Server) issueSeat(room *Room, playerID int32, role model.PlayerRole, identity *Identity) string {
+	token, _ := s.seatTokens.sign(room.GameId, playerID)
+	room.addSeat(newSeat(playerID, role, token, identity.UserID))
+	return token
+}
+
+// Shutdown 优雅地关闭服务器和所有活跃房间，并等待它们的游戏引擎和事件广播退出。
+// 如果 ctx 在所有房间退出之前结束，返回 ctx 的错误。可以重复调用。
+func (s *Server) Shutdown(ctx context.Context) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.shutdownOnce.Do(func() { close(s.shutdownChan) }) // 此后不再接受新房间
+	rooms := make([]*Room, 0, len(s.rooms))
 	for _, room := range s.rooms {
-		room.Stop() // 发送信号给每个房间停止其游戏循环
+		rooms = append(rooms, room)
 	}
-	time.Sleep(2 * time.Second) // 给予房间一些时间关闭
+	s.mu.Unlock()
 
+	for _, room := range rooms {
+		// 保存进行中游戏的最新状态，重启后由 LoadRooms 恢复。
+		if room.state() == model.RoomState_ROOM_STATE_RUNNING {
+			room.saveSnapshot()
+		}
+		room.Stop() // 发送信号给每个房间停止其游戏循环
+	}
+	var errs []error
+	for _, room := range rooms {
+		if err := room.wait(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // LoggingMiddleware 创建一个带有 request_id 的新记录器并将其添加到上下文中。
@@ -81,40 +159,32 @@ func (s *Server) LoggingMiddleware(next http.Handler) http.Handler {
 func (s *Server) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	ctxLogger := logger.LoggerFromContext(r.Context())
 
-	conn, err := s.upgrader.Upgrade(w, r, nil)
-	if err != nil {
-		ctxLogger.Error("WebSocket upgrade failed", zap.Error(err))
-		return
-	}
-	defer conn.Close()
-
-	playerIDStr := r.URL.Query().Get("player_id")
-	if playerIDStr == "" {
-		ctxLogger.Warn("WebSocket connection: Missing player_id query parameter.")
-		if err := conn.WriteMessage(websocket.TextMessage, []byte("Error: player_id required.")); err != nil {
-			ctxLogger.Error("Error writing message", zap.Error(err))
-		}
+	identity, ok := s.authenticate(w, r)
+	if !ok {
 		return
 	}
 
-	playerID, err := strconv.ParseInt(playerIDStr, 10, 32)
+	conn, err := s.upgrader.Upgrade(w, r, nil)
 	if err != nil {
-		ctxLogger.Warn("WebSocket connection: Invalid player_id.")
-		if err := conn.WriteMessage(websocket.TextMessage, []byte("Error: invalid player_id.")); err != nil {
-			ctxLogger.Error("Error writing message", zap.Error(err))
-		}
+		ctxLogger.Error("WebSocket upgrade failed", zap.Error(err))
 		return
 	}
-
-	clientLogger := ctxLogger.With(zap.Int32("playerID", int32(playerID)))
-	clientLogger.Info("Player connected via WebSocket.")
-
-	client := &Client{
-		conn:     conn,
-		send:     make(chan []byte, 256),
-		playerID: int32(playerID),
-		room:     nil, // 加入房间时设置
-		logger:   clientLogger,
+	// 客户端可以在握手时通过 ?token=...&last_seq=... 直接加入（或重新加入）席位，
+	// 也可以在连接建立后发送 JoinRoom 消息。旁观者通过 ?spectate=<game_id>&full_view=1 观看房间。
+	// 默认使用 JSON 文本帧回复，直到客户端发送二进制帧；也可以通过 ?encoding=binary 指定初始编码。
+	query := r.URL.Query()
+	client := newClient(conn, ctxLogger)
+	client.identity = identity
+	client.limits = s.connLimits
+	client.binary.Store(query.Get("encoding") == "binary")
+	ctxLogger.Info("Client connected via WebSocket.")
+
+	if token := query.Get("token"); token != "" {
+		lastSeq, _ := strconv.ParseUint(query.Get("last_seq"), 10, 64)
+		client.handleJoinRoom(s, 0, &model.JoinRoomRequest{SessionToken: token, LastSeq: lastSeq})
+	} else if gameID := query.Get("spectate"); gameID != "" {
+		fullView, _ := strconv.ParseBool(query.Get("full_view"))
+		client.handleSpectate(s, 0, &model.SpectateRequest{GameId: gameID, FullView: fullView})
 	}
 
 	go client.writePump()
@@ -122,6 +192,7 @@ func (s *Server) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 }
 
 // HandleCreateRoom 处理创建新游戏房间的请求。
+// 新房间处于大厅状态，创建者成为房主并占据所选身份的座位；游戏在房主调用 HandleStartGame 后才开始。
 func (s *Server) HandleCreateRoom(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	ctx = logger.WithCallDepth(ctx)
@@ -132,64 +203,70 @@ func (s *Server) HandleCreateRoom(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		ScriptID   string           `json:"script_id"`
-		ModelID    int32            `json:"model_id"`
-		PlayerID   int32            `json:"player_id"`
-		PlayerName string           `json:"player_name"`
-		PlayerRole model.PlayerRole `json:"player_role"`
-		IsLlm      bool             `json:"is_llm"`
+		lobbySettings                  // 可选：也可以稍后通过 HandleConfigureRoom 选择剧本
+		PlayerName    string           `json:"player_name"`
+		PlayerRole    model.PlayerRole `json:"player_role"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-
-	gameID := generateUniqueGameID()
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	if _, exists := s.rooms[gameID]; exists {
-		http.Error(w, "Game ID already exists, try again", http.StatusConflict)
+	identity, ok := s.authenticate(w, r)
+	if !ok {
 		return
 	}
 
-	players := make([]*model.Player, 0)
-	players = append(players, &model.Player{
-		Id:                 req.PlayerID,
-		Name:               req.PlayerName,
-		Role:               req.PlayerRole,
-		IsLlm:              req.IsLlm,
-		Hand:               &model.CardList{Cards: make([]*model.Card, 0)}, // 卡牌将由游戏引擎处理
-		DeductionKnowledge: &model.PlayerDeductionKnowledge{},
-		LlmSessionId:       "",
-	})
-
-	gameConfig, err := loader.LoadConfig(s.gameDataDir, req.ScriptID, req.ModelID)
+	grant, err := s.createRoom(ctx, identity, req.PlayerName, req.PlayerRole, req.lobbySettings)
 	if err != nil {
-		ctxLogger.Error("Failed to load game data", zap.Error(err))
-		http.Error(w, "Error loading game data", http.StatusInternalServerError)
+		httpError(w, ctxLogger, err)
 		return
 	}
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(map[string]any{"game_id": grant.GameId, "player_id": grant.PlayerId, "session_token": grant.SessionToken}); err != nil {
+		ctxLogger.Error("Error encoding response", zap.Error(err))
+	}
+}
 
-	llmActionGenerator := llm.NewLLMActionGenerator(s.llmClient, ctxLogger)
-	gameEngine, err := engine.NewGameEngine(ctxLogger.With(zap.String("gameID", gameID)), players, llmActionGenerator, gameConfig)
-	if err != nil {
-		ctxLogger.Error("Failed to create game engine", zap.Error(err))
-		return
+// createRoom 创建处于大厅状态的房间，调用者成为房主并占据所选身份的座位。
+// settings.ScriptID 为空时不选择剧本，房主稍后在大厅中选择。
+func (s *Server) createRoom(ctx context.Context, identity *Identity, name string, role model.PlayerRole, settings lobbySettings) (*model.SeatGrant, error) {
+	ctxLogger := logger.LoggerFromContext(ctx)
+	if identity.Name != "" {
+		name = identity.Name
 	}
-	room := NewRoom(gameID, gameEngine, ctxLogger)
-	s.rooms[gameID] = room
 
-	room.Start() // 启动此房间的游戏引擎循环
+	gameID := generateUniqueGameID()
+	room := NewRoom(gameID, s.logger)
+	host, err := room.addLobbySeat(name, role, false)
+	if err != nil {
+		return nil, newRPCError(codeInvalidArgument, err)
+	}
+	if settings.ScriptID != "" {
+		gameConfig, err := s.loadGameConfig(settings.ScriptID, settings.ModelID, settings.DifficultySet)
+		if err != nil {
+			ctxLogger.Warn("Invalid room configuration", zap.Error(err))
+			return nil, newRPCError(codeInvalidArgument, err)
+		}
+		if err := room.configure(host.PlayerId, settings, gameConfig); err != nil {
+			return nil, wrapLobbyError(err)
+		}
+	}
+	sessionToken := s.issueSeat(room, host.PlayerId, host.Role, identity)
 
-	ctxLogger.Info("Room created", zap.String("gameID", gameID), zap.Int32("playerID", req.PlayerID), zap.String("scriptID", req.ScriptID))
-	w.WriteHeader(http.StatusCreated)
-	if err := json.NewEncoder(w).Encode(map[string]string{"game_id": gameID}); err != nil {
-		ctxLogger.Error("Error encoding response", zap.Error(err))
+	if err := s.addRoom(room); err != nil {
+		ctxLogger.Warn("Rejected room creation", zap.Error(err))
+		code := codeAborted
+		if errors.Is(err, errTooManyRooms) || errors.Is(err, errShuttingDown) {
+			code = codeUnavailable
+		}
+		return nil, newRPCError(code, err)
 	}
+
+	ctxLogger.Info("Room created", zap.String("gameID", gameID), zap.Int32("playerID", host.PlayerId), zap.String("scriptID", settings.ScriptID))
+	return &model.SeatGrant{GameId: gameID, PlayerId: host.PlayerId, SessionToken: sessionToken}, nil
 }
 
-// HandleJoinRoom 处理加入现有游戏房间的请求。
+// HandleJoinRoom 处理在大厅中入座的请求。玩家 ID 由服务器分配，身份冲突和同一用户重复入座会被拒绝。
 func (s *Server) HandleJoinRoom(w http.ResponseWriter, r *http.Request) {
 	ctxLogger := logger.LoggerFromContext(r.Context())
 	if r.Method != http.MethodPost {
@@ -199,199 +276,96 @@ func (s *Server) HandleJoinRoom(w http.ResponseWriter, r *http.Request) {
 
 	var req struct {
 		GameId     string           `json:"game_id"`
-		PlayerID   int32            `json:"player_id"`
 		PlayerName string           `json:"player_name"`
 		PlayerRole model.PlayerRole `json:"player_role"`
-		IsLlm      bool             `json:"is_llm"`
 	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
-
-	s.mu.RLock()
-	room, ok := s.rooms[req.GameId]
-	s.mu.RUnlock()
-
+	identity, ok := s.authenticate(w, r)
 	if !ok {
-		http.Error(w, "Room not found", http.StatusNotFound)
 		return
 	}
 
-	room.gameEngine.GameState.Players[req.PlayerID] = &model.Player{
-		Id:                 req.PlayerID,
-		Name:               req.PlayerName,
-		Role:               req.PlayerRole,
-		IsLlm:              req.IsLlm,
-		Hand:               &model.CardList{Cards: make([]*model.Card, 0)},
-		DeductionKnowledge: &model.PlayerDeductionKnowledge{},
+	grant, err := s.joinRoom(r.Context(), identity, req.GameId, req.PlayerName, req.PlayerRole)
+	if err != nil {
+		httpError(w, ctxLogger, err)
+		return
 	}
-
-	ctxLogger.Info("Player joined room", zap.Int32("playerID", req.PlayerID), zap.String("gameID", req.GameId), zap.String("role", req.PlayerRole.String()))
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(map[string]string{"message": "Joined room successfully"}); err != nil {
+	if err := json.NewEncoder(w).Encode(map[string]any{"message": "Joined room successfully", "player_id": grant.PlayerId, "session_token": grant.SessionToken}); err != nil {
 		ctxLogger.Error("Error encoding response", zap.Error(err))
 	}
 }
 
-// HandleListRooms 处理列出可用游戏房间的请求。
-func (s *Server) HandleListRooms(w http.ResponseWriter, _ *http.Request) {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	var roomList []map[string]interface{}
-	for id, room := range s.rooms {
-		// 只列出未满或未开始的房间
-		if room.gameEngine.GameState.CurrentPhase == model.GamePhase_GAME_PHASE_SETUP { // 示例条件
-			roomList = append(roomList, map[string]interface{}{
-				"id":            id,
-				"script_name":   "", // 占位符，因为 Script 不再直接在 GameState 上
-				"players_count": len(room.gameEngine.GameState.Players),
-				"current_phase": room.gameEngine.GameState.CurrentPhase,
-			})
-		}
+// joinRoom 在大厅中为调用者分配座位并签发席位会话令牌。
+func (s *Server) joinRoom(ctx context.Context, identity *Identity, gameID, name string, role model.PlayerRole) (*model.SeatGrant, error) {
+	ctxLogger := logger.LoggerFromContext(ctx)
+	if identity.Name != "" {
+		name = identity.Name
 	}
-
-	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(roomList); err != nil {
-		s.logger.Error("Error encoding room list", zap.Error(err))
+	room, ok := s.getRoom(gameID)
+	if !ok {
+		return nil, newRPCError(codeNotFound, errRoomNotFound)
 	}
-}
-
-// Client 表示单个 WebSocket 连接。
-type Client struct {
-	conn     *websocket.Conn
-	send     chan []byte // 用于传出消息的带缓冲通道
-	playerID int32
-	room     *Room // 此客户端所属的房间
-	logger   *zap.Logger
-}
-
-// readPump 从 WebSocket 连接中抽取消息到房间。
-func (c *Client) readPump(_ *Server) {
-	defer func() {
-		c.logger.Info("Player disconnected.")
-
-		c.conn.Close()
-	}()
 
-	for {
-		_, message, err := c.conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				c.logger.Error("WebSocket read error", zap.Error(err))
-			}
-			break
-		}
-
-		var action *model.PlayerActionPayload
-		if err := json.Unmarshal(message, &action); err != nil {
-			c.logger.Warn("Failed to parse incoming message as PlayerAction", zap.Error(err))
-			continue
-		}
+	// 串行化同一房间的入座请求，使重复入座检查和分配座位成为原子操作。
+	room.joinMu.Lock()
+	defer room.joinMu.Unlock()
 
-		if c.room != nil {
-			c.room.gameEngine.SubmitPlayerAction(c.playerID, action)
-		} else {
-			c.logger.Warn("Received action but not in a room.")
-		}
+	if st := room.seatForUser(identity.UserID); st != nil {
+		return nil, rpcErrorf(codeAlreadyExists, "already seated as player %d", st.playerID)
 	}
-}
-
-// writePump 将消息从房间抽取到 WebSocket 连接。
-func (c *Client) writePump() {
-	defer func() {
-		c.conn.Close()
-	}()
-	for message := range c.send {
-		if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
-			c.logger.Error("WebSocket write error", zap.Error(err))
-			return
-		}
+	lobbySeat, err := room.addLobbySeat(name, role, false)
+	if err != nil {
+		ctxLogger.Warn("Rejected join request", zap.String("gameID", gameID), zap.Error(err))
+		return nil, newRPCError(codeAborted, err)
 	}
-}
 
-// Room 管理单个游戏实例及其连接的客户端。
-type Room struct {
-	GameId     string
-	gameEngine *engine.GameEngine
-	clients    map[int32]*Client // 玩家 ID 到 Client 的映射
-	mu         sync.RWMutex
-	stopChan   chan struct{} // 用于发出房间停止信号的通道
-	logger     *zap.Logger
-}
+	// 会话令牌用于 WebSocket 握手和大厅操作，断线后可凭同一令牌重新连接到该席位。
+	sessionToken := s.issueSeat(room, lobbySeat.PlayerId, lobbySeat.Role, identity)
+	room.broadcastLobby()
 
-// NewRoom 创建一个新的游戏房间。
-func NewRoom(gameID string, ge *engine.GameEngine, logger *zap.Logger) *Room {
-	return &Room{
-		GameId:     gameID,
-		gameEngine: ge,
-		clients:    make(map[int32]*Client),
-		stopChan:   make(chan struct{}),
-		logger:     logger.With(zap.String("gameID", gameID)), // 将 gameID 添加到所有房间日志中
-	}
+	ctxLogger.Info("Player joined room", zap.Int32("playerID", lobbySeat.PlayerId), zap.String("gameID", gameID), zap.String("role", role.String()))
+	return &model.SeatGrant{GameId: gameID, PlayerId: lobbySeat.PlayerId, SessionToken: sessionToken}, nil
 }
 
-// AddClient 将客户端添加到房间。
-func (r *Room) AddClient(client *Client) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	r.clients[client.playerID] = client
-	client.room = r // 设置客户端的房间引用
-	r.logger.Info("Client added to room", zap.Int32("clientID", client.playerID), zap.String("roomID", r.GameId))
+// getRoom 按游戏 ID 查找房间。
+func (s *Server) getRoom(gameID string) (*Room, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	room, ok := s.rooms[gameID]
+	return room, ok
 }
 
-// RemoveClient 从房间中移除客户端。
-func (r *Room) RemoveClient(playerID int32) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
-	if client, ok := r.clients[playerID]; ok {
-		close(client.send) // 关闭客户端的发送通道
-		delete(r.clients, playerID)
-		r.logger.Info("Client removed from room", zap.Int32("clientID", playerID), zap.String("roomID", r.GameId))
+// findSeat 验证会话令牌并返回对应的房间和席位。
+func (s *Server) findSeat(token string) (*Room, *seat, error) {
+	claims, err := s.seatTokens.verify(token)
+	if err != nil {
+		return nil, nil, err
 	}
+	room, ok := s.getRoom(claims.GameID)
+	if !ok {
+		return nil, nil, fmt.Errorf("room %s not found", claims.GameID)
+	}
+	st := room.seatByToken(claims.PlayerID, token)
+	if st == nil {
+		return nil, nil, fmt.Errorf("seat token for player %d has been revoked", claims.PlayerID)
+	}
+	return room, st, nil
 }
 
-// Start 启动房间的游戏引擎和事件广播。
-func (r *Room) Start() {
-	r.gameEngine.Start()
-	go r.broadcastGameEvents()
-}
-
-// Stop 停止房间的游戏引擎和广播。
-func (r *Room) Stop() {
-	r.gameEngine.Stop()
-	close(r.stopChan)
-	r.logger.Info("Room signaled to stop.", zap.String("roomID", r.GameId))
-}
-
-// broadcastGameEvents 监听游戏事件并将其广播给客户端。
-func (r *Room) broadcastGameEvents() {
-	eventChan := r.gameEngine.GetGameEvents()
-	for {
-		select {
-		case <-r.stopChan:
-			r.logger.Info("Event broadcaster stopped.", zap.String("roomID", r.GameId))
-			return
-		case event := <-eventChan:
-			r.logger.Debug("Broadcasting event", zap.String("roomID", r.GameId), zap.String("eventType", event.Type.String()))
-			r.mu.RLock()
-			for playerID, client := range r.clients {
-				playerView := r.gameEngine.GetPlayerView(playerID)
-				msg, err := json.Marshal(playerView)
-				if err != nil {
-					r.logger.Error("Failed to marshal player view", zap.String("roomID", r.GameId), zap.Int32("playerID", playerID), zap.Error(err))
-					continue
-				}
-				select {
-				case client.send <- msg:
-				default:
-					r.logger.Warn("Client send channel full, dropping message.", zap.String("roomID", r.GameId), zap.Int32("playerID", playerID))
-				}
-			}
-			r.mu.RUnlock()
-		}
+// authorizeSeat 验证会话令牌，并检查席位属于调用者。
+func (s *Server) authorizeSeat(identity *Identity, token string) (*Room, *seat, error) {
+	room, st, err := s.findSeat(token)
+	if err != nil {
+		return nil, nil, newRPCError(codeUnauthenticated, fmt.Errorf("invalid session token: %w", err))
+	}
+	if st.userID != "" && st.userID != identity.UserID {
+		return nil, nil, rpcErrorf(codePermissionDenied, "seat belongs to another user")
 	}
+	return room, st, nil
 }
 
 // generateUniqueGameID 是实际 ID 生成函数的占位符。
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// 此文件实现 GameService 的各个方法，它们与对应的 HTTP 和 WebSocket 接口共享同一套房间逻辑。
+
+// rpcCreateRoom 实现 GameService.CreateRoom。
+func (s *Server) rpcCreateRoom(ctx context.Context, identity *Identity, req *model.CreateRoomRequest) (*model.SeatGrant, error) {
+	return s.createRoom(ctx, identity, req.GetPlayerName(), req.GetPlayerRole(), lobbySettings{
+		ScriptID:                      req.GetScriptId(),
+		ModelID:                       req.GetModelId(),
+		DifficultySet:                 req.GetDifficultySet(),
+		FillWithAI:                    req.GetFillWithAi(),
+		SpectatorFullViewDelaySeconds: req.GetSpectatorFullViewDelaySeconds(),
+	})
+}
+
+// rpcJoinRoom 实现 GameService.JoinRoom。
+func (s *Server) rpcJoinRoom(ctx context.Context, identity *Identity, req *model.TakeSeatRequest) (*model.SeatGrant, error) {
+	return s.joinRoom(ctx, identity, req.GetGameId(), req.GetPlayerName(), req.GetPlayerRole())
+}
+
+// rpcListRooms 实现 GameService.ListRooms。
+func (s *Server) rpcListRooms(ctx context.Context, _ *Identity, req *model.ListRoomsRequest) (*model.ListRoomsResponse, error) {
+	states := map[model.RoomState]bool{}
+	for _, state := range req.GetStates() {
+		if _, ok := model.RoomState_name[int32(state)]; !ok || state == model.RoomState_ROOM_STATE_UNSPECIFIED {
+			return nil, rpcErrorf(codeInvalidArgument, "unknown room state %v", state)
+		}
+		states[state] = true
+	}
+	if len(states) == 0 {
+		states[model.RoomState_ROOM_STATE_LOBBY] = true
+	}
+	return s.listRooms(ctx, states)
+}
+
+// rpcSubmitAction 实现 GameService.SubmitAction。
+func (s *Server) rpcSubmitAction(_ context.Context, identity *Identity, req *model.SeatActionRequest) (*model.SeatActionResponse, error) {
+	room, st, err := s.authorizeSeat(identity, req.GetSessionToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := room.submitAction(st, req.GetAction()); err != nil {
+		return nil, err
+	}
+	return &model.SeatActionResponse{}, nil
+}
+
+// rpcGetPlayerView 实现 GameService.GetPlayerView。
+func (s *Server) rpcGetPlayerView(_ context.Context, identity *Identity, req *model.GetPlayerViewRequest) (*model.PlayerView, error) {
+	room, st, err := s.authorizeSeat(identity, req.GetSessionToken())
+	if err != nil {
+		return nil, err
+	}
+	ge := room.engine()
+	if ge == nil {
+		return nil, newRPCError(codeFailedPrecondition, errGameNotStarted)
+	}
+	view := ge.GetPlayerView(st.playerID)
+	if view == nil {
+		return nil, newRPCError(codeUnavailable, errGameStopped)
+	}
+	return view, nil
+}
+
+// rpcSendChat 实现 GameService.SendChat。
+func (s *Server) rpcSendChat(_ context.Context, identity *Identity, req *model.SeatChatRequest) (*model.SeatChatResponse, error) {
+	room, st, err := s.authorizeSeat(identity, req.GetSessionToken())
+	if err != nil {
+		return nil, err
+	}
+	if err := room.sendChat(st, req.GetChat()); err != nil {
+		return nil, err
+	}
+	return &model.SeatChatResponse{}, nil
+}
+
+// rpcStreamEvents 实现 GameService.StreamEvents。
+// 流作为席位的连接加入房间，与 WebSocket 连接一样接收按序号排列的服务器消息，并在重连时补发错过的事件。
+// 调用者断开、连接被同一席位的新连接替换或房间停止时，流结束。
+func (s *Server) rpcStreamEvents(ctx context.Context, identity *Identity, req *model.StreamEventsRequest, send func(*model.ServerMessage) error) error {
+	client := newStreamClient(identity, logger.LoggerFromContext(ctx))
+	room, err := s.attachStream(client, req.GetSessionToken(), req.GetLastSeq())
+	if err != nil {
+		return err
+	}
+	defer room.RemoveClient(client)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-client.send:
+			if !ok {
+				return nil
+			}
+			if err := send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// attachStream 验证会话令牌，并将没有 WebSocket 连接的客户端作为席位的连接加入房间。
+// lastSeq 是客户端已收到的最后一条消息序号，之后的事件会被补发。
+func (s *Server) attachStream(client *Client, token string, lastSeq uint64) (*Room, error) {
+	room, st, err := s.authorizeSeat(client.identity, token)
+	if err != nil {
+		return nil, err
+	}
+	room.AddClient(client, st, lastSeq)
+	return room, nil
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// maxSeatHistory 是每个席位为重连保留的未确认事件数量上限。
+// 超出上限时丢弃最旧的事件；客户端仍可从 PlayerView.public_events 中获得完整历史。
+const maxSeatHistory = 1024
+
+// seat 是房间中一个人类玩家席位的会话状态，跨越多次 WebSocket 连接保留。
+// 服务器消息序号按席位分配，因此重连后客户端可以用最后确认的序号请求补发。
+type seat struct {
+	token    string // 签名的席位会话令牌，见 seatTokenSigner
+	playerID int32
+	role     model.PlayerRole
+	userID   string // 认证器识别出的入座用户，匿名时为空
+	// spectator 表示这是旁观者的匿名席位：playerID 为 0，仅用于分配消息序号，不能执行操作。
+	spectator bool
+
+	mu      sync.Mutex
+	seq     uint64                 // 最后一条已分配的消息序号
+	acked   uint64                 // 客户端确认收到的最后一条消息序号
+	history []*model.ServerMessage // 尚未确认的事件消息，按序号排列
+	dropped uint64                 // 因超出 maxSeatHistory 而丢弃的最后一条事件消息的序号
+	client  *Client                // 当前连接，断线时为 nil
+	joined  bool                   // 席位是否绑定过连接，用于区分重连
+	limiter rateLimiter            // 限制席位提交操作的频率，跨越重连保留
+	// viewVersion 是当前连接持有的视图版本，0 表示尚未发送完整视图。每次绑定新连接时重置。
+	viewVersion uint64
+}
+
+// newSeat 创建一个席位。
+func newSeat(playerID int32, role model.PlayerRole, token, userID string) *seat {
+	return &seat{
+		token:    token,
+		playerID: playerID,
+		role:     role,
+		userID:   userID,
+	}
+}
+
+// viewer 返回此席位在可见性策略中的身份。
+func (s *seat) viewer() visibility.Viewer {
+	return visibility.Viewer{PlayerID: s.playerID, Role: s.role}
+}
+
+// connected 报告席位当前是否有连接。
+func (s *seat) connected() bool {
+	return s.currentClient() != nil
+}
+
+// currentClient 返回席位当前的连接，断线时返回 nil。
+func (s *seat) currentClient() *Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.client
+}
+
+// stamp 为消息分配序号，并保留事件消息以备重连补发。调用者必须持有 s.mu。
+func (s *seat) stamp(msg *model.ServerMessage) {
+	s.seq++
+	msg.Seq = s.seq
+	if _, ok := msg.Message.(*model.ServerMessage_Event); ok {
+		s.history = append(s.history, msg)
+		if len(s.history) > maxSeatHistory {
+			trim := len(s.history) - maxSeatHistory
+			s.dropped = s.history[trim-1].GetSeq()
+			s.history = s.history[trim:]
+		}
+	}
+}
+
+// sendTo 为消息分配序号并发送给指定客户端。
+func (s *seat) sendTo(c *Client, msg *model.ServerMessage) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stamp(msg)
+	return c.enqueue(msg)
+}
+
+// broadcast 为消息分配序号并发送给席位当前的连接。
+// 即使席位已断线，事件也会被保留，以便重连时补发。
+func (s *seat) broadcast(msg *model.ServerMessage) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stamp(msg)
+	if s.client == nil {
+		return false
+	}
+	return s.client.enqueue(msg)
+}
+
+// sendView 向席位当前的连接推送已发布的视图。连接持有差异所基于的版本时发送 ViewDelta，否则发送完整视图。
+// 不会发送比连接已持有的版本更旧的视图。席位断线时不发送任何内容并返回 true，重连后会收到完整视图。
+func (s *seat) sendView(pv *engine.PublishedView) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client == nil || pv.Version <= s.viewVersion {
+		return true
+	}
+	var msg *model.ServerMessage
+	if s.viewVersion != 0 && s.viewVersion == pv.Delta.GetBaseVersion() {
+		msg = newViewDeltaMessage(pv.Delta)
+	} else {
+		msg = newViewUpdateMessage(pv.View, pv.Version)
+	}
+	s.viewVersion = pv.Version
+	s.stamp(msg)
+	return s.client.enqueue(msg)
+}
+
+// resetView 让席位忘记当前连接持有的视图版本，下一次推送将是完整视图。
+func (s *seat) resetView() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.viewVersion = 0
+}
+
+// allowAction 报告席位在 now 时刻能否再提交一次操作，见 rateLimiter.allow。
+func (s *seat) allowAction(now time.Time, rate float64, burst int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.limiter.allow(now, rate, burst)
+}
+
+// ack 记录客户端确认的序号，并丢弃已确认的历史事件。
+func (s *seat) ack(seq uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ackLocked(seq)
+}
+
+func (s *seat) ackLocked(seq uint64) {
+	if seq <= s.acked || seq > s.seq {
+		return
+	}
+	s.acked = seq
+	i := 0
+	for i < len(s.history) && s.history[i].GetSeq() <= seq {
+		i++
+	}
+	s.history = s.history[i:]
+}
+
+// attach 将客户端绑定到席位，发送 joined 消息；如果是重连，再补发 lastSeq 之后的事件。
+// joined 不分配序号，因此客户端确认它不会让席位丢弃正在补发的事件。
+// joined 的 resumed 和 history_truncated 字段由此方法填写。返回被替换的旧连接（如果有）。
+func (s *seat) attach(c *Client, joined *model.JoinedRoom, lastSeq uint64) *Client {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old := s.client
+	s.client = c
+	s.viewVersion = 0
+	resumed := s.joined
+	s.joined = true
+	s.ackLocked(lastSeq)
+
+	joined.Resumed = resumed
+	joined.HistoryTruncated = resumed && lastSeq < s.dropped
+	c.enqueue(&model.ServerMessage{Message: &model.ServerMessage_Joined{Joined: joined}})
+	if resumed {
+		for _, msg := range s.history {
+			if msg.GetSeq() > lastSeq {
+				c.enqueue(msg)
+			}
+		}
+	}
+	if old == c {
+		return nil
+	}
+	return old
+}
+
+// detach 在客户端仍是席位当前连接时解除绑定，并报告是否解除了绑定。
+func (s *seat) detach(c *Client) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.client != c {
+		return false
+	}
+	s.client = nil
+	return true
+}
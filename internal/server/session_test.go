@@ -0,0 +1,150 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func drain(c *Client) []*model.ServerMessage {
+	var msgs []*model.ServerMessage
+	for {
+		select {
+		case msg := <-c.send:
+			msgs = append(msgs, msg)
+		default:
+			return msgs
+		}
+	}
+}
+
+func eventMessage(eventType model.GameEventType) *model.ServerMessage {
+	return newEventMessage(&model.GameEvent{Type: eventType})
+}
+
+func TestClientSendBeforeJoin(t *testing.T) {
+	c := newClient(nil, nil)
+	assert.True(t, c.Send(newAckMessage(1)))
+	assert.Equal(t, uint64(0), (<-c.send).GetSeq(), "messages before joining a seat are not sequenced")
+
+	c.close()
+	c.close()
+	assert.False(t, c.Send(newAckMessage(2)), "closed client should not accept messages")
+}
+
+func TestSeatResume(t *testing.T) {
+	st := newSeat(2, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, "token", "")
+
+	first := newClient(nil, nil)
+	assert.Nil(t, st.attach(first, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 0))
+	joined := drain(first)
+	assert.Len(t, joined, 1)
+	assert.Equal(t, uint64(0), joined[0].GetSeq(), "joined messages are not sequenced")
+	assert.False(t, joined[0].GetJoined().GetResumed())
+
+	// 两个事件送达，客户端只确认了第一个。
+	assert.True(t, st.broadcast(eventMessage(model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED)))
+	assert.True(t, st.broadcast(eventMessage(model.GameEventType_GAME_EVENT_TYPE_LOOP_RESET)))
+	assert.Len(t, drain(first), 2)
+	st.ack(1)
+
+	// 断线期间的事件仍然分配序号并保留。
+	assert.True(t, st.detach(first))
+	assert.False(t, st.connected())
+	assert.False(t, st.broadcast(eventMessage(model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED)))
+	assert.False(t, st.broadcast(newViewUpdateMessage(&model.PlayerView{}, 1)), "view updates are not kept for resend")
+
+	second := newClient(nil, nil)
+	assert.Nil(t, st.attach(second, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 1))
+	msgs := drain(second)
+	if assert.Len(t, msgs, 3) {
+		assert.True(t, msgs[0].GetJoined().GetResumed())
+		assert.False(t, msgs[0].GetJoined().GetHistoryTruncated())
+		assert.Equal(t, uint64(0), msgs[0].GetSeq())
+		assert.Equal(t, uint64(2), msgs[1].GetSeq())
+		assert.Equal(t, model.GameEventType_GAME_EVENT_TYPE_LOOP_RESET, msgs[1].GetEvent().GetType())
+		assert.Equal(t, uint64(3), msgs[2].GetSeq())
+		assert.Equal(t, model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED, msgs[2].GetEvent().GetType())
+	}
+
+	// 客户端确认 joined 之后、收到补发的事件之前再次断线：事件仍会再次补发。
+	st.ack(msgs[0].GetSeq())
+	third := newClient(nil, nil)
+	assert.Equal(t, second, st.attach(third, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 1))
+	assert.False(t, st.detach(second), "replaced client must not detach the seat")
+	assert.Len(t, drain(third), 3)
+
+	// 新连接替换旧连接。
+	fourth := newClient(nil, nil)
+	assert.Equal(t, third, st.attach(fourth, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 4))
+	assert.Len(t, drain(fourth), 1, "acknowledged events are not resent")
+}
+
+func TestSeatResumeTruncatedHistory(t *testing.T) {
+	st := newSeat(2, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, "token", "")
+	first := newClient(nil, nil)
+	st.attach(first, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 0)
+	assert.True(t, st.detach(first))
+
+	// 断线期间的事件超出了保留的历史，最旧的两条被丢弃。
+	for range maxSeatHistory + 2 {
+		st.broadcast(eventMessage(model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED))
+	}
+
+	second := newClient(nil, nil)
+	st.attach(second, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 1)
+	msgs := drain(second)
+	if assert.Len(t, msgs, maxSeatHistory+1) {
+		assert.True(t, msgs[0].GetJoined().GetHistoryTruncated(), "the client must learn that event 2 was lost")
+		assert.Equal(t, uint64(3), msgs[1].GetSeq())
+	}
+
+	third := newClient(nil, nil)
+	st.attach(third, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 2)
+	msgs = drain(third)
+	if assert.NotEmpty(t, msgs) {
+		assert.False(t, msgs[0].GetJoined().GetHistoryTruncated())
+	}
+}
+
+func publishedView(base, version uint64) *engine.PublishedView {
+	return &engine.PublishedView{
+		Version: version,
+		View:    &model.PlayerView{Tick: int64(version)},
+		Delta:   &model.ViewDelta{BaseVersion: base, Version: version},
+	}
+}
+
+func TestSeatSendView(t *testing.T) {
+	st := newSeat(2, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, "token", "")
+	assert.True(t, st.sendView(publishedView(0, 1)), "disconnected seats are skipped")
+
+	c := newClient(nil, nil)
+	st.attach(c, &model.JoinedRoom{GameId: "g1", PlayerId: 2}, 0)
+	drain(c)
+
+	// 新连接先收到完整视图，之后收到差异。
+	assert.True(t, st.sendView(publishedView(1, 3)))
+	assert.True(t, st.sendView(publishedView(3, 4)))
+	assert.True(t, st.sendView(publishedView(3, 4)), "versions already sent are skipped")
+	msgs := drain(c)
+	if assert.Len(t, msgs, 2) {
+		assert.Equal(t, uint64(3), msgs[0].GetViewUpdate().GetVersion())
+		assert.Equal(t, uint64(4), msgs[1].GetViewDelta().GetVersion())
+	}
+
+	// 错过了一个批次：差异的基准版本与连接持有的版本不一致，改发完整视图。
+	assert.True(t, st.sendView(publishedView(5, 6)))
+	msgs = drain(c)
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, uint64(6), msgs[0].GetViewUpdate().GetVersion())
+	}
+
+	// 客户端请求重新同步后收到完整视图。
+	st.resetView()
+	assert.True(t, st.sendView(publishedView(5, 6)))
+	assert.NotNil(t, drain(c)[0].GetViewUpdate())
+}
@@ -0,0 +1,202 @@
+package server
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// spectatorQueueSize 是延迟旁观者等待发送的消息数量上限，超出时丢弃新消息。
+const spectatorQueueSize = 4096
+
+// errFullViewNotAllowed 表示房主没有允许旁观者在游戏进行中获得完整视图。
+var errFullViewNotAllowed = errors.New("full spectator view is not allowed while the game is running")
+
+// spectator 是一个没有席位的旁观连接。旁观者收到按其视角过滤的事件和视图，不能执行任何操作。
+// 完整视图按房间设置延迟发送，以免被正在游戏的玩家利用。
+type spectator struct {
+	seat  *seat // 匿名席位，仅用于分配消息序号
+	full  bool
+	delay time.Duration
+	queue chan delayedMessage // delay > 0 时等待发送的消息
+	done  chan struct{}
+	once  sync.Once
+}
+
+// delayedMessage 是一条在 at 时刻之后才发送的消息。
+type delayedMessage struct {
+	at  time.Time
+	msg *model.ServerMessage
+}
+
+// newSpectator 创建旁观者；delay > 0 时启动延迟发送 goroutine。
+func newSpectator(full bool, delay time.Duration) *spectator {
+	sp := &spectator{
+		seat:  &seat{role: visibility.SpectatorViewer(full).Role, spectator: true},
+		full:  full,
+		delay: delay,
+		done:  make(chan struct{}),
+	}
+	if delay > 0 {
+		sp.queue = make(chan delayedMessage, spectatorQueueSize)
+		go sp.run()
+	}
+	return sp
+}
+
+// deliver 发送游戏消息，按旁观者的延迟排队。
+func (sp *spectator) deliver(msg *model.ServerMessage) bool {
+	if sp.delay <= 0 {
+		return sp.seat.broadcast(msg)
+	}
+	select {
+	case sp.queue <- delayedMessage{at: time.Now().Add(sp.delay), msg: msg}:
+		return true
+	default:
+		return false
+	}
+}
+
+// run 在每条消息到期后发送，直到旁观者停止。
+func (sp *spectator) run() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-sp.done:
+			return
+		case dm := <-sp.queue:
+			timer.Reset(time.Until(dm.at))
+			select {
+			case <-sp.done:
+				return
+			case <-timer.C:
+				sp.seat.broadcast(dm.msg)
+			}
+		}
+	}
+}
+
+// stop 停止延迟发送。可以重复调用。
+func (sp *spectator) stop() {
+	sp.once.Do(func() { close(sp.done) })
+}
+
+// addSpectator 让客户端以旁观者身份观看房间。
+// 请求完整视图时，游戏结束后立即提供；游戏进行中仅在房主设置了延迟时按延迟提供。
+func (r *Room) addSpectator(client *Client, full bool) error {
+	gameOver := false
+	if ge := r.engine(); ge != nil {
+		gameOver = ge.GetCurrentPhase() == model.GamePhase_GAME_PHASE_GAME_OVER
+	}
+
+	r.mu.Lock()
+	var delay time.Duration
+	if full && !gameOver {
+		if r.lobby.SpectatorFullViewDelaySeconds <= 0 {
+			r.mu.Unlock()
+			return errFullViewNotAllowed
+		}
+		delay = time.Duration(r.lobby.SpectatorFullViewDelaySeconds) * time.Second
+	}
+	sp := newSpectator(full, delay)
+	r.spectators[client] = sp
+	r.mu.Unlock()
+
+	client.mu.Lock()
+	client.seat = sp.seat
+	client.mu.Unlock()
+	client.room = r
+
+	sp.seat.attach(client, &model.JoinedRoom{
+		GameId:       r.GameId,
+		Role:         sp.seat.role,
+		Spectator:    true,
+		DelaySeconds: int32(delay / time.Second),
+	}, 0)
+	r.logger.Info("Spectator joined room", zap.Bool("fullView", full), zap.Duration("delay", delay))
+
+	r.broadcastLobby()
+	if ge := r.engine(); ge != nil {
+		if view := ge.GetSpectatorView(sp.seat.viewer()); view != nil {
+			sp.deliver(newViewUpdateMessage(view, 0))
+		}
+	}
+	return nil
+}
+
+// removeSpectator 移除旁观者并报告客户端是否为旁观者。
+func (r *Room) removeSpectator(client *Client) bool {
+	r.mu.Lock()
+	sp, ok := r.spectators[client]
+	delete(r.spectators, client)
+	r.mu.Unlock()
+	if !ok {
+		return false
+	}
+	sp.stop()
+	sp.seat.detach(client)
+	r.logger.Info("Spectator left room")
+	r.broadcastLobby()
+	return true
+}
+
+// spectatorList 返回房间中所有旁观者的快照。
+func (r *Room) spectatorList() []*spectator {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	spectators := make([]*spectator, 0, len(r.spectators))
+	for _, sp := range r.spectators {
+		spectators = append(spectators, sp)
+	}
+	return spectators
+}
+
+// broadcastSpectatorEvent 将事件按旁观者视角过滤后发送给所有旁观者。
+func (r *Room) broadcastSpectatorEvent(event *model.GameEvent) {
+	ge := r.engine()
+	// 同一视角的旁观者共享过滤结果，只有消息信封按旁观者创建。
+	redacted := make(map[bool]*model.GameEvent, 2)
+	for _, sp := range r.spectatorList() {
+		e, ok := redacted[sp.full]
+		if !ok {
+			e = ge.RedactEvent(sp.seat.viewer(), event)
+			redacted[sp.full] = e
+		}
+		if e != nil && !sp.deliver(newEventMessage(e)) {
+			r.logger.Warn("Spectator queue full, dropping event.", zap.String("roomID", r.GameId))
+		}
+	}
+}
+
+// sendSpectatorView 向旁观者推送最新的完整视图。
+func (r *Room) sendSpectatorView(client *Client) {
+	r.mu.RLock()
+	sp, ok := r.spectators[client]
+	r.mu.RUnlock()
+	ge := r.engine()
+	if !ok || ge == nil {
+		return
+	}
+	if view := ge.GetSpectatorView(sp.seat.viewer()); view != nil {
+		sp.deliver(newViewUpdateMessage(view, 0))
+	}
+}
+
+// broadcastSpectatorViews 向旁观者推送引擎在视图批次中发布的旁观者视图，视图没有变化的旁观者不推送。
+// 发布的视图不与游戏状态共享数据，因此延迟发送的视图保持发布时的内容。
+func (r *Room) broadcastSpectatorViews(views map[visibility.Viewer]*model.PlayerView) {
+	if len(views) == 0 {
+		return
+	}
+	for _, sp := range r.spectatorList() {
+		if view, ok := views[sp.seat.viewer()]; ok && !sp.deliver(newViewUpdateMessage(view, 0)) {
+			r.logger.Warn("Spectator queue full, dropping view.", zap.String("roomID", r.GameId))
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/game/engine/visibility"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestAddSpectator(t *testing.T) {
+	room := NewRoom("g1", zap.NewNop())
+
+	// 房主没有设置延迟时，游戏进行中不提供完整视图。
+	c := newClient(nil, nil)
+	assert.ErrorIs(t, room.addSpectator(c, true), errFullViewNotAllowed)
+	assert.Nil(t, c.currentSeat())
+
+	assert.NoError(t, room.addSpectator(c, false))
+	msgs := drain(c)
+	if assert.NotEmpty(t, msgs) {
+		joined := msgs[0].GetJoined()
+		assert.True(t, joined.GetSpectator())
+		assert.Equal(t, int32(0), joined.GetPlayerId())
+		assert.Equal(t, model.PlayerRole_PLAYER_ROLE_PROTAGONIST, joined.GetRole())
+	}
+	assert.Equal(t, int32(1), room.lobbySnapshot().GetSpectators())
+
+	c.submitAction(1, &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PassTurn{}})
+	msgs = drain(c)
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, model.ErrorCode_ERROR_CODE_FORBIDDEN, msgs[0].GetError().GetCode())
+	}
+
+	room.RemoveClient(c)
+	assert.Equal(t, int32(0), room.lobbySnapshot().GetSpectators())
+}
+
+func TestDelayedSpectator(t *testing.T) {
+	sp := newSpectator(true, 50*time.Millisecond)
+	defer sp.stop()
+	c := newClient(nil, nil)
+	sp.seat.attach(c, &model.JoinedRoom{Spectator: true}, 0)
+	drain(c)
+
+	sent := time.Now()
+	assert.True(t, sp.deliver(eventMessage(model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED)))
+	assert.Empty(t, drain(c), "full view is delayed")
+
+	msg := <-c.send
+	assert.GreaterOrEqual(t, time.Since(sent), 50*time.Millisecond)
+	assert.Equal(t, model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED, msg.GetEvent().GetType())
+}
+
+func TestBroadcastSpectatorViews(t *testing.T) {
+	room := NewRoom("g1", zap.NewNop())
+	limited := newClient(nil, nil)
+	assert.NoError(t, room.addSpectator(limited, false))
+	full := newSpectator(true, 0)
+	fullClient := newClient(nil, nil)
+	full.seat.attach(fullClient, &model.JoinedRoom{Spectator: true}, 0)
+	room.mu.Lock()
+	room.spectators[fullClient] = full
+	room.mu.Unlock()
+	drain(limited)
+	drain(fullClient)
+
+	// 只有视图发生了变化的旁观者收到视图。
+	view := &model.PlayerView{GameId: "g1", CurrentDay: 2}
+	room.broadcastSpectatorViews(map[visibility.Viewer]*model.PlayerView{visibility.SpectatorViewer(true): view})
+	assert.Empty(t, drain(limited))
+	msgs := drain(fullClient)
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, int32(2), msgs[0].GetViewUpdate().GetView().GetCurrentDay())
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"go.uber.org/zap"
+)
+
+// sseKeepAliveInterval 是 SSE 流在没有消息时发送注释行的间隔，防止代理关闭空闲连接。
+const sseKeepAliveInterval = 15 * time.Second
+
+// HandleEvents 通过 Server-Sent Events 推送与 WebSocket 相同的服务器消息，供无法使用 WebSocket 的客户端使用；
+// 操作通过 HandleSubmitAction 提交。
+//
+// 玩家使用 ?session_token=... 连接到自己的席位，旁观者使用 ?spectate=<game_id>&full_view=1。
+// 每条消息的 id 是消息序号，event 是消息类型（joined、event、view_update 等），data 是 protojson。
+// 重连时浏览器会自动发送 Last-Event-ID，服务器据此补发错过的事件；也可以用 ?last_seq=... 指定。
+func (s *Server) HandleEvents(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	identity, ok := s.authenticate(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	client := newStreamClient(identity, ctxLogger)
+	var room *Room
+	if token := query.Get("session_token"); token != "" {
+		lastEventID := r.Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = query.Get("last_seq")
+		}
+		lastSeq, _ := strconv.ParseUint(lastEventID, 10, 64)
+		var err error
+		if room, err = s.attachStream(client, token, lastSeq); err != nil {
+			httpError(w, ctxLogger, err)
+			return
+		}
+	} else if gameID := query.Get("spectate"); gameID != "" {
+		if room, ok = s.getRoom(gameID); !ok {
+			http.Error(w, "Room not found", http.StatusNotFound)
+			return
+		}
+		fullView, _ := strconv.ParseBool(query.Get("full_view"))
+		if err := room.addSpectator(client, fullView); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	} else {
+		http.Error(w, "session_token or spectate is required", http.StatusBadRequest)
+		return
+	}
+	defer room.RemoveClient(client)
+	ctxLogger.Info("Client connected via SSE.")
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // 关闭 nginx 等反向代理的响应缓冲
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		ctxLogger.Error("SSE flush failed", zap.Error(err))
+		return
+	}
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+	for {
+		var err error
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keep-alive\n\n")
+		case msg, ok := <-client.send:
+			if !ok {
+				// 连接被同一席位的新连接替换、发送队列已满，或房间已停止。
+				return
+			}
+			err = writeSSE(w, msg)
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			ctxLogger.Info("SSE write failed", zap.Error(err))
+			return
+		}
+	}
+}
+
+// writeSSE 将服务器消息写为一条 SSE 消息。
+func writeSSE(w io.Writer, msg *model.ServerMessage) error {
+	data, err := protocolMarshaler.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if msg.GetSeq() > 0 {
+		fmt.Fprintf(&buf, "id: %d\n", msg.GetSeq())
+	}
+	m := msg.ProtoReflect()
+	if field := m.WhichOneof(m.Descriptor().Oneofs().ByName("message")); field != nil {
+		fmt.Fprintf(&buf, "event: %s\n", field.Name())
+	}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// HandleSubmitAction 处理通过 HTTP 提交玩家操作的请求，与 HandleEvents 配合使用。
+// 请求体是 protojson 编码的 SeatActionRequest。操作的结果通过事件推送；接受操作时返回 202。
+func (s *Server) HandleSubmitAction(w http.ResponseWriter, r *http.Request) {
+	ctxLogger := logger.LoggerFromContext(r.Context())
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxRPCMessageSize))
+	if err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	req := &model.SeatActionRequest{}
+	if err := protocolUnmarshaler.Unmarshal(data, req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	room, st, ok := s.seatFromRequest(w, r, req.GetSessionToken())
+	if !ok {
+		return
+	}
+	if err := room.submitAction(st, req.GetAction()); err != nil {
+		httpError(w, ctxLogger, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// readSSE 读取一条 SSE 消息，返回其字段。注释行被跳过。
+func readSSE(t *testing.T, r *bufio.Reader) map[string]string {
+	t.Helper()
+	fields := map[string]string{}
+	for {
+		line, err := r.ReadString('\n')
+		if !assert.NoError(t, err) {
+			return fields
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			if len(fields) > 0 {
+				return fields
+			}
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+		name, value, _ := strings.Cut(line, ": ")
+		fields[name] += value
+	}
+}
+
+func TestSSE(t *testing.T) {
+	srv := NewServer("", nil, logger.New())
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", srv.HandleEvents)
+	mux.HandleFunc("/submit_action", srv.HandleSubmitAction)
+	ts := httptest.NewServer(srv.LoggingMiddleware(mux))
+	defer ts.Close()
+
+	room := NewRoom("game-1", logger.New())
+	host, _ := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{})
+	assert.NoError(t, srv.addRoom(room))
+
+	resp, err := http.Get(ts.URL + "/events?session_token=" + token)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	msg := readSSE(t, bufio.NewReader(resp.Body))
+	assert.Equal(t, "1", msg["id"])
+	assert.Equal(t, "joined", msg["event"])
+	assert.Contains(t, msg["data"], `"player_id":1`)
+	resp.Body.Close()
+
+	// 断线期间的事件在重连时按 Last-Event-ID 补发，并保留原始序号。
+	st := room.seatByToken(host.PlayerId, token)
+	assert.Eventually(t, func() bool { return !st.connected() }, time.Second, 10*time.Millisecond)
+	st.broadcast(newEventMessage(&model.GameEvent{Type: model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED}))
+	req, _ := http.NewRequest(http.MethodGet, ts.URL+"/events?session_token="+token, nil)
+	req.Header.Set("Last-Event-ID", "1")
+	resp, err = http.DefaultClient.Do(req)
+	if !assert.NoError(t, err) {
+		return
+	}
+	body := bufio.NewReader(resp.Body)
+	msg = readSSE(t, body)
+	assert.Equal(t, "joined", msg["event"])
+	assert.Contains(t, msg["data"], `"resumed":true`)
+	msg = readSSE(t, body)
+	assert.Equal(t, "event", msg["event"])
+	assert.Contains(t, msg["data"], "GAME_EVENT_TYPE_DAY_ADVANCED")
+	assert.NotEqual(t, "1", msg["id"])
+	resp.Body.Close()
+
+	// 操作通过 HTTP POST 提交，与 WebSocket 使用相同的验证。
+	post := func(body string) int {
+		resp, err := http.Post(ts.URL+"/submit_action", "application/json", strings.NewReader(body))
+		if !assert.NoError(t, err) {
+			return 0
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusUnauthorized, post(`{"session_token":"bogus"}`))
+	assert.Equal(t, http.StatusBadRequest, post(`{"session_token":"`+token+`"}`), "empty action")
+	assert.Equal(t, http.StatusBadRequest, post(`{"session_token":"`+token+`","action":{"pass_turn":{}}}`), "game has not started")
+
+	resp, err = http.Get(ts.URL + "/events")
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound 表示存储中没有请求的记录。
+var ErrNotFound = errors.New("not found")
+
+// Store 持久化房间元数据和席位、游戏状态快照、事件日志和最终结果，使游戏可以在服务器重启后继续。
+// 实现必须可以被多个 goroutine 同时调用。读取方法在记录不存在时返回 ErrNotFound。
+type Store interface {
+	// SaveRoom 保存或替换房间记录。
+	SaveRoom(ctx context.Context, record *model.RoomRecord) error
+	// LoadRoom 读取房间记录。
+	LoadRoom(ctx context.Context, gameID string) (*model.RoomRecord, error)
+	// ListRooms 返回所有房间记录，按游戏 ID 排序。
+	ListRooms(ctx context.Context) ([]*model.RoomRecord, error)
+
+	// SaveSnapshot 保存或替换房间的游戏状态快照。
+	SaveSnapshot(ctx context.Context, gameID string, state *model.GameState) error
+	// LoadSnapshot 读取房间最近一次保存的游戏状态快照。
+	LoadSnapshot(ctx context.Context, gameID string) (*model.GameState, error)
+
+	// AppendEvents 将未经过滤的事件追加到房间的事件日志。
+	AppendEvents(ctx context.Context, gameID string, events ...*model.GameEvent) error
+	// LoadEvents 按追加顺序读取房间的完整事件日志；没有事件时返回空切片。
+	LoadEvents(ctx context.Context, gameID string) ([]*model.GameEvent, error)
+
+	// SaveResult 保存游戏的最终结果。
+	SaveResult(ctx context.Context, result *model.GameResult) error
+	// LoadResult 读取游戏的最终结果。
+	LoadResult(ctx context.Context, gameID string) (*model.GameResult, error)
+}
+
+// MemoryStore 是保存在内存中的 Store，主要用于测试。
+type MemoryStore struct {
+	mu        sync.RWMutex
+	rooms     map[string]*model.RoomRecord
+	snapshots map[string]*model.GameState
+	events    map[string][]*model.GameEvent
+	results   map[string]*model.GameResult
+}
+
+// NewMemoryStore 创建一个空的内存存储。
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		rooms:     make(map[string]*model.RoomRecord),
+		snapshots: make(map[string]*model.GameState),
+		events:    make(map[string][]*model.GameEvent),
+		results:   make(map[string]*model.GameResult),
+	}
+}
+
+// clone 深拷贝消息，使调用者和存储不会共享可变状态。
+func clone[M proto.Message](m M) M {
+	return proto.Clone(m).(M)
+}
+
+// SaveRoom 实现 Store。
+func (s *MemoryStore) SaveRoom(_ context.Context, record *model.RoomRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rooms[record.GetGameId()] = clone(record)
+	return nil
+}
+
+// LoadRoom 实现 Store。
+func (s *MemoryStore) LoadRoom(_ context.Context, gameID string) (*model.RoomRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	record, ok := s.rooms[gameID]
+	if !ok {
+		return nil, fmt.Errorf("room %s: %w", gameID, ErrNotFound)
+	}
+	return clone(record), nil
+}
+
+// ListRooms 实现 Store。
+func (s *MemoryStore) ListRooms(_ context.Context) ([]*model.RoomRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	records := make([]*model.RoomRecord, 0, len(s.rooms))
+	for _, record := range s.rooms {
+		records = append(records, clone(record))
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].GetGameId() < records[j].GetGameId() })
+	return records, nil
+}
+
+// SaveSnapshot 实现 Store。
+func (s *MemoryStore) SaveSnapshot(_ context.Context, gameID string, state *model.GameState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.snapshots[gameID] = clone(state)
+	return nil
+}
+
+// LoadSnapshot 实现 Store。
+func (s *MemoryStore) LoadSnapshot(_ context.Context, gameID string) (*model.GameState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	state, ok := s.snapshots[gameID]
+	if !ok {
+		return nil, fmt.Errorf("snapshot of %s: %w", gameID, ErrNotFound)
+	}
+	return clone(state), nil
+}
+
+// AppendEvents 实现 Store。
+func (s *MemoryStore) AppendEvents(_ context.Context, gameID string, events ...*model.GameEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, event := range events {
+		s.events[gameID] = append(s.events[gameID], clone(event))
+	}
+	return nil
+}
+
+// LoadEvents 实现 Store。
+func (s *MemoryStore) LoadEvents(_ context.Context, gameID string) ([]*model.GameEvent, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	events := make([]*model.GameEvent, 0, len(s.events[gameID]))
+	for _, event := range s.events[gameID] {
+		events = append(events, clone(event))
+	}
+	return events, nil
+}
+
+// SaveResult 实现 Store。
+func (s *MemoryStore) SaveResult(_ context.Context, result *model.GameResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[result.GetGameId()] = clone(result)
+	return nil
+}
+
+// LoadResult 实现 Store。
+func (s *MemoryStore) LoadResult(_ context.Context, gameID string) (*model.GameResult, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	result, ok := s.results[gameID]
+	if !ok {
+		return nil, fmt.Errorf("result of %s: %w", gameID, ErrNotFound)
+	}
+	return clone(result), nil
+}
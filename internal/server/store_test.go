@@ -0,0 +1,141 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/constellation39/tragedyLooper/internal/logger"
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestStores(t *testing.T) {
+	fsStore, err := NewFSStore(t.TempDir())
+	assert.NoError(t, err)
+	for name, store := range map[string]Store{"memory": NewMemoryStore(), "fs": fsStore} {
+		t.Run(name, func(t *testing.T) { testStore(t, store) })
+	}
+}
+
+func testStore(t *testing.T, store Store) {
+	ctx := context.Background()
+
+	_, err := store.LoadRoom(ctx, "g1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.LoadSnapshot(ctx, "g1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.LoadResult(ctx, "g1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	events, err := store.LoadEvents(ctx, "g1")
+	assert.NoError(t, err)
+	assert.Empty(t, events)
+
+	record := &model.RoomRecord{
+		GameId: "g1",
+		Lobby:  &model.LobbyState{GameId: "g1", State: model.RoomState_ROOM_STATE_RUNNING, ScriptId: "first_steps"},
+		Seats:  []*model.SeatRecord{{PlayerId: 1, Role: model.PlayerRole_PLAYER_ROLE_MASTERMIND, SessionToken: "token", UserId: "alice"}},
+	}
+	assert.NoError(t, store.SaveRoom(ctx, record))
+	assert.NoError(t, store.SaveRoom(ctx, &model.RoomRecord{GameId: "g0"}))
+	loaded, err := store.LoadRoom(ctx, "g1")
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(record, loaded))
+	records, err := store.ListRooms(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "g0", records[0].GameId)
+		assert.Equal(t, "g1", records[1].GameId)
+	}
+
+	state := &model.GameState{GameId: "g1", CurrentLoop: 2, DisconnectedPlayers: map[int32]bool{2: true}}
+	assert.NoError(t, store.SaveSnapshot(ctx, "g1", state))
+	state.CurrentLoop = 3 // 保存之后的修改不影响存储的快照
+	snapshot, err := store.LoadSnapshot(ctx, "g1")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), snapshot.CurrentLoop)
+	assert.True(t, snapshot.DisconnectedPlayers[2])
+
+	assert.NoError(t, store.AppendEvents(ctx, "g1", &model.GameEvent{Type: model.GameEventType_GAME_EVENT_TYPE_DAY_ADVANCED, Day: 2}))
+	assert.NoError(t, store.AppendEvents(ctx, "g1",
+		&model.GameEvent{Type: model.GameEventType_GAME_EVENT_TYPE_LOOP_RESET},
+		&model.GameEvent{Type: model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED}))
+	events, err = store.LoadEvents(ctx, "g1")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 3) {
+		assert.Equal(t, int32(2), events[0].Day)
+		assert.Equal(t, model.GameEventType_GAME_EVENT_TYPE_GAME_ENDED, events[2].Type)
+	}
+
+	result := &model.GameResult{GameId: "g1", Winner: model.PlayerRole_PLAYER_ROLE_PROTAGONIST, Reason: "Correctly guessed all roles"}
+	assert.NoError(t, store.SaveResult(ctx, result))
+	loadedResult, err := store.LoadResult(ctx, "g1")
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(result, loadedResult))
+}
+
+func TestFSStoreLayout(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFSStore(dir)
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	assert.NoError(t, store.AppendEvents(ctx, "g1", &model.GameEvent{}, &model.GameEvent{}))
+	data, err := os.ReadFile(filepath.Join(dir, "g1", eventsFile))
+	assert.NoError(t, err)
+	assert.Equal(t, "{}\n{}\n", string(data), "one JSON event per line")
+
+	for _, id := range []string{"", "..", "../escape", `a\b`} {
+		assert.Error(t, store.SaveRoom(ctx, &model.RoomRecord{GameId: id}), "game ID %q", id)
+	}
+}
+
+func TestLoadRooms(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+
+	srv := NewServer("", nil, logger.New())
+	srv.SetSeatTokenSecret([]byte("secret"))
+	srv.SetStore(store)
+	room := NewRoom("lobby", logger.New())
+	host, err := room.addLobbySeat("alice", model.PlayerRole_PLAYER_ROLE_PROTAGONIST, false)
+	assert.NoError(t, err)
+	token := srv.issueSeat(room, host.PlayerId, host.Role, &Identity{UserID: "alice"})
+	assert.NoError(t, srv.addRoom(room))
+
+	// 游戏进行中但剧本无法加载的房间不能恢复。
+	assert.NoError(t, store.SaveRoom(ctx, &model.RoomRecord{
+		GameId: "broken",
+		Lobby:  &model.LobbyState{GameId: "broken", State: model.RoomState_ROOM_STATE_RUNNING, ScriptId: "missing"},
+	}))
+	assert.NoError(t, store.SaveRoom(ctx, &model.RoomRecord{
+		GameId: "finished",
+		Lobby:  &model.LobbyState{GameId: "finished", State: model.RoomState_ROOM_STATE_FINISHED},
+	}))
+
+	// 重启后大厅中的房间被恢复，玩家可以凭原令牌重新入座。
+	restarted := NewServer(t.TempDir(), nil, logger.New())
+	restarted.SetSeatTokenSecret([]byte("secret"))
+	restarted.SetStore(store)
+	n, err := restarted.LoadRooms(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	found, st, err := restarted.findSeat(token)
+	assert.NoError(t, err)
+	assert.Equal(t, "lobby", found.GameId)
+	assert.Equal(t, "alice", st.userID)
+	assert.True(t, found.lobbySnapshot().Seats[0].Host)
+	next, err := found.addLobbySeat("bob", model.PlayerRole_PLAYER_ROLE_MASTERMIND, false)
+	assert.NoError(t, err)
+	assert.Equal(t, host.PlayerId+1, next.PlayerId, "player IDs continue after restart")
+
+	_, ok := restarted.getRoom("finished")
+	assert.False(t, ok)
+	broken, err := store.LoadRoom(ctx, "broken")
+	assert.NoError(t, err)
+	assert.Equal(t, model.RoomState_ROOM_STATE_ABANDONED, broken.Lobby.State)
+}
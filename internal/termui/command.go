@@ -0,0 +1,148 @@
+package termui
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+)
+
+// ActionHelp 描述 ParseAction 接受的命令。
+const ActionHelp = `Game actions:
+  play <hand #> [request=option ...]      play a card from your hand, optionally pre-choosing targets
+  ability <character #> <ability id> [request=option ...]
+                                          use a character's ability
+  guess <character #>=<role id> ...       guess the roles of characters
+  choose <n|option id>                    answer the pending choice
+  pass                                    pass your turn
+`
+
+// ErrNotAction 表示输入的命令不是游戏操作，调用者可以按自己的命令处理。
+var ErrNotAction = errors.New("not a game action")
+
+// ParseAction 将一行输入解析为游戏操作。view 用于将手牌序号转换为卡牌 ID，choice 是玩家待回应的选择请求（可以为 nil）。
+// 第一个词不是操作命令时返回 ErrNotAction。
+func ParseAction(line string, view *model.PlayerView, choice *model.ChoiceRequiredEvent) (*model.PlayerActionPayload, error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil, ErrNotAction
+	}
+	args := fields[1:]
+	switch fields[0] {
+	case "play":
+		if len(args) < 1 {
+			return nil, errors.New("usage: play <hand #> [request=option ...]")
+		}
+		n, err := strconv.Atoi(args[0])
+		hand := view.GetYourHand()
+		if err != nil || n < 1 || n > len(hand) {
+			return nil, fmt.Errorf("no card #%s in your hand", args[0])
+		}
+		options, err := parseOptions(args[1:])
+		if err != nil {
+			return nil, err
+		}
+		return &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PlayCard{PlayCard: &model.PlayCardPayload{
+			CardId:           hand[n-1].GetConfig().GetId(),
+			PreChosenOptions: options,
+		}}}, nil
+
+	case "ability":
+		if len(args) < 2 {
+			return nil, errors.New("usage: ability <character #> <ability id> [request=option ...]")
+		}
+		charID, err1 := parseID(args[0])
+		abilityID, err2 := parseID(args[1])
+		if err := errors.Join(err1, err2); err != nil {
+			return nil, err
+		}
+		if _, ok := view.GetCharacters()[charID]; !ok {
+			return nil, fmt.Errorf("no character #%d", charID)
+		}
+		options, err := parseOptions(args[2:])
+		if err != nil {
+			return nil, err
+		}
+		return &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_UseAbility{UseAbility: &model.UseAbilityPayload{
+			CharacterId:      charID,
+			AbilityId:        abilityID,
+			PreChosenOptions: options,
+		}}}, nil
+
+	case "guess":
+		if len(args) == 0 {
+			return nil, errors.New("usage: guess <character #>=<role id> ...")
+		}
+		guesses := make(map[int32]int32, len(args))
+		for _, arg := range args {
+			char, role, ok := strings.Cut(arg, "=")
+			charID, err1 := parseID(char)
+			roleID, err2 := parseID(role)
+			if !ok || err1 != nil || err2 != nil {
+				return nil, fmt.Errorf("invalid guess %q, want <character #>=<role id>", arg)
+			}
+			guesses[charID] = roleID
+		}
+		return &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_MakeGuess{MakeGuess: &model.MakeGuessPayload{GuessedRoles: guesses}}}, nil
+
+	case "choose":
+		if choice == nil {
+			return nil, errors.New("there is no pending choice")
+		}
+		if len(args) != 1 {
+			return nil, errors.New("usage: choose <n|option id>")
+		}
+		option, err := pickOption(choice, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_ChooseOption{ChooseOption: &model.ChooseOptionPayload{
+			RequestId:      choice.GetRequestId(),
+			ChosenOptionId: option.GetId(),
+		}}}, nil
+
+	case "pass":
+		return &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PassTurn{PassTurn: &model.PassTurnAction{}}}, nil
+	}
+	return nil, ErrNotAction
+}
+
+// pickOption 按序号（从 1 开始）或选项 ID 查找选项。
+func pickOption(choice *model.ChoiceRequiredEvent, arg string) (*model.Choice, error) {
+	choices := choice.GetChoices()
+	if n, err := strconv.Atoi(arg); err == nil && n >= 1 && n <= len(choices) {
+		return choices[n-1], nil
+	}
+	for _, c := range choices {
+		if c.GetId() == arg {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("no option %q in choice %s", arg, choice.GetRequestId())
+}
+
+// parseOptions 解析 request=option 形式的预选项。
+func parseOptions(args []string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	options := make(map[string]string, len(args))
+	for _, arg := range args {
+		request, option, ok := strings.Cut(arg, "=")
+		if !ok || request == "" || option == "" {
+			return nil, fmt.Errorf("invalid pre-chosen option %q, want request=option", arg)
+		}
+		options[request] = option
+	}
+	return options, nil
+}
+
+func parseID(s string) (int32, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(s, "#"), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid id %q", s)
+	}
+	return int32(id), nil
+}
@@ -0,0 +1,275 @@
+// Package termui 在终端中显示玩家视图，并把玩家输入的命令解析为游戏操作。
+// 终端客户端（cmd/tlclient）和本地热座模式共用此包，前者通过 WebSocket 提交操作，后者直接提交给游戏引擎。
+package termui
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// locations 是棋盘上地点的显示顺序。
+var locations = []model.LocationType{
+	model.LocationType_LOCATION_TYPE_HOSPITAL,
+	model.LocationType_LOCATION_TYPE_SHRINE,
+	model.LocationType_LOCATION_TYPE_CITY,
+	model.LocationType_LOCATION_TYPE_SCHOOL,
+}
+
+// EnumName 返回去掉类型前缀的小写枚举名，例如 LOCATION_TYPE_SHRINE 显示为 shrine。
+func EnumName(e protoreflect.Enum) string {
+	desc := e.Descriptor()
+	value := desc.Values().ByNumber(e.Number())
+	if value == nil {
+		return fmt.Sprint(e.Number())
+	}
+	// 枚举值以枚举类型名的大写蛇形形式为前缀，例如 LocationType 的前缀为 LOCATION_TYPE_。
+	name := string(value.Name())
+	if i := commonPrefixLen(desc.Values()); i > 0 && i < len(name) {
+		name = name[i:]
+	}
+	return strings.ToLower(name)
+}
+
+// commonPrefixLen 返回所有枚举值名称共同前缀的长度，截断到最后一个下划线之后。
+func commonPrefixLen(values protoreflect.EnumValueDescriptors) int {
+	if values.Len() < 2 {
+		return 0
+	}
+	prefix := string(values.Get(0).Name())
+	for i := 1; i < values.Len(); i++ {
+		name := string(values.Get(i).Name())
+		for !strings.HasPrefix(name, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return strings.LastIndex(prefix, "_") + 1
+}
+
+// RenderView 将玩家视图写入 w：阶段、棋盘（按地点列出角色及其属性）、玩家、事件日程和手牌。
+func RenderView(w io.Writer, view *model.PlayerView, playerID int32) {
+	sheet := view.GetPublicSheet()
+	fmt.Fprintf(w, "=== %s | loop %d/%d, day %d", sheet.GetTitle(), view.GetCurrentLoop(), view.GetLoopCount(), view.GetCurrentDay())
+	if days := sheet.GetDaysPerLoop(); days > 0 {
+		fmt.Fprintf(w, "/%d", days)
+	}
+	fmt.Fprintf(w, " | phase %s", EnumName(view.GetCurrentPhase()))
+	if view.GetPaused() {
+		fmt.Fprint(w, " | PAUSED")
+	}
+	fmt.Fprintln(w, " ===")
+
+	byLocation := make(map[model.LocationType][]*model.PlayerViewCharacter)
+	for _, c := range view.GetCharacters() {
+		byLocation[c.GetCurrentLocation()] = append(byLocation[c.GetCurrentLocation()], c)
+	}
+	for _, loc := range append(slices.Clone(locations), model.LocationType_LOCATION_TYPE_UNSPECIFIED) {
+		chars := byLocation[loc]
+		if len(chars) == 0 && loc == model.LocationType_LOCATION_TYPE_UNSPECIFIED {
+			continue
+		}
+		slices.SortFunc(chars, func(a, b *model.PlayerViewCharacter) int { return int(a.GetId() - b.GetId()) })
+		fmt.Fprintf(w, "[%s]\n", EnumName(loc))
+		for _, c := range chars {
+			fmt.Fprintf(w, "  #%-3d %s\n", c.GetId(), describeCharacter(c))
+		}
+	}
+
+	fmt.Fprint(w, "Players:")
+	for _, id := range sortedKeys(view.GetPlayers()) {
+		p := view.GetPlayers()[id]
+		fmt.Fprintf(w, " %s(%s, %d cards", p.GetName(), EnumName(p.GetRole()), p.GetHandSize())
+		if p.GetDisconnected() {
+			fmt.Fprint(w, ", disconnected")
+		}
+		if id == playerID {
+			fmt.Fprint(w, ", you")
+		}
+		fmt.Fprint(w, ")")
+	}
+	fmt.Fprintln(w)
+
+	if incidents := view.GetIncidents(); len(incidents) > 0 {
+		fmt.Fprint(w, "Incidents:")
+		for _, inc := range incidents {
+			fmt.Fprintf(w, " day %d %s", inc.GetDay(), inc.GetName())
+			if inc.GetCulprit() != "" {
+				fmt.Fprintf(w, " (culprit %s)", inc.GetCulprit())
+			}
+			if inc.GetTriggered() {
+				fmt.Fprint(w, " [triggered]")
+			}
+			fmt.Fprint(w, ";")
+		}
+		fmt.Fprintln(w)
+	}
+
+	if played := view.GetPlayedCards(); len(played) > 0 {
+		fmt.Fprint(w, "Played today:")
+		for _, pc := range played {
+			name := "face down"
+			if !pc.GetFaceDown() {
+				name = pc.GetCard().GetConfig().GetName()
+			}
+			fmt.Fprintf(w, " %s by %s;", name, playerName(view, pc.GetPlayerId()))
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w, "Hand:")
+	for i, card := range view.GetYourHand() {
+		cfg := card.GetConfig()
+		fmt.Fprintf(w, "  %d. %s (card %d)", i+1, cfg.GetName(), cfg.GetId())
+		if card.GetUsedThisLoop() {
+			fmt.Fprint(w, " [used this loop]")
+		}
+		if cfg.GetDescription() != "" {
+			fmt.Fprintf(w, " - %s", cfg.GetDescription())
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// describeCharacter 返回角色的一行描述：名称、属性、状态和可见的身份。
+func describeCharacter(c *model.PlayerViewCharacter) string {
+	var b strings.Builder
+	b.WriteString(c.GetName())
+	for _, stat := range sortedKeys(c.GetStats()) {
+		fmt.Fprintf(&b, " %s=%d", EnumName(model.StatType(stat)), c.GetStats()[stat])
+	}
+	if !c.GetIsAlive() {
+		b.WriteString(" [dead]")
+	}
+	if c.GetInPanicMode() {
+		b.WriteString(" [panic]")
+	}
+	if c.GetRoleName() != "" {
+		fmt.Fprintf(&b, " role=%s(%d)", c.GetRoleName(), c.GetRoleId())
+	}
+	if len(c.GetTraits()) > 0 {
+		fmt.Fprintf(&b, " traits=%s", strings.Join(c.GetTraits(), ","))
+	}
+	return b.String()
+}
+
+// FormatEvent 返回事件的一行描述，尽量用视图中的名称代替 ID。
+func FormatEvent(event *model.GameEvent, view *model.PlayerView) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[loop %d day %d] %s", event.GetLoop(), event.GetDay(), EnumName(event.GetType()))
+	switch p := event.GetPayload().GetPayload().(type) {
+	case *model.EventPayload_ChatMessage:
+		name := p.ChatMessage.GetPlayerName()
+		if name == "" {
+			name = playerName(view, p.ChatMessage.GetPlayerId())
+		}
+		if p.ChatMessage.GetChannel() == model.ChatChannel_CHAT_CHANNEL_PROTAGONISTS {
+			name += " (protagonists)"
+		}
+		fmt.Fprintf(&b, " %s: %s", name, p.ChatMessage.GetText())
+	case *model.EventPayload_CardPlayed:
+		name := p.CardPlayed.GetCard().GetConfig().GetName()
+		if name == "" {
+			name = "a face-down card"
+		}
+		fmt.Fprintf(&b, " %s played %s", playerName(view, p.CardPlayed.GetPlayerId()), name)
+	case *model.EventPayload_ChoiceRequired:
+		fmt.Fprintf(&b, " %s must choose (%s)", playerName(view, p.ChoiceRequired.GetPlayerId()), p.ChoiceRequired.GetRequestId())
+	case nil:
+	default:
+		if text := strings.TrimSpace(prototext.MarshalOptions{}.Format(event.GetPayload())); text != "" {
+			fmt.Fprintf(&b, " %s", text)
+		}
+	}
+	return b.String()
+}
+
+// RenderChoice 列出选择请求的选项，玩家用 choose <序号> 回应。
+func RenderChoice(w io.Writer, choice *model.ChoiceRequiredEvent) {
+	fmt.Fprintf(w, "Choice %s:\n", choice.GetRequestId())
+	for i, c := range choice.GetChoices() {
+		fmt.Fprintf(w, "  %d. %s (%s)\n", i+1, c.GetDescription(), c.GetId())
+	}
+}
+
+// cardTargetRequest 是卡牌目标在 PlayCardPayload.PreChosenOptions 中的键，与 phasehandler.CardTargetRequestID 相同。
+const cardTargetRequest = "target"
+
+// RenderHints 显示轮到玩家时可以输入的操作，操作来自视图的 legal_actions。choice 是玩家待回应的选择请求（可以为 nil）。
+func RenderHints(w io.Writer, view *model.PlayerView, choice *model.ChoiceRequiredEvent) {
+	if choice != nil {
+		RenderChoice(w, choice)
+		fmt.Fprintln(w, "> choose <n>")
+		return
+	}
+	legal := view.GetLegalActions()
+	if len(legal) == 0 {
+		return
+	}
+
+	// 同一张卡牌的不同目标合并为一行。
+	targets := make(map[int32][]string)
+	for _, action := range legal {
+		if play := action.GetPlayCard(); play != nil {
+			targets[play.GetCardId()] = append(targets[play.GetCardId()], play.GetPreChosenOptions()[cardTargetRequest])
+		}
+	}
+
+	fmt.Fprintln(w, "> your turn:")
+	for _, action := range legal {
+		switch a := action.GetPayload().(type) {
+		case *model.PlayerActionPayload_PlayCard:
+			id := a.PlayCard.GetCardId()
+			cardTargets, ok := targets[id]
+			if !ok {
+				continue // 已经显示过
+			}
+			delete(targets, id)
+			n := slices.IndexFunc(view.GetYourHand(), func(c *model.Card) bool { return c.GetConfig().GetId() == id })
+			if n < 0 {
+				continue
+			}
+			fmt.Fprintf(w, "  play %d %s=<target>  # %s; targets: %s\n", n+1, cardTargetRequest,
+				view.GetYourHand()[n].GetConfig().GetName(), strings.Join(cardTargets, " "))
+		case *model.PlayerActionPayload_UseAbility:
+			charID, abilityID := a.UseAbility.GetCharacterId(), a.UseAbility.GetAbilityId()
+			c := view.GetCharacters()[charID]
+			name := fmt.Sprintf("ability %d", abilityID)
+			for _, ability := range c.GetAbilities() {
+				if ability.GetConfig().GetId() == abilityID {
+					name = ability.GetConfig().GetName()
+				}
+			}
+			fmt.Fprintf(w, "  ability %d %d  # %s: %s\n", charID, abilityID, c.GetName(), name)
+		case *model.PlayerActionPayload_MakeGuess:
+			fmt.Fprintln(w, "  guess <character #>=<role id> ...")
+		case *model.PlayerActionPayload_ChooseOption:
+			fmt.Fprintf(w, "  choose %s\n", a.ChooseOption.GetChosenOptionId())
+		case *model.PlayerActionPayload_PassTurn:
+			fmt.Fprintln(w, "  pass")
+		}
+	}
+}
+
+// playerName 返回玩家名称，视图中没有该玩家时返回 ID。
+func playerName(view *model.PlayerView, playerID int32) string {
+	if p, ok := view.GetPlayers()[playerID]; ok && p.GetName() != "" {
+		return p.GetName()
+	}
+	return fmt.Sprintf("player %d", playerID)
+}
+
+// sortedKeys 返回按升序排列的键。
+func sortedKeys[V any](m map[int32]V) []int32 {
+	keys := make([]int32, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
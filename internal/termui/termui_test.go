@@ -0,0 +1,108 @@
+package termui
+
+import (
+	"strings"
+	"testing"
+
+	model "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testView() *model.PlayerView {
+	return &model.PlayerView{
+		CurrentLoop:  1,
+		CurrentDay:   2,
+		LoopCount:    3,
+		CurrentPhase: model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY,
+		PublicSheet:  &model.PublicScriptSheet{Title: "First Steps", DaysPerLoop: 4},
+		Characters: map[int32]*model.PlayerViewCharacter{
+			1: {Id: 1, Name: "Boy Student", CurrentLocation: model.LocationType_LOCATION_TYPE_SCHOOL, IsAlive: true,
+				Stats: map[int32]int32{int32(model.StatType_STAT_TYPE_PARANOIA): 2}},
+			2: {Id: 2, Name: "Shrine Maiden", CurrentLocation: model.LocationType_LOCATION_TYPE_SHRINE, IsAlive: true},
+		},
+		Players: map[int32]*model.PlayerViewPlayer{
+			1: {Id: 1, Name: "alice", Role: model.PlayerRole_PLAYER_ROLE_MASTERMIND, HandSize: 3},
+			2: {Id: 2, Name: "bob", Role: model.PlayerRole_PLAYER_ROLE_PROTAGONIST, HandSize: 2},
+		},
+		YourHand: []*model.Card{
+			{Config: &model.CardConfig{Id: 11, Name: "Goodwill +1"}},
+			{Config: &model.CardConfig{Id: 12, Name: "Movement Horizontal"}},
+		},
+	}
+}
+
+func playCard(cardID int32, target string) *model.PlayerActionPayload {
+	return &model.PlayerActionPayload{Payload: &model.PlayerActionPayload_PlayCard{PlayCard: &model.PlayCardPayload{
+		CardId: cardID, PreChosenOptions: map[string]string{"target": target},
+	}}}
+}
+
+func TestEnumName(t *testing.T) {
+	assert.Equal(t, "shrine", EnumName(model.LocationType_LOCATION_TYPE_SHRINE))
+	assert.Equal(t, "protagonist_card_play", EnumName(model.GamePhase_GAME_PHASE_PROTAGONIST_CARD_PLAY))
+	assert.Equal(t, "mastermind", EnumName(model.PlayerRole_PLAYER_ROLE_MASTERMIND))
+	assert.Equal(t, "99", EnumName(model.StatType(99)))
+}
+
+func TestRenderView(t *testing.T) {
+	var b strings.Builder
+	view := testView()
+	RenderView(&b, view, 2)
+	RenderHints(&b, view, nil)
+	assert.NotContains(t, b.String(), "your turn", "no legal actions, not the player's turn")
+
+	view.LegalActions = []*model.PlayerActionPayload{
+		playCard(12, "target_char_1"),
+		playCard(12, "target_char_2"),
+		{Payload: &model.PlayerActionPayload_PassTurn{PassTurn: &model.PassTurnAction{}}},
+	}
+	RenderHints(&b, view, nil)
+	out := b.String()
+	assert.Contains(t, out, "First Steps | loop 1/3, day 2/4 | phase protagonist_card_play")
+	assert.Less(t, strings.Index(out, "[shrine]"), strings.Index(out, "Shrine Maiden"))
+	assert.Contains(t, out, "#1   Boy Student paranoia=2")
+	assert.Contains(t, out, "bob(protagonist, 2 cards, you)")
+	assert.Contains(t, out, "2. Movement Horizontal (card 12)")
+	assert.Contains(t, out, "> your turn:\n  play 2 target=<target>  # Movement Horizontal; targets: target_char_1 target_char_2\n  pass\n")
+}
+
+func TestParseAction(t *testing.T) {
+	view := testView()
+
+	action, err := ParseAction("play 2 target=char_1", view, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), action.GetPlayCard().GetCardId())
+	assert.Equal(t, map[string]string{"target": "char_1"}, action.GetPlayCard().GetPreChosenOptions())
+
+	action, err = ParseAction("ability 1 7", view, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), action.GetUseAbility().GetCharacterId())
+	assert.Equal(t, int32(7), action.GetUseAbility().GetAbilityId())
+
+	action, err = ParseAction("guess 1=3 #2=4", view, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[int32]int32{1: 3, 2: 4}, action.GetMakeGuess().GetGuessedRoles())
+
+	choice := &model.ChoiceRequiredEvent{RequestId: "card_22_target", Choices: []*model.Choice{{Id: "a"}, {Id: "b"}}}
+	action, err = ParseAction("choose 2", view, choice)
+	require.NoError(t, err)
+	assert.Equal(t, "card_22_target", action.GetChooseOption().GetRequestId())
+	assert.Equal(t, "b", action.GetChooseOption().GetChosenOptionId())
+	action, err = ParseAction("choose a", view, choice)
+	require.NoError(t, err)
+	assert.Equal(t, "a", action.GetChooseOption().GetChosenOptionId())
+
+	action, err = ParseAction("pass", view, nil)
+	require.NoError(t, err)
+	assert.NotNil(t, action.GetPassTurn())
+
+	for _, line := range []string{"play 3", "play x", "ability 9 1", "guess 1", "choose 1", "play 1 target"} {
+		_, err := ParseAction(line, view, nil)
+		assert.Error(t, err, line)
+		assert.NotErrorIs(t, err, ErrNotAction, line)
+	}
+	_, err = ParseAction("dance", view, nil)
+	assert.ErrorIs(t, err, ErrNotAction)
+}
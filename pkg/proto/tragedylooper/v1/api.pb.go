@@ -0,0 +1,449 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tragedylooper/v1/api.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RoomSummary 是房间的公开概要，不包含任何隐藏信息。
+type RoomSummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GameId          string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                             // 房间的游戏 ID
+	State           RoomState              `protobuf:"varint,2,opt,name=state,proto3,enum=tragedylooper.v1.RoomState" json:"state,omitempty"`            // 房间状态
+	ScriptId        string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`                       // 所选剧本
+	ScriptName      string                 `protobuf:"bytes,4,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`                 // 剧本名称
+	ModelId         int32                  `protobuf:"varint,5,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`                         // 所选剧本模型
+	ModelTitle      string                 `protobuf:"bytes,6,opt,name=model_title,json=modelTitle,proto3" json:"model_title,omitempty"`                 // 剧本模型标题
+	Seats           []*LobbySeat           `protobuf:"bytes,7,rep,name=seats,proto3" json:"seats,omitempty"`                                             // 座位，按玩家 ID 排列
+	MaxProtagonists int32                  `protobuf:"varint,8,opt,name=max_protagonists,json=maxProtagonists,proto3" json:"max_protagonists,omitempty"` // 主角座位的数量上限
+	Spectators      int32                  `protobuf:"varint,9,opt,name=spectators,proto3" json:"spectators,omitempty"`                                  // 当前旁观者数量
+	Phase           GamePhase              `protobuf:"varint,10,opt,name=phase,proto3,enum=tragedylooper.v1.GamePhase" json:"phase,omitempty"`           // 当前阶段；游戏尚未开始或已不在内存中时为 UNSPECIFIED
+	CurrentLoop     int32                  `protobuf:"varint,11,opt,name=current_loop,json=currentLoop,proto3" json:"current_loop,omitempty"`            // 当前循环
+	CurrentDay      int32                  `protobuf:"varint,12,opt,name=current_day,json=currentDay,proto3" json:"current_day,omitempty"`               // 当前天数
+	LoopCount       int32                  `protobuf:"varint,13,opt,name=loop_count,json=loopCount,proto3" json:"loop_count,omitempty"`                  // 循环总数
+	Result          *GameResult            `protobuf:"bytes,14,opt,name=result,proto3" json:"result,omitempty"`                                          // 游戏结果；仅在游戏结束后设置
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RoomSummary) Reset() {
+	*x = RoomSummary{}
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomSummary) ProtoMessage() {}
+
+func (x *RoomSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomSummary.ProtoReflect.Descriptor instead.
+func (*RoomSummary) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomSummary) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RoomSummary) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+func (x *RoomSummary) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *RoomSummary) GetScriptName() string {
+	if x != nil {
+		return x.ScriptName
+	}
+	return ""
+}
+
+func (x *RoomSummary) GetModelId() int32 {
+	if x != nil {
+		return x.ModelId
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetModelTitle() string {
+	if x != nil {
+		return x.ModelTitle
+	}
+	return ""
+}
+
+func (x *RoomSummary) GetSeats() []*LobbySeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *RoomSummary) GetMaxProtagonists() int32 {
+	if x != nil {
+		return x.MaxProtagonists
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetPhase() GamePhase {
+	if x != nil {
+		return x.Phase
+	}
+	return GamePhase_GAME_PHASE_UNSPECIFIED
+}
+
+func (x *RoomSummary) GetCurrentLoop() int32 {
+	if x != nil {
+		return x.CurrentLoop
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetCurrentDay() int32 {
+	if x != nil {
+		return x.CurrentDay
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetLoopCount() int32 {
+	if x != nil {
+		return x.LoopCount
+	}
+	return 0
+}
+
+func (x *RoomSummary) GetResult() *GameResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// ListRoomsResponse 是房间列表。
+type ListRoomsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rooms         []*RoomSummary         `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"` // 房间概要，按游戏 ID 排列
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsResponse) ProtoMessage() {}
+
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRoomsResponse) GetRooms() []*RoomSummary {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// EventLog 是一局已结束游戏的完整事件日志，包含所有隐藏信息。
+type EventLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // 房间的游戏 ID
+	Events        []*GameEvent           `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`               // 按发生顺序排列的事件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventLog) Reset() {
+	*x = EventLog{}
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventLog) ProtoMessage() {}
+
+func (x *EventLog) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventLog.ProtoReflect.Descriptor instead.
+func (*EventLog) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventLog) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *EventLog) GetEvents() []*GameEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Replay 是一局已结束游戏的回放文件，包含还原整局游戏所需的全部信息。
+type Replay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FormatVersion int32                  `protobuf:"varint,1,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"` // 回放文件格式版本，当前为 1
+	Summary       *RoomSummary           `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`                                   // 房间概要和游戏结果
+	Events        []*GameEvent           `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`                                     // 按发生顺序排列的完整事件日志
+	FinalState    *GameState             `protobuf:"bytes,4,opt,name=final_state,json=finalState,proto3" json:"final_state,omitempty"`           // 游戏结束时的完整游戏状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Replay) Reset() {
+	*x = Replay{}
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Replay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Replay) ProtoMessage() {}
+
+func (x *Replay) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Replay.ProtoReflect.Descriptor instead.
+func (*Replay) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Replay) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *Replay) GetSummary() *RoomSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+func (x *Replay) GetEvents() []*GameEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Replay) GetFinalState() *GameState {
+	if x != nil {
+		return x.FinalState
+	}
+	return nil
+}
+
+var File_tragedylooper_v1_api_proto protoreflect.FileDescriptor
+
+const file_tragedylooper_v1_api_proto_rawDesc = "" +
+	"\n" +
+	"\x1atragedylooper/v1/api.proto\x12\x10tragedylooper.v1\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1ctragedylooper/v1/event.proto\x1a\x1btragedylooper/v1/game.proto\x1a\x1ftragedylooper/v1/protocol.proto\x1a\x1etragedylooper/v1/storage.proto\"\x9d\x04\n" +
+	"\vRoomSummary\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x121\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1b.tragedylooper.v1.RoomStateR\x05state\x12\x1b\n" +
+	"\tscript_id\x18\x03 \x01(\tR\bscriptId\x12\x1f\n" +
+	"\vscript_name\x18\x04 \x01(\tR\n" +
+	"scriptName\x12\x19\n" +
+	"\bmodel_id\x18\x05 \x01(\x05R\amodelId\x12\x1f\n" +
+	"\vmodel_title\x18\x06 \x01(\tR\n" +
+	"modelTitle\x121\n" +
+	"\x05seats\x18\a \x03(\v2\x1b.tragedylooper.v1.LobbySeatR\x05seats\x12)\n" +
+	"\x10max_protagonists\x18\b \x01(\x05R\x0fmaxProtagonists\x12\x1e\n" +
+	"\n" +
+	"spectators\x18\t \x01(\x05R\n" +
+	"spectators\x121\n" +
+	"\x05phase\x18\n" +
+	" \x01(\x0e2\x1b.tragedylooper.v1.GamePhaseR\x05phase\x12!\n" +
+	"\fcurrent_loop\x18\v \x01(\x05R\vcurrentLoop\x12\x1f\n" +
+	"\vcurrent_day\x18\f \x01(\x05R\n" +
+	"currentDay\x12\x1d\n" +
+	"\n" +
+	"loop_count\x18\r \x01(\x05R\tloopCount\x124\n" +
+	"\x06result\x18\x0e \x01(\v2\x1c.tragedylooper.v1.GameResultR\x06result\"H\n" +
+	"\x11ListRoomsResponse\x123\n" +
+	"\x05rooms\x18\x01 \x03(\v2\x1d.tragedylooper.v1.RoomSummaryR\x05rooms\"X\n" +
+	"\bEventLog\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x123\n" +
+	"\x06events\x18\x02 \x03(\v2\x1b.tragedylooper.v1.GameEventR\x06events\"\xdb\x01\n" +
+	"\x06Replay\x12%\n" +
+	"\x0eformat_version\x18\x01 \x01(\x05R\rformatVersion\x127\n" +
+	"\asummary\x18\x02 \x01(\v2\x1d.tragedylooper.v1.RoomSummaryR\asummary\x123\n" +
+	"\x06events\x18\x03 \x03(\v2\x1b.tragedylooper.v1.GameEventR\x06events\x12<\n" +
+	"\vfinal_state\x18\x04 \x01(\v2\x1b.tragedylooper.v1.GameStateR\n" +
+	"finalStateB\xb8\x01\n" +
+	"\x14com.tragedylooper.v1B\bApiProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
+
+var (
+	file_tragedylooper_v1_api_proto_rawDescOnce sync.Once
+	file_tragedylooper_v1_api_proto_rawDescData []byte
+)
+
+func file_tragedylooper_v1_api_proto_rawDescGZIP() []byte {
+	file_tragedylooper_v1_api_proto_rawDescOnce.Do(func() {
+		file_tragedylooper_v1_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_api_proto_rawDesc), len(file_tragedylooper_v1_api_proto_rawDesc)))
+	})
+	return file_tragedylooper_v1_api_proto_rawDescData
+}
+
+var file_tragedylooper_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_tragedylooper_v1_api_proto_goTypes = []any{
+	(*RoomSummary)(nil),       // 0: tragedylooper.v1.RoomSummary
+	(*ListRoomsResponse)(nil), // 1: tragedylooper.v1.ListRoomsResponse
+	(*EventLog)(nil),          // 2: tragedylooper.v1.EventLog
+	(*Replay)(nil),            // 3: tragedylooper.v1.Replay
+	(RoomState)(0),            // 4: tragedylooper.v1.RoomState
+	(*LobbySeat)(nil),         // 5: tragedylooper.v1.LobbySeat
+	(GamePhase)(0),            // 6: tragedylooper.v1.GamePhase
+	(*GameResult)(nil),        // 7: tragedylooper.v1.GameResult
+	(*GameEvent)(nil),         // 8: tragedylooper.v1.GameEvent
+	(*GameState)(nil),         // 9: tragedylooper.v1.GameState
+}
+var file_tragedylooper_v1_api_proto_depIdxs = []int32{
+	4, // 0: tragedylooper.v1.RoomSummary.state:type_name -> tragedylooper.v1.RoomState
+	5, // 1: tragedylooper.v1.RoomSummary.seats:type_name -> tragedylooper.v1.LobbySeat
+	6, // 2: tragedylooper.v1.RoomSummary.phase:type_name -> tragedylooper.v1.GamePhase
+	7, // 3: tragedylooper.v1.RoomSummary.result:type_name -> tragedylooper.v1.GameResult
+	0, // 4: tragedylooper.v1.ListRoomsResponse.rooms:type_name -> tragedylooper.v1.RoomSummary
+	8, // 5: tragedylooper.v1.EventLog.events:type_name -> tragedylooper.v1.GameEvent
+	0, // 6: tragedylooper.v1.Replay.summary:type_name -> tragedylooper.v1.RoomSummary
+	8, // 7: tragedylooper.v1.Replay.events:type_name -> tragedylooper.v1.GameEvent
+	9, // 8: tragedylooper.v1.Replay.final_state:type_name -> tragedylooper.v1.GameState
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_tragedylooper_v1_api_proto_init() }
+func file_tragedylooper_v1_api_proto_init() {
+	if File_tragedylooper_v1_api_proto != nil {
+		return
+	}
+	file_tragedylooper_v1_enums_proto_init()
+	file_tragedylooper_v1_event_proto_init()
+	file_tragedylooper_v1_game_proto_init()
+	file_tragedylooper_v1_protocol_proto_init()
+	file_tragedylooper_v1_storage_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_api_proto_rawDesc), len(file_tragedylooper_v1_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tragedylooper_v1_api_proto_goTypes,
+		DependencyIndexes: file_tragedylooper_v1_api_proto_depIdxs,
+		MessageInfos:      file_tragedylooper_v1_api_proto_msgTypes,
+	}.Build()
+	File_tragedylooper_v1_api_proto = out.File
+	file_tragedylooper_v1_api_proto_goTypes = nil
+	file_tragedylooper_v1_api_proto_depIdxs = nil
+}
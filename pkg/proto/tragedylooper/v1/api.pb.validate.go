@@ -0,0 +1,685 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: tragedylooper/v1/api.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RoomSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoomSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoomSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoomSummaryMultiError, or
+// nil if none found.
+func (m *RoomSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoomSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for State
+
+	// no validation rules for ScriptId
+
+	// no validation rules for ScriptName
+
+	// no validation rules for ModelId
+
+	// no validation rules for ModelTitle
+
+	for idx, item := range m.GetSeats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoomSummaryValidationError{
+						field:  fmt.Sprintf("Seats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoomSummaryValidationError{
+						field:  fmt.Sprintf("Seats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoomSummaryValidationError{
+					field:  fmt.Sprintf("Seats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MaxProtagonists
+
+	// no validation rules for Spectators
+
+	// no validation rules for Phase
+
+	// no validation rules for CurrentLoop
+
+	// no validation rules for CurrentDay
+
+	// no validation rules for LoopCount
+
+	if all {
+		switch v := interface{}(m.GetResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomSummaryValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomSummaryValidationError{
+					field:  "Result",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomSummaryValidationError{
+				field:  "Result",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoomSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomSummaryMultiError is an error wrapping multiple validation errors
+// returned by RoomSummary.ValidateAll() if the designated constraints aren't met.
+type RoomSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomSummaryMultiError) AllErrors() []error { return m }
+
+// RoomSummaryValidationError is the validation error returned by
+// RoomSummary.Validate if the designated constraints aren't met.
+type RoomSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomSummaryValidationError) ErrorName() string { return "RoomSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoomSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomSummaryValidationError{}
+
+// Validate checks the field values on ListRoomsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRoomsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoomsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoomsResponseMultiError, or nil if none found.
+func (m *ListRoomsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoomsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRooms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRoomsResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRoomsResponseValidationError{
+						field:  fmt.Sprintf("Rooms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRoomsResponseValidationError{
+					field:  fmt.Sprintf("Rooms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListRoomsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoomsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListRoomsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListRoomsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoomsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoomsResponseMultiError) AllErrors() []error { return m }
+
+// ListRoomsResponseValidationError is the validation error returned by
+// ListRoomsResponse.Validate if the designated constraints aren't met.
+type ListRoomsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoomsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoomsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoomsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoomsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoomsResponseValidationError) ErrorName() string {
+	return "ListRoomsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListRoomsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoomsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoomsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoomsResponseValidationError{}
+
+// Validate checks the field values on EventLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventLogMultiError, or nil
+// if none found.
+func (m *EventLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventLogValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventLogValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventLogValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EventLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventLogMultiError is an error wrapping multiple validation errors returned
+// by EventLog.ValidateAll() if the designated constraints aren't met.
+type EventLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventLogMultiError) AllErrors() []error { return m }
+
+// EventLogValidationError is the validation error returned by
+// EventLog.Validate if the designated constraints aren't met.
+type EventLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventLogValidationError) ErrorName() string { return "EventLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventLogValidationError{}
+
+// Validate checks the field values on Replay with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Replay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Replay with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReplayMultiError, or nil if none found.
+func (m *Replay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Replay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FormatVersion
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplayValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplayValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplayValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplayValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplayValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplayValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetFinalState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplayValidationError{
+					field:  "FinalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplayValidationError{
+					field:  "FinalState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFinalState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplayValidationError{
+				field:  "FinalState",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReplayMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayMultiError is an error wrapping multiple validation errors returned by
+// Replay.ValidateAll() if the designated constraints aren't met.
+type ReplayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayMultiError) AllErrors() []error { return m }
+
+// ReplayValidationError is the validation error returned by Replay.Validate if
+// the designated constraints aren't met.
+type ReplayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayValidationError) ErrorName() string { return "ReplayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReplayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayValidationError{}
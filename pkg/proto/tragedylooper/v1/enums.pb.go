@@ -517,6 +517,7 @@ const (
 	GameEventType_GAME_EVENT_TYPE_CARD_REVEALED         GameEventType = 22 // 卡牌揭示事件
 	GameEventType_GAME_EVENT_TYPE_GAME_ENDED            GameEventType = 23 // 游戏结束事件
 	GameEventType_GAME_EVENT_TYPE_PLAYER_ACTION         GameEventType = 24 // 玩家行动事件
+	GameEventType_GAME_EVENT_TYPE_CHAT_MESSAGE          GameEventType = 25 // 聊天消息事件
 )
 
 // Enum value maps for GameEventType.
@@ -547,6 +548,7 @@ var (
 		22: "GAME_EVENT_TYPE_CARD_REVEALED",
 		23: "GAME_EVENT_TYPE_GAME_ENDED",
 		24: "GAME_EVENT_TYPE_PLAYER_ACTION",
+		25: "GAME_EVENT_TYPE_CHAT_MESSAGE",
 	}
 	GameEventType_value = map[string]int32{
 		"GAME_EVENT_TYPE_UNSPECIFIED":           0,
@@ -574,6 +576,7 @@ var (
 		"GAME_EVENT_TYPE_CARD_REVEALED":         22,
 		"GAME_EVENT_TYPE_GAME_ENDED":            23,
 		"GAME_EVENT_TYPE_PLAYER_ACTION":         24,
+		"GAME_EVENT_TYPE_CHAT_MESSAGE":          25,
 	}
 )
 
@@ -604,6 +607,56 @@ func (GameEventType) EnumDescriptor() ([]byte, []int) {
 	return file_tragedylooper_v1_enums_proto_rawDescGZIP(), []int{7}
 }
 
+// ChatChannel 定义了聊天消息的频道。
+type ChatChannel int32
+
+const (
+	ChatChannel_CHAT_CHANNEL_UNSPECIFIED  ChatChannel = 0 // 未指定
+	ChatChannel_CHAT_CHANNEL_ALL          ChatChannel = 1 // 所有玩家可见，包括主谋
+	ChatChannel_CHAT_CHANNEL_PROTAGONISTS ChatChannel = 2 // 仅主角可见
+)
+
+// Enum value maps for ChatChannel.
+var (
+	ChatChannel_name = map[int32]string{
+		0: "CHAT_CHANNEL_UNSPECIFIED",
+		1: "CHAT_CHANNEL_ALL",
+		2: "CHAT_CHANNEL_PROTAGONISTS",
+	}
+	ChatChannel_value = map[string]int32{
+		"CHAT_CHANNEL_UNSPECIFIED":  0,
+		"CHAT_CHANNEL_ALL":          1,
+		"CHAT_CHANNEL_PROTAGONISTS": 2,
+	}
+)
+
+func (x ChatChannel) Enum() *ChatChannel {
+	p := new(ChatChannel)
+	*p = x
+	return p
+}
+
+func (x ChatChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChatChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_tragedylooper_v1_enums_proto_enumTypes[8].Descriptor()
+}
+
+func (ChatChannel) Type() protoreflect.EnumType {
+	return &file_tragedylooper_v1_enums_proto_enumTypes[8]
+}
+
+func (x ChatChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChatChannel.Descriptor instead.
+func (ChatChannel) EnumDescriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_enums_proto_rawDescGZIP(), []int{8}
+}
+
 // StatType 定义了角色属性的类型。
 type StatType int32
 
@@ -641,11 +694,11 @@ func (x StatType) String() string {
 }
 
 func (StatType) Descriptor() protoreflect.EnumDescriptor {
-	return file_tragedylooper_v1_enums_proto_enumTypes[8].Descriptor()
+	return file_tragedylooper_v1_enums_proto_enumTypes[9].Descriptor()
 }
 
 func (StatType) Type() protoreflect.EnumType {
-	return &file_tragedylooper_v1_enums_proto_enumTypes[8]
+	return &file_tragedylooper_v1_enums_proto_enumTypes[9]
 }
 
 func (x StatType) Number() protoreflect.EnumNumber {
@@ -654,7 +707,7 @@ func (x StatType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use StatType.Descriptor instead.
 func (StatType) EnumDescriptor() ([]byte, []int) {
-	return file_tragedylooper_v1_enums_proto_rawDescGZIP(), []int{8}
+	return file_tragedylooper_v1_enums_proto_rawDescGZIP(), []int{9}
 }
 
 // GoodwillRuleType 定义了如何处理角色的好感度。
@@ -694,11 +747,11 @@ func (x GoodwillRuleType) String() string {
 }
 
 func (GoodwillRuleType) Descriptor() protoreflect.EnumDescriptor {
-	return file_tragedylooper_v1_enums_proto_enumTypes[9].Descriptor()
+	return file_tragedylooper_v1_enums_proto_enumTypes[10].Descriptor()
 }
 
 func (GoodwillRuleType) Type() protoreflect.EnumType {
-	return &file_tragedylooper_v1_enums_proto_enumTypes[9]
+	return &file_tragedylooper_v1_enums_proto_enumTypes[10]
 }
 
 func (x GoodwillRuleType) Number() protoreflect.EnumNumber {
@@ -707,7 +760,7 @@ func (x GoodwillRuleType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use GoodwillRuleType.Descriptor instead.
 func (GoodwillRuleType) EnumDescriptor() ([]byte, []int) {
-	return file_tragedylooper_v1_enums_proto_rawDescGZIP(), []int{9}
+	return file_tragedylooper_v1_enums_proto_rawDescGZIP(), []int{10}
 }
 
 var File_tragedylooper_v1_enums_proto protoreflect.FileDescriptor
@@ -785,7 +838,7 @@ const file_tragedylooper_v1_enums_proto_rawDesc = "" +
 	"\x12\x1c\n" +
 	"\x18TRIGGER_TYPE_ON_LOOP_END\x10\v\x12\x17\n" +
 	"\x13ABILITY_TYPE_ACTIVE\x10\f\x12\x18\n" +
-	"\x14ABILITY_TYPE_PASSIVE\x10\r*\x8e\a\n" +
+	"\x14ABILITY_TYPE_PASSIVE\x10\r*\xb0\a\n" +
 	"\rGameEventType\x12\x1f\n" +
 	"\x1bGAME_EVENT_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fGAME_EVENT_TYPE_CHARACTER_MOVED\x10\x01\x12%\n" +
@@ -812,7 +865,12 @@ const file_tragedylooper_v1_enums_proto_rawDesc = "" +
 	"\x1bGAME_EVENT_TYPE_CARD_PLAYED\x10\x15\x12!\n" +
 	"\x1dGAME_EVENT_TYPE_CARD_REVEALED\x10\x16\x12\x1e\n" +
 	"\x1aGAME_EVENT_TYPE_GAME_ENDED\x10\x17\x12!\n" +
-	"\x1dGAME_EVENT_TYPE_PLAYER_ACTION\x10\x18*m\n" +
+	"\x1dGAME_EVENT_TYPE_PLAYER_ACTION\x10\x18\x12 \n" +
+	"\x1cGAME_EVENT_TYPE_CHAT_MESSAGE\x10\x19*`\n" +
+	"\vChatChannel\x12\x1c\n" +
+	"\x18CHAT_CHANNEL_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10CHAT_CHANNEL_ALL\x10\x01\x12\x1d\n" +
+	"\x19CHAT_CHANNEL_PROTAGONISTS\x10\x02*m\n" +
 	"\bStatType\x12\x19\n" +
 	"\x15STAT_TYPE_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12STAT_TYPE_PARANOIA\x10\x01\x12\x16\n" +
@@ -837,7 +895,7 @@ func file_tragedylooper_v1_enums_proto_rawDescGZIP() []byte {
 	return file_tragedylooper_v1_enums_proto_rawDescData
 }
 
-var file_tragedylooper_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_tragedylooper_v1_enums_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
 var file_tragedylooper_v1_enums_proto_goTypes = []any{
 	(PlayerRole)(0),       // 0: tragedylooper.v1.PlayerRole
 	(GamePhase)(0),        // 1: tragedylooper.v1.GamePhase
@@ -847,8 +905,9 @@ var file_tragedylooper_v1_enums_proto_goTypes = []any{
 	(LocationType)(0),     // 5: tragedylooper.v1.LocationType
 	(TriggerType)(0),      // 6: tragedylooper.v1.TriggerType
 	(GameEventType)(0),    // 7: tragedylooper.v1.GameEventType
-	(StatType)(0),         // 8: tragedylooper.v1.StatType
-	(GoodwillRuleType)(0), // 9: tragedylooper.v1.GoodwillRuleType
+	(ChatChannel)(0),      // 8: tragedylooper.v1.ChatChannel
+	(StatType)(0),         // 9: tragedylooper.v1.StatType
+	(GoodwillRuleType)(0), // 10: tragedylooper.v1.GoodwillRuleType
 }
 var file_tragedylooper_v1_enums_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -868,7 +927,7 @@ func file_tragedylooper_v1_enums_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_enums_proto_rawDesc), len(file_tragedylooper_v1_enums_proto_rawDesc)),
-			NumEnums:      10,
+			NumEnums:      11,
 			NumMessages:   0,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -29,6 +29,8 @@ type GameEvent struct {
 	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                            // 事件发生的时间戳
 	Payload       *EventPayload          `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 	Cause         *Cause                 `protobuf:"bytes,4,opt,name=cause,proto3,oneof" json:"cause,omitempty"` // 事件的起因
+	Loop          int32                  `protobuf:"varint,5,opt,name=loop,proto3" json:"loop,omitempty"`        // 事件发生时的循环数
+	Day           int32                  `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`          // 事件发生时的天数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,6 +93,20 @@ func (x *GameEvent) GetCause() *Cause {
 	return nil
 }
 
+func (x *GameEvent) GetLoop() int32 {
+	if x != nil {
+		return x.Loop
+	}
+	return 0
+}
+
+func (x *GameEvent) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
 // Cause 定义了事件的来源。
 type Cause struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -209,6 +225,8 @@ type EventPayload struct {
 	//	*EventPayload_TragedyTriggered
 	//	*EventPayload_TraitAdjusted
 	//	*EventPayload_PlayerActionTaken
+	//	*EventPayload_RoleRevealed
+	//	*EventPayload_ChatMessage
 	Payload       isEventPayload_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -386,6 +404,24 @@ func (x *EventPayload) GetPlayerActionTaken() *PlayerActionTakenEvent {
 	return nil
 }
 
+func (x *EventPayload) GetRoleRevealed() *RoleRevealedEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventPayload_RoleRevealed); ok {
+			return x.RoleRevealed
+		}
+	}
+	return nil
+}
+
+func (x *EventPayload) GetChatMessage() *ChatMessageEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*EventPayload_ChatMessage); ok {
+			return x.ChatMessage
+		}
+	}
+	return nil
+}
+
 type isEventPayload_Payload interface {
 	isEventPayload_Payload()
 }
@@ -450,6 +486,14 @@ type EventPayload_PlayerActionTaken struct {
 	PlayerActionTaken *PlayerActionTakenEvent `protobuf:"bytes,18,opt,name=player_action_taken,json=playerActionTaken,proto3,oneof"`
 }
 
+type EventPayload_RoleRevealed struct {
+	RoleRevealed *RoleRevealedEvent `protobuf:"bytes,19,opt,name=role_revealed,json=roleRevealed,proto3,oneof"`
+}
+
+type EventPayload_ChatMessage struct {
+	ChatMessage *ChatMessageEvent `protobuf:"bytes,20,opt,name=chat_message,json=chatMessage,proto3,oneof"`
+}
+
 func (*EventPayload_CharacterMoved) isEventPayload_Payload() {}
 
 func (*EventPayload_StatAdjusted) isEventPayload_Payload() {}
@@ -480,6 +524,10 @@ func (*EventPayload_TraitAdjusted) isEventPayload_Payload() {}
 
 func (*EventPayload_PlayerActionTaken) isEventPayload_Payload() {}
 
+func (*EventPayload_RoleRevealed) isEventPayload_Payload() {}
+
+func (*EventPayload_ChatMessage) isEventPayload_Payload() {}
+
 // 角色移动事件
 type CharacterMovedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1110,10 +1158,11 @@ func (x *ChoiceRequiredEvent) GetChoices() []*Choice {
 
 // 事件触发事件
 type IncidentTriggeredEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Incident      *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"` // 被触发的事件详情
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Incident           *Incident              `protobuf:"bytes,1,opt,name=incident,proto3" json:"incident,omitempty"`                                                  // 被触发的事件详情
+	CulpritCharacterId int32                  `protobuf:"varint,2,opt,name=culprit_character_id,json=culpritCharacterId,proto3" json:"culprit_character_id,omitempty"` // 事件的罪魁祸首，仅主谋可见
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *IncidentTriggeredEvent) Reset() {
@@ -1153,6 +1202,66 @@ func (x *IncidentTriggeredEvent) GetIncident() *Incident {
 	return nil
 }
 
+func (x *IncidentTriggeredEvent) GetCulpritCharacterId() int32 {
+	if x != nil {
+		return x.CulpritCharacterId
+	}
+	return 0
+}
+
+// 角色身份揭示事件
+type RoleRevealedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharacterId   int32                  `protobuf:"varint,1,opt,name=character_id,json=characterId,proto3" json:"character_id,omitempty"` // 被揭示身份的角色ID
+	RoleId        int32                  `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`                // 被揭示的身份ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleRevealedEvent) Reset() {
+	*x = RoleRevealedEvent{}
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleRevealedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleRevealedEvent) ProtoMessage() {}
+
+func (x *RoleRevealedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleRevealedEvent.ProtoReflect.Descriptor instead.
+func (*RoleRevealedEvent) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_event_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RoleRevealedEvent) GetCharacterId() int32 {
+	if x != nil {
+		return x.CharacterId
+	}
+	return 0
+}
+
+func (x *RoleRevealedEvent) GetRoleId() int32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
 // 悲剧触发事件
 type TragedyTriggeredEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1163,7 +1272,7 @@ type TragedyTriggeredEvent struct {
 
 func (x *TragedyTriggeredEvent) Reset() {
 	*x = TragedyTriggeredEvent{}
-	mi := &file_tragedylooper_v1_event_proto_msgTypes[16]
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1284,7 @@ func (x *TragedyTriggeredEvent) String() string {
 func (*TragedyTriggeredEvent) ProtoMessage() {}
 
 func (x *TragedyTriggeredEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_tragedylooper_v1_event_proto_msgTypes[16]
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1297,7 @@ func (x *TragedyTriggeredEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TragedyTriggeredEvent.ProtoReflect.Descriptor instead.
 func (*TragedyTriggeredEvent) Descriptor() ([]byte, []int) {
-	return file_tragedylooper_v1_event_proto_rawDescGZIP(), []int{16}
+	return file_tragedylooper_v1_event_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TragedyTriggeredEvent) GetTragedyId() int32 {
@@ -1208,7 +1317,7 @@ type PlayerActionTakenEvent struct {
 
 func (x *PlayerActionTakenEvent) Reset() {
 	*x = PlayerActionTakenEvent{}
-	mi := &file_tragedylooper_v1_event_proto_msgTypes[17]
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1220,7 +1329,7 @@ func (x *PlayerActionTakenEvent) String() string {
 func (*PlayerActionTakenEvent) ProtoMessage() {}
 
 func (x *PlayerActionTakenEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_tragedylooper_v1_event_proto_msgTypes[17]
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,7 +1342,7 @@ func (x *PlayerActionTakenEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerActionTakenEvent.ProtoReflect.Descriptor instead.
 func (*PlayerActionTakenEvent) Descriptor() ([]byte, []int) {
-	return file_tragedylooper_v1_event_proto_rawDescGZIP(), []int{17}
+	return file_tragedylooper_v1_event_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PlayerActionTakenEvent) GetPlayerId() int32 {
@@ -1250,16 +1359,87 @@ func (x *PlayerActionTakenEvent) GetAction() *PlayerActionPayload {
 	return nil
 }
 
+// ChatMessageEvent 是玩家发送的一条聊天消息。
+type ChatMessageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                 // 发送者的玩家ID
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`            // 发送者的玩家名称
+	Channel       ChatChannel            `protobuf:"varint,3,opt,name=channel,proto3,enum=tragedylooper.v1.ChatChannel" json:"channel,omitempty"` // 消息所在的频道
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                          // 消息内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatMessageEvent) Reset() {
+	*x = ChatMessageEvent{}
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatMessageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessageEvent) ProtoMessage() {}
+
+func (x *ChatMessageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_event_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessageEvent.ProtoReflect.Descriptor instead.
+func (*ChatMessageEvent) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_event_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChatMessageEvent) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ChatMessageEvent) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *ChatMessageEvent) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_UNSPECIFIED
+}
+
+func (x *ChatMessageEvent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 var File_tragedylooper_v1_event_proto protoreflect.FileDescriptor
 
 const file_tragedylooper_v1_event_proto_rawDesc = "" +
 	"\n" +
-	"\x1ctragedylooper/v1/event.proto\x12\x10tragedylooper.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1btragedylooper/v1/card.proto\x1a\x1ftragedylooper/v1/incident.proto\x1a\x1etragedylooper/v1/payload.proto\x1a\x1dtragedylooper/v1/common.proto\"\xf2\x01\n" +
+	"\x1ctragedylooper/v1/event.proto\x12\x10tragedylooper.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1btragedylooper/v1/card.proto\x1a\x1ftragedylooper/v1/incident.proto\x1a\x1etragedylooper/v1/payload.proto\x1a\x1dtragedylooper/v1/common.proto\"\x98\x02\n" +
 	"\tGameEvent\x123\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1f.tragedylooper.v1.GameEventTypeR\x04type\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x128\n" +
 	"\apayload\x18\x03 \x01(\v2\x1e.tragedylooper.v1.EventPayloadR\apayload\x122\n" +
-	"\x05cause\x18\x04 \x01(\v2\x17.tragedylooper.v1.CauseH\x00R\x05cause\x88\x01\x01B\b\n" +
+	"\x05cause\x18\x04 \x01(\v2\x17.tragedylooper.v1.CauseH\x00R\x05cause\x88\x01\x01\x12\x12\n" +
+	"\x04loop\x18\x05 \x01(\x05R\x04loop\x12\x10\n" +
+	"\x03day\x18\x06 \x01(\x05R\x03dayB\b\n" +
 	"\x06_cause\"t\n" +
 	"\x05Cause\x12\x19\n" +
 	"\acard_id\x18\x01 \x01(\x05H\x00R\x06cardId\x12\x1f\n" +
@@ -1268,7 +1448,8 @@ const file_tragedylooper_v1_event_proto_rawDesc = "" +
 	"\vincident_id\x18\x03 \x01(\x05H\x00R\n" +
 	"incidentIdB\f\n" +
 	"\n" +
-	"cause_type\"\x8e\t\n" +
+	"cause_type\"\xa3\n" +
+	"\n" +
 	"\fEventPayload\x12P\n" +
 	"\x0fcharacter_moved\x18\x01 \x01(\v2%.tragedylooper.v1.CharacterMovedEventH\x00R\x0echaracterMoved\x12J\n" +
 	"\rstat_adjusted\x18\x02 \x01(\v2#.tragedylooper.v1.StatAdjustedEventH\x00R\fstatAdjusted\x12>\n" +
@@ -1288,7 +1469,9 @@ const file_tragedylooper_v1_event_proto_rawDesc = "" +
 	"\x12incident_triggered\x18\x0e \x01(\v2(.tragedylooper.v1.IncidentTriggeredEventH\x00R\x11incidentTriggered\x12V\n" +
 	"\x11tragedy_triggered\x18\x0f \x01(\v2'.tragedylooper.v1.TragedyTriggeredEventH\x00R\x10tragedyTriggered\x12M\n" +
 	"\x0etrait_adjusted\x18\x10 \x01(\v2$.tragedylooper.v1.TraitAdjustedEventH\x00R\rtraitAdjusted\x12Z\n" +
-	"\x13player_action_taken\x18\x12 \x01(\v2(.tragedylooper.v1.PlayerActionTakenEventH\x00R\x11playerActionTakenB\t\n" +
+	"\x13player_action_taken\x18\x12 \x01(\v2(.tragedylooper.v1.PlayerActionTakenEventH\x00R\x11playerActionTaken\x12J\n" +
+	"\rrole_revealed\x18\x13 \x01(\v2#.tragedylooper.v1.RoleRevealedEventH\x00R\froleRevealed\x12G\n" +
+	"\fchat_message\x18\x14 \x01(\v2\".tragedylooper.v1.ChatMessageEventH\x00R\vchatMessageB\t\n" +
 	"\apayload\"{\n" +
 	"\x13CharacterMovedEvent\x12!\n" +
 	"\fcharacter_id\x18\x01 \x01(\x05R\vcharacterId\x12A\n" +
@@ -1331,15 +1514,25 @@ const file_tragedylooper_v1_event_proto_rawDesc = "" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x122\n" +
-	"\achoices\x18\x03 \x03(\v2\x18.tragedylooper.v1.ChoiceR\achoices\"P\n" +
+	"\achoices\x18\x03 \x03(\v2\x18.tragedylooper.v1.ChoiceR\achoices\"\x82\x01\n" +
 	"\x16IncidentTriggeredEvent\x126\n" +
-	"\bincident\x18\x01 \x01(\v2\x1a.tragedylooper.v1.IncidentR\bincident\"6\n" +
+	"\bincident\x18\x01 \x01(\v2\x1a.tragedylooper.v1.IncidentR\bincident\x120\n" +
+	"\x14culprit_character_id\x18\x02 \x01(\x05R\x12culpritCharacterId\"O\n" +
+	"\x11RoleRevealedEvent\x12!\n" +
+	"\fcharacter_id\x18\x01 \x01(\x05R\vcharacterId\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\x05R\x06roleId\"6\n" +
 	"\x15TragedyTriggeredEvent\x12\x1d\n" +
 	"\n" +
 	"tragedy_id\x18\x01 \x01(\x05R\ttragedyId\"t\n" +
 	"\x16PlayerActionTakenEvent\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12=\n" +
-	"\x06action\x18\x02 \x01(\v2%.tragedylooper.v1.PlayerActionPayloadR\x06actionB\xba\x01\n" +
+	"\x06action\x18\x02 \x01(\v2%.tragedylooper.v1.PlayerActionPayloadR\x06action\"\x9d\x01\n" +
+	"\x10ChatMessageEvent\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x127\n" +
+	"\achannel\x18\x03 \x01(\x0e2\x1d.tragedylooper.v1.ChatChannelR\achannel\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04textB\xba\x01\n" +
 	"\x14com.tragedylooper.v1B\n" +
 	"EventProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
 
@@ -1355,7 +1548,7 @@ func file_tragedylooper_v1_event_proto_rawDescGZIP() []byte {
 	return file_tragedylooper_v1_event_proto_rawDescData
 }
 
-var file_tragedylooper_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_tragedylooper_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_tragedylooper_v1_event_proto_goTypes = []any{
 	(*GameEvent)(nil),              // 0: tragedylooper.v1.GameEvent
 	(*Cause)(nil),                  // 1: tragedylooper.v1.Cause
@@ -1373,23 +1566,26 @@ var file_tragedylooper_v1_event_proto_goTypes = []any{
 	(*GameEndedEvent)(nil),         // 13: tragedylooper.v1.GameEndedEvent
 	(*ChoiceRequiredEvent)(nil),    // 14: tragedylooper.v1.ChoiceRequiredEvent
 	(*IncidentTriggeredEvent)(nil), // 15: tragedylooper.v1.IncidentTriggeredEvent
-	(*TragedyTriggeredEvent)(nil),  // 16: tragedylooper.v1.TragedyTriggeredEvent
-	(*PlayerActionTakenEvent)(nil), // 17: tragedylooper.v1.PlayerActionTakenEvent
-	nil,                            // 18: tragedylooper.v1.CardRevealedEvent.CardsEntry
-	(GameEventType)(0),             // 19: tragedylooper.v1.GameEventType
-	(*timestamppb.Timestamp)(nil),  // 20: google.protobuf.Timestamp
-	(LocationType)(0),              // 21: tragedylooper.v1.LocationType
-	(StatType)(0),                  // 22: tragedylooper.v1.StatType
-	(*Card)(nil),                   // 23: tragedylooper.v1.Card
-	(PlayerRole)(0),                // 24: tragedylooper.v1.PlayerRole
-	(*Choice)(nil),                 // 25: tragedylooper.v1.Choice
-	(*Incident)(nil),               // 26: tragedylooper.v1.Incident
-	(*PlayerActionPayload)(nil),    // 27: tragedylooper.v1.PlayerActionPayload
-	(*CardList)(nil),               // 28: tragedylooper.v1.CardList
+	(*RoleRevealedEvent)(nil),      // 16: tragedylooper.v1.RoleRevealedEvent
+	(*TragedyTriggeredEvent)(nil),  // 17: tragedylooper.v1.TragedyTriggeredEvent
+	(*PlayerActionTakenEvent)(nil), // 18: tragedylooper.v1.PlayerActionTakenEvent
+	(*ChatMessageEvent)(nil),       // 19: tragedylooper.v1.ChatMessageEvent
+	nil,                            // 20: tragedylooper.v1.CardRevealedEvent.CardsEntry
+	(GameEventType)(0),             // 21: tragedylooper.v1.GameEventType
+	(*timestamppb.Timestamp)(nil),  // 22: google.protobuf.Timestamp
+	(LocationType)(0),              // 23: tragedylooper.v1.LocationType
+	(StatType)(0),                  // 24: tragedylooper.v1.StatType
+	(*Card)(nil),                   // 25: tragedylooper.v1.Card
+	(PlayerRole)(0),                // 26: tragedylooper.v1.PlayerRole
+	(*Choice)(nil),                 // 27: tragedylooper.v1.Choice
+	(*Incident)(nil),               // 28: tragedylooper.v1.Incident
+	(*PlayerActionPayload)(nil),    // 29: tragedylooper.v1.PlayerActionPayload
+	(ChatChannel)(0),               // 30: tragedylooper.v1.ChatChannel
+	(*CardList)(nil),               // 31: tragedylooper.v1.CardList
 }
 var file_tragedylooper_v1_event_proto_depIdxs = []int32{
-	19, // 0: tragedylooper.v1.GameEvent.type:type_name -> tragedylooper.v1.GameEventType
-	20, // 1: tragedylooper.v1.GameEvent.timestamp:type_name -> google.protobuf.Timestamp
+	21, // 0: tragedylooper.v1.GameEvent.type:type_name -> tragedylooper.v1.GameEventType
+	22, // 1: tragedylooper.v1.GameEvent.timestamp:type_name -> google.protobuf.Timestamp
 	2,  // 2: tragedylooper.v1.GameEvent.payload:type_name -> tragedylooper.v1.EventPayload
 	1,  // 3: tragedylooper.v1.GameEvent.cause:type_name -> tragedylooper.v1.Cause
 	3,  // 4: tragedylooper.v1.EventPayload.character_moved:type_name -> tragedylooper.v1.CharacterMovedEvent
@@ -1404,23 +1600,26 @@ var file_tragedylooper_v1_event_proto_depIdxs = []int32{
 	13, // 13: tragedylooper.v1.EventPayload.game_ended:type_name -> tragedylooper.v1.GameEndedEvent
 	14, // 14: tragedylooper.v1.EventPayload.choice_required:type_name -> tragedylooper.v1.ChoiceRequiredEvent
 	15, // 15: tragedylooper.v1.EventPayload.incident_triggered:type_name -> tragedylooper.v1.IncidentTriggeredEvent
-	16, // 16: tragedylooper.v1.EventPayload.tragedy_triggered:type_name -> tragedylooper.v1.TragedyTriggeredEvent
+	17, // 16: tragedylooper.v1.EventPayload.tragedy_triggered:type_name -> tragedylooper.v1.TragedyTriggeredEvent
 	5,  // 17: tragedylooper.v1.EventPayload.trait_adjusted:type_name -> tragedylooper.v1.TraitAdjustedEvent
-	17, // 18: tragedylooper.v1.EventPayload.player_action_taken:type_name -> tragedylooper.v1.PlayerActionTakenEvent
-	21, // 19: tragedylooper.v1.CharacterMovedEvent.new_location:type_name -> tragedylooper.v1.LocationType
-	22, // 20: tragedylooper.v1.StatAdjustedEvent.stat_type:type_name -> tragedylooper.v1.StatType
-	23, // 21: tragedylooper.v1.CardPlayedEvent.card:type_name -> tragedylooper.v1.Card
-	18, // 22: tragedylooper.v1.CardRevealedEvent.cards:type_name -> tragedylooper.v1.CardRevealedEvent.CardsEntry
-	24, // 23: tragedylooper.v1.GameEndedEvent.winner:type_name -> tragedylooper.v1.PlayerRole
-	25, // 24: tragedylooper.v1.ChoiceRequiredEvent.choices:type_name -> tragedylooper.v1.Choice
-	26, // 25: tragedylooper.v1.IncidentTriggeredEvent.incident:type_name -> tragedylooper.v1.Incident
-	27, // 26: tragedylooper.v1.PlayerActionTakenEvent.action:type_name -> tragedylooper.v1.PlayerActionPayload
-	28, // 27: tragedylooper.v1.CardRevealedEvent.CardsEntry.value:type_name -> tragedylooper.v1.CardList
-	28, // [28:28] is the sub-list for method output_type
-	28, // [28:28] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	18, // 18: tragedylooper.v1.EventPayload.player_action_taken:type_name -> tragedylooper.v1.PlayerActionTakenEvent
+	16, // 19: tragedylooper.v1.EventPayload.role_revealed:type_name -> tragedylooper.v1.RoleRevealedEvent
+	19, // 20: tragedylooper.v1.EventPayload.chat_message:type_name -> tragedylooper.v1.ChatMessageEvent
+	23, // 21: tragedylooper.v1.CharacterMovedEvent.new_location:type_name -> tragedylooper.v1.LocationType
+	24, // 22: tragedylooper.v1.StatAdjustedEvent.stat_type:type_name -> tragedylooper.v1.StatType
+	25, // 23: tragedylooper.v1.CardPlayedEvent.card:type_name -> tragedylooper.v1.Card
+	20, // 24: tragedylooper.v1.CardRevealedEvent.cards:type_name -> tragedylooper.v1.CardRevealedEvent.CardsEntry
+	26, // 25: tragedylooper.v1.GameEndedEvent.winner:type_name -> tragedylooper.v1.PlayerRole
+	27, // 26: tragedylooper.v1.ChoiceRequiredEvent.choices:type_name -> tragedylooper.v1.Choice
+	28, // 27: tragedylooper.v1.IncidentTriggeredEvent.incident:type_name -> tragedylooper.v1.Incident
+	29, // 28: tragedylooper.v1.PlayerActionTakenEvent.action:type_name -> tragedylooper.v1.PlayerActionPayload
+	30, // 29: tragedylooper.v1.ChatMessageEvent.channel:type_name -> tragedylooper.v1.ChatChannel
+	31, // 30: tragedylooper.v1.CardRevealedEvent.CardsEntry.value:type_name -> tragedylooper.v1.CardList
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_tragedylooper_v1_event_proto_init() }
@@ -1455,6 +1654,8 @@ func file_tragedylooper_v1_event_proto_init() {
 		(*EventPayload_TragedyTriggered)(nil),
 		(*EventPayload_TraitAdjusted)(nil),
 		(*EventPayload_PlayerActionTaken)(nil),
+		(*EventPayload_RoleRevealed)(nil),
+		(*EventPayload_ChatMessage)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1462,7 +1663,7 @@ func file_tragedylooper_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_event_proto_rawDesc), len(file_tragedylooper_v1_event_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
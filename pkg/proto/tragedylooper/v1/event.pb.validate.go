@@ -117,6 +117,10 @@ func (m *GameEvent) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Loop
+
+	// no validation rules for Day
+
 	if m.Cause != nil {
 
 		if all {
@@ -1004,6 +1008,88 @@ func (m *EventPayload) validate(all bool) error {
 			}
 		}
 
+	case *EventPayload_RoleRevealed:
+		if v == nil {
+			err := EventPayloadValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRoleRevealed()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventPayloadValidationError{
+						field:  "RoleRevealed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventPayloadValidationError{
+						field:  "RoleRevealed",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRoleRevealed()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventPayloadValidationError{
+					field:  "RoleRevealed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *EventPayload_ChatMessage:
+		if v == nil {
+			err := EventPayloadValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChatMessage()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventPayloadValidationError{
+						field:  "ChatMessage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventPayloadValidationError{
+						field:  "ChatMessage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChatMessage()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventPayloadValidationError{
+					field:  "ChatMessage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -2494,6 +2580,8 @@ func (m *IncidentTriggeredEvent) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for CulpritCharacterId
+
 	if len(errors) > 0 {
 		return IncidentTriggeredEventMultiError(errors)
 	}
@@ -2574,6 +2662,112 @@ var _ interface {
 	ErrorName() string
 } = IncidentTriggeredEventValidationError{}
 
+// Validate checks the field values on RoleRevealedEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RoleRevealedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleRevealedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RoleRevealedEventMultiError, or nil if none found.
+func (m *RoleRevealedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleRevealedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CharacterId
+
+	// no validation rules for RoleId
+
+	if len(errors) > 0 {
+		return RoleRevealedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleRevealedEventMultiError is an error wrapping multiple validation errors
+// returned by RoleRevealedEvent.ValidateAll() if the designated constraints
+// aren't met.
+type RoleRevealedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleRevealedEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleRevealedEventMultiError) AllErrors() []error { return m }
+
+// RoleRevealedEventValidationError is the validation error returned by
+// RoleRevealedEvent.Validate if the designated constraints aren't met.
+type RoleRevealedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleRevealedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleRevealedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleRevealedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleRevealedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleRevealedEventValidationError) ErrorName() string {
+	return "RoleRevealedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RoleRevealedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleRevealedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleRevealedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleRevealedEventValidationError{}
+
 // Validate checks the field values on TragedyTriggeredEvent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2810,3 +3004,111 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PlayerActionTakenEventValidationError{}
+
+// Validate checks the field values on ChatMessageEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChatMessageEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChatMessageEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChatMessageEventMultiError, or nil if none found.
+func (m *ChatMessageEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChatMessageEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlayerId
+
+	// no validation rules for PlayerName
+
+	// no validation rules for Channel
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return ChatMessageEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChatMessageEventMultiError is an error wrapping multiple validation errors
+// returned by ChatMessageEvent.ValidateAll() if the designated constraints
+// aren't met.
+type ChatMessageEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChatMessageEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChatMessageEventMultiError) AllErrors() []error { return m }
+
+// ChatMessageEventValidationError is the validation error returned by
+// ChatMessageEvent.Validate if the designated constraints aren't met.
+type ChatMessageEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChatMessageEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChatMessageEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChatMessageEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChatMessageEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChatMessageEventValidationError) ErrorName() string { return "ChatMessageEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ChatMessageEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChatMessageEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChatMessageEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChatMessageEventValidationError{}
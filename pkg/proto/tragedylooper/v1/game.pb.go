@@ -36,10 +36,18 @@ type GameState struct {
 	Players            map[int32]*Player      `protobuf:"bytes,8,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                                                  // 所有玩家的映射，以 player_id 为键。
 	TriggeredIncidents map[int32]bool         `protobuf:"bytes,9,rep,name=triggered_incidents,json=triggeredIncidents,proto3" json:"triggered_incidents,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 本循环中已触发的事件集合，以事件名称为键。
 	// LoopEvents
-	LoopEvents    []*GameEvent `protobuf:"bytes,10,rep,name=loop_events,json=loopEvents,proto3" json:"loop_events,omitempty"`
-	DayEvents     []*GameEvent `protobuf:"bytes,11,rep,name=day_events,json=dayEvents,proto3" json:"day_events,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	LoopEvents          []*GameEvent        `protobuf:"bytes,10,rep,name=loop_events,json=loopEvents,proto3" json:"loop_events,omitempty"`
+	DayEvents           []*GameEvent        `protobuf:"bytes,11,rep,name=day_events,json=dayEvents,proto3" json:"day_events,omitempty"`
+	LoopCount           int32               `protobuf:"varint,12,opt,name=loop_count,json=loopCount,proto3" json:"loop_count,omitempty"`                                                                                                              // 本局游戏的总循环数，由所选难度组合决定。
+	DifficultySet       *DifficultySet      `protobuf:"bytes,13,opt,name=difficulty_set,json=difficultySet,proto3" json:"difficulty_set,omitempty"`                                                                                                   // 创建房间时选定的难度组合（可能为空，表示使用剧本默认值）。
+	PlayedCardsThisDay  map[int32]*CardList `protobuf:"bytes,14,rep,name=played_cards_this_day,json=playedCardsThisDay,proto3" json:"played_cards_this_day,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`     // 本日已打出的卡牌，以 player_id 为键。揭示前仅持有者可见。
+	PlayedCardsThisLoop map[int32]bool      `protobuf:"bytes,15,rep,name=played_cards_this_loop,json=playedCardsThisLoop,proto3" json:"played_cards_this_loop,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 本循环中已打出过的卡牌 ID 集合。
+	RevealedRoles       map[int32]bool      `protobuf:"bytes,16,rep,name=revealed_roles,json=revealedRoles,proto3" json:"revealed_roles,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                       // 身份已公开的角色集合，以 character_id 为键。
+	DisconnectedPlayers map[int32]bool      `protobuf:"bytes,17,rep,name=disconnected_players,json=disconnectedPlayers,proto3" json:"disconnected_players,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`     // 当前断开连接的人类玩家集合，以 player_id 为键。
+	Paused              bool                `protobuf:"varint,18,opt,name=paused,proto3" json:"paused,omitempty"`                                                                                                                                     // 游戏是否因玩家断线而暂停。
+	PhaseTurn           int32               `protobuf:"varint,19,opt,name=phase_turn,json=phaseTurn,proto3" json:"phase_turn,omitempty"`                                                                                                              // 当前阶段中已完成的回合数：轮流行动的阶段中已行动的玩家数，或主谋已打出的卡牌数。进入阶段时清零，从快照恢复时保留阶段进度。
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GameState) Reset() {
@@ -149,6 +157,62 @@ func (x *GameState) GetDayEvents() []*GameEvent {
 	return nil
 }
 
+func (x *GameState) GetLoopCount() int32 {
+	if x != nil {
+		return x.LoopCount
+	}
+	return 0
+}
+
+func (x *GameState) GetDifficultySet() *DifficultySet {
+	if x != nil {
+		return x.DifficultySet
+	}
+	return nil
+}
+
+func (x *GameState) GetPlayedCardsThisDay() map[int32]*CardList {
+	if x != nil {
+		return x.PlayedCardsThisDay
+	}
+	return nil
+}
+
+func (x *GameState) GetPlayedCardsThisLoop() map[int32]bool {
+	if x != nil {
+		return x.PlayedCardsThisLoop
+	}
+	return nil
+}
+
+func (x *GameState) GetRevealedRoles() map[int32]bool {
+	if x != nil {
+		return x.RevealedRoles
+	}
+	return nil
+}
+
+func (x *GameState) GetDisconnectedPlayers() map[int32]bool {
+	if x != nil {
+		return x.DisconnectedPlayers
+	}
+	return nil
+}
+
+func (x *GameState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GameState) GetPhaseTurn() int32 {
+	if x != nil {
+		return x.PhaseTurn
+	}
+	return 0
+}
+
 // Player 表示游戏的参与者。
 type Player struct {
 	state              protoimpl.MessageState    `protogen:"open.v1"`
@@ -316,6 +380,15 @@ type PlayerView struct {
 	Players        map[int32]*PlayerViewPlayer    `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`       // 所有玩家的可见状态。
 	YourHand       []*Card                        `protobuf:"bytes,8,rep,name=your_hand,json=yourHand,proto3" json:"your_hand,omitempty"`                                                                // 接收此视图的玩家的手牌。
 	YourDeductions *PlayerDeductionKnowledge      `protobuf:"bytes,9,opt,name=your_deductions,json=yourDeductions,proto3" json:"your_deductions,omitempty"`                                              // 接收此视图的玩家的推理状态。
+	LoopCount      int32                          `protobuf:"varint,10,opt,name=loop_count,json=loopCount,proto3" json:"loop_count,omitempty"`                                                           // 本局游戏的总循环数。
+	LoopsRemaining int32                          `protobuf:"varint,11,opt,name=loops_remaining,json=loopsRemaining,proto3" json:"loops_remaining,omitempty"`                                            // 当前循环之后还剩余的循环数。
+	PublicEvents   []*GameEvent                   `protobuf:"bytes,12,rep,name=public_events,json=publicEvents,proto3" json:"public_events,omitempty"`                                                   // 接收此视图的玩家可见的事件历史（已按身份过滤），按发生顺序排列。
+	PublicSheet    *PublicScriptSheet             `protobuf:"bytes,13,opt,name=public_sheet,json=publicSheet,proto3" json:"public_sheet,omitempty"`                                                      // 公开剧本表，所有玩家可见。
+	PrivateSheet   *PrivateScriptSheet            `protobuf:"bytes,14,opt,name=private_sheet,json=privateSheet,proto3" json:"private_sheet,omitempty"`                                                   // 私有剧本表，仅主谋可见。
+	PlayedCards    []*PlayerViewPlayedCard        `protobuf:"bytes,15,rep,name=played_cards,json=playedCards,proto3" json:"played_cards,omitempty"`                                                      // 本日已打出的卡牌；揭示前对手只能看到背面。
+	Incidents      []*PlayerViewIncident          `protobuf:"bytes,16,rep,name=incidents,proto3" json:"incidents,omitempty"`                                                                             // 剧本中的预定事件；罪魁祸首仅主谋可见。
+	Paused         bool                           `protobuf:"varint,17,opt,name=paused,proto3" json:"paused,omitempty"`                                                                                  // 游戏是否因玩家断线而暂停。
+	LegalActions   []*PlayerActionPayload         `protobuf:"bytes,18,rep,name=legal_actions,json=legalActions,proto3" json:"legal_actions,omitempty"`                                                   // 接收此视图的玩家当前可以执行的操作模板，为空表示现在不轮到该玩家。
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -413,6 +486,69 @@ func (x *PlayerView) GetYourDeductions() *PlayerDeductionKnowledge {
 	return nil
 }
 
+func (x *PlayerView) GetLoopCount() int32 {
+	if x != nil {
+		return x.LoopCount
+	}
+	return 0
+}
+
+func (x *PlayerView) GetLoopsRemaining() int32 {
+	if x != nil {
+		return x.LoopsRemaining
+	}
+	return 0
+}
+
+func (x *PlayerView) GetPublicEvents() []*GameEvent {
+	if x != nil {
+		return x.PublicEvents
+	}
+	return nil
+}
+
+func (x *PlayerView) GetPublicSheet() *PublicScriptSheet {
+	if x != nil {
+		return x.PublicSheet
+	}
+	return nil
+}
+
+func (x *PlayerView) GetPrivateSheet() *PrivateScriptSheet {
+	if x != nil {
+		return x.PrivateSheet
+	}
+	return nil
+}
+
+func (x *PlayerView) GetPlayedCards() []*PlayerViewPlayedCard {
+	if x != nil {
+		return x.PlayedCards
+	}
+	return nil
+}
+
+func (x *PlayerView) GetIncidents() []*PlayerViewIncident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *PlayerView) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *PlayerView) GetLegalActions() []*PlayerActionPayload {
+	if x != nil {
+		return x.LegalActions
+	}
+	return nil
+}
+
 // PlayerViewCharacter 是用于客户端显示的角色清理版本。
 // 它省略了隐藏信息，例如真实角色（对于对手）。
 type PlayerViewCharacter struct {
@@ -427,6 +563,8 @@ type PlayerViewCharacter struct {
 	InPanicMode     bool                   `protobuf:"varint,10,opt,name=in_panic_mode,json=inPanicMode,proto3" json:"in_panic_mode,omitempty"`                                   // 角色是否处于恐慌模式。
 	Rules           []*CharacterRule       `protobuf:"bytes,11,rep,name=rules,proto3" json:"rules,omitempty"`                                                                     // 特殊规则列表。
 	RevealedRole    PlayerRole             `protobuf:"varint,12,opt,name=revealed_role,json=revealedRole,proto3,enum=tragedylooper.v1.PlayerRole" json:"revealed_role,omitempty"` // 如果角色身份已揭示，则为该身份，否则为 UNKNOWN。
+	RoleId          int32                  `protobuf:"varint,13,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`                                                    // 角色的隐藏身份 ID，仅主谋可见或身份已公开时填写。
+	RoleName        string                 `protobuf:"bytes,14,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`                                               // 角色的隐藏身份名称，可见性同 role_id。
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -531,6 +669,20 @@ func (x *PlayerViewCharacter) GetRevealedRole() PlayerRole {
 	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
 }
 
+func (x *PlayerViewCharacter) GetRoleId() int32 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *PlayerViewCharacter) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
 // PlayerViewPlayer 是用于客户端显示的玩家清理版本。
 // 它省略了私人信息，例如其他玩家的手牌。
 type PlayerViewPlayer struct {
@@ -538,6 +690,8 @@ type PlayerViewPlayer struct {
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // 玩家的唯一 ID。
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                   // 玩家的名称。
 	Role          PlayerRole             `protobuf:"varint,3,opt,name=role,proto3,enum=tragedylooper.v1.PlayerRole" json:"role,omitempty"` // 玩家的角色。
+	HandSize      int32                  `protobuf:"varint,4,opt,name=hand_size,json=handSize,proto3" json:"hand_size,omitempty"`          // 玩家手牌的数量。
+	Disconnected  bool                   `protobuf:"varint,5,opt,name=disconnected,proto3" json:"disconnected,omitempty"`                  // 玩家当前是否断开连接。
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -593,11 +747,275 @@ func (x *PlayerViewPlayer) GetRole() PlayerRole {
 	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
 }
 
+func (x *PlayerViewPlayer) GetHandSize() int32 {
+	if x != nil {
+		return x.HandSize
+	}
+	return 0
+}
+
+func (x *PlayerViewPlayer) GetDisconnected() bool {
+	if x != nil {
+		return x.Disconnected
+	}
+	return false
+}
+
+// PlayerViewPlayedCard 是视图中一张本日已打出的卡牌。
+type PlayerViewPlayedCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // 打出此牌的玩家。
+	Card          *Card                  `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`                          // 卡牌内容；对非持有者在揭示前为空。
+	FaceDown      bool                   `protobuf:"varint,3,opt,name=face_down,json=faceDown,proto3" json:"face_down,omitempty"` // 对接收此视图的玩家而言，此牌是否仍为背面朝上。
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerViewPlayedCard) Reset() {
+	*x = PlayerViewPlayedCard{}
+	mi := &file_tragedylooper_v1_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerViewPlayedCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerViewPlayedCard) ProtoMessage() {}
+
+func (x *PlayerViewPlayedCard) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_game_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerViewPlayedCard.ProtoReflect.Descriptor instead.
+func (*PlayerViewPlayedCard) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PlayerViewPlayedCard) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerViewPlayedCard) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *PlayerViewPlayedCard) GetFaceDown() bool {
+	if x != nil {
+		return x.FaceDown
+	}
+	return false
+}
+
+// PlayerViewIncident 是视图中的一个预定事件。
+type PlayerViewIncident struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`               // 事件 ID。
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`            // 事件名称。
+	Day           int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`             // 预定发生的日期。
+	Triggered     bool                   `protobuf:"varint,4,opt,name=triggered,proto3" json:"triggered,omitempty"` // 本循环中是否已触发。
+	Culprit       string                 `protobuf:"bytes,5,opt,name=culprit,proto3" json:"culprit,omitempty"`      // 罪魁祸首，仅主谋可见。
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerViewIncident) Reset() {
+	*x = PlayerViewIncident{}
+	mi := &file_tragedylooper_v1_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerViewIncident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerViewIncident) ProtoMessage() {}
+
+func (x *PlayerViewIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_game_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerViewIncident.ProtoReflect.Descriptor instead.
+func (*PlayerViewIncident) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PlayerViewIncident) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerViewIncident) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerViewIncident) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *PlayerViewIncident) GetTriggered() bool {
+	if x != nil {
+		return x.Triggered
+	}
+	return false
+}
+
+func (x *PlayerViewIncident) GetCulprit() string {
+	if x != nil {
+		return x.Culprit
+	}
+	return ""
+}
+
+// ViewDelta 是同一玩家两个版本的 PlayerView 之间的差异。
+// 客户端持有 base_version 版本的视图时，按以下规则应用差异即可得到 version 版本的视图：
+// changed_fields 中列出的字段取 changed 中的值（未设置表示清空），characters 和 players 中的条目
+// 整体替换或新增，removed_* 中的条目被删除，new_events 追加到 public_events 末尾。
+type ViewDelta struct {
+	state               protoimpl.MessageState         `protogen:"open.v1"`
+	BaseVersion         uint64                         `protobuf:"varint,1,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`                                                      // 差异所基于的视图版本。
+	Version             uint64                         `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`                                                                                 // 应用差异后的视图版本。
+	Changed             *PlayerView                    `protobuf:"bytes,3,opt,name=changed,proto3" json:"changed,omitempty"`                                                                                  // 发生变化的普通字段，只有 changed_fields 中列出的字段有意义。
+	ChangedFields       []string                       `protobuf:"bytes,4,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`                                                 // changed 中发生变化的字段名（proto 字段名），不包括 characters、players 和 public_events。
+	Characters          map[int32]*PlayerViewCharacter `protobuf:"bytes,5,rep,name=characters,proto3" json:"characters,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 新增或发生变化的角色，以 character_id 为键。
+	RemovedCharacterIds []int32                        `protobuf:"varint,6,rep,packed,name=removed_character_ids,json=removedCharacterIds,proto3" json:"removed_character_ids,omitempty"`                     // 不再可见的角色。
+	Players             map[int32]*PlayerViewPlayer    `protobuf:"bytes,7,rep,name=players,proto3" json:"players,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`       // 新增或发生变化的玩家，以 player_id 为键。
+	RemovedPlayerIds    []int32                        `protobuf:"varint,8,rep,packed,name=removed_player_ids,json=removedPlayerIds,proto3" json:"removed_player_ids,omitempty"`                              // 不再可见的玩家。
+	NewEvents           []*GameEvent                   `protobuf:"bytes,9,rep,name=new_events,json=newEvents,proto3" json:"new_events,omitempty"`                                                             // 追加到 public_events 末尾的事件。
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ViewDelta) Reset() {
+	*x = ViewDelta{}
+	mi := &file_tragedylooper_v1_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewDelta) ProtoMessage() {}
+
+func (x *ViewDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewDelta.ProtoReflect.Descriptor instead.
+func (*ViewDelta) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ViewDelta) GetBaseVersion() uint64 {
+	if x != nil {
+		return x.BaseVersion
+	}
+	return 0
+}
+
+func (x *ViewDelta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ViewDelta) GetChanged() *PlayerView {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *ViewDelta) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *ViewDelta) GetCharacters() map[int32]*PlayerViewCharacter {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+func (x *ViewDelta) GetRemovedCharacterIds() []int32 {
+	if x != nil {
+		return x.RemovedCharacterIds
+	}
+	return nil
+}
+
+func (x *ViewDelta) GetPlayers() map[int32]*PlayerViewPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ViewDelta) GetRemovedPlayerIds() []int32 {
+	if x != nil {
+		return x.RemovedPlayerIds
+	}
+	return nil
+}
+
+func (x *ViewDelta) GetNewEvents() []*GameEvent {
+	if x != nil {
+		return x.NewEvents
+	}
+	return nil
+}
+
 var File_tragedylooper_v1_game_proto protoreflect.FileDescriptor
 
 const file_tragedylooper_v1_game_proto_rawDesc = "" +
 	"\n" +
-	"\x1btragedylooper/v1/game.proto\x12\x10tragedylooper.v1\x1a\x1etragedylooper/v1/ability.proto\x1a\x1btragedylooper/v1/card.proto\x1a tragedylooper/v1/character.proto\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1ctragedylooper/v1/event.proto\"\xcc\x06\n" +
+	"\x1btragedylooper/v1/game.proto\x12\x10tragedylooper.v1\x1a\x1etragedylooper/v1/ability.proto\x1a\x1btragedylooper/v1/card.proto\x1a tragedylooper/v1/character.proto\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1ctragedylooper/v1/event.proto\x1a\x1etragedylooper/v1/payload.proto\x1a\x1dtragedylooper/v1/script.proto\"\xb2\r\n" +
 	"\tGameState\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
 	"\x04tick\x18\x02 \x01(\x03R\x04tick\x12!\n" +
@@ -615,7 +1033,17 @@ const file_tragedylooper_v1_game_proto_rawDesc = "" +
 	" \x03(\v2\x1b.tragedylooper.v1.GameEventR\n" +
 	"loopEvents\x12:\n" +
 	"\n" +
-	"day_events\x18\v \x03(\v2\x1b.tragedylooper.v1.GameEventR\tdayEvents\x1aZ\n" +
+	"day_events\x18\v \x03(\v2\x1b.tragedylooper.v1.GameEventR\tdayEvents\x12\x1d\n" +
+	"\n" +
+	"loop_count\x18\f \x01(\x05R\tloopCount\x12F\n" +
+	"\x0edifficulty_set\x18\r \x01(\v2\x1f.tragedylooper.v1.DifficultySetR\rdifficultySet\x12f\n" +
+	"\x15played_cards_this_day\x18\x0e \x03(\v23.tragedylooper.v1.GameState.PlayedCardsThisDayEntryR\x12playedCardsThisDay\x12i\n" +
+	"\x16played_cards_this_loop\x18\x0f \x03(\v24.tragedylooper.v1.GameState.PlayedCardsThisLoopEntryR\x13playedCardsThisLoop\x12U\n" +
+	"\x0erevealed_roles\x18\x10 \x03(\v2..tragedylooper.v1.GameState.RevealedRolesEntryR\rrevealedRoles\x12g\n" +
+	"\x14disconnected_players\x18\x11 \x03(\v24.tragedylooper.v1.GameState.DisconnectedPlayersEntryR\x13disconnectedPlayers\x12\x16\n" +
+	"\x06paused\x18\x12 \x01(\bR\x06paused\x12\x1d\n" +
+	"\n" +
+	"phase_turn\x18\x13 \x01(\x05R\tphaseTurn\x1aZ\n" +
 	"\x0fCharactersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x121\n" +
 	"\x05value\x18\x02 \x01(\v2\x1b.tragedylooper.v1.CharacterR\x05value:\x028\x01\x1aT\n" +
@@ -624,6 +1052,18 @@ const file_tragedylooper_v1_game_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x18.tragedylooper.v1.PlayerR\x05value:\x028\x01\x1aE\n" +
 	"\x17TriggeredIncidentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1aa\n" +
+	"\x17PlayedCardsThisDayEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.tragedylooper.v1.CardListR\x05value:\x028\x01\x1aF\n" +
+	"\x18PlayedCardsThisLoopEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1a@\n" +
+	"\x12RevealedRolesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1aF\n" +
+	"\x18DisconnectedPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xa8\x02\n" +
 	"\x06Player\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
@@ -639,7 +1079,7 @@ const file_tragedylooper_v1_game_proto_rawDesc = "" +
 	"\btheories\x18\x03 \x03(\tR\btheories\x1a?\n" +
 	"\x11GuessedRolesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa2\x05\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xb2\t\n" +
 	"\n" +
 	"PlayerView\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x12\n" +
@@ -653,13 +1093,24 @@ const file_tragedylooper_v1_game_proto_rawDesc = "" +
 	"characters\x12C\n" +
 	"\aplayers\x18\a \x03(\v2).tragedylooper.v1.PlayerView.PlayersEntryR\aplayers\x123\n" +
 	"\tyour_hand\x18\b \x03(\v2\x16.tragedylooper.v1.CardR\byourHand\x12S\n" +
-	"\x0fyour_deductions\x18\t \x01(\v2*.tragedylooper.v1.PlayerDeductionKnowledgeR\x0eyourDeductions\x1ad\n" +
+	"\x0fyour_deductions\x18\t \x01(\v2*.tragedylooper.v1.PlayerDeductionKnowledgeR\x0eyourDeductions\x12\x1d\n" +
+	"\n" +
+	"loop_count\x18\n" +
+	" \x01(\x05R\tloopCount\x12'\n" +
+	"\x0floops_remaining\x18\v \x01(\x05R\x0eloopsRemaining\x12@\n" +
+	"\rpublic_events\x18\f \x03(\v2\x1b.tragedylooper.v1.GameEventR\fpublicEvents\x12F\n" +
+	"\fpublic_sheet\x18\r \x01(\v2#.tragedylooper.v1.PublicScriptSheetR\vpublicSheet\x12I\n" +
+	"\rprivate_sheet\x18\x0e \x01(\v2$.tragedylooper.v1.PrivateScriptSheetR\fprivateSheet\x12I\n" +
+	"\fplayed_cards\x18\x0f \x03(\v2&.tragedylooper.v1.PlayerViewPlayedCardR\vplayedCards\x12B\n" +
+	"\tincidents\x18\x10 \x03(\v2$.tragedylooper.v1.PlayerViewIncidentR\tincidents\x12\x16\n" +
+	"\x06paused\x18\x11 \x01(\bR\x06paused\x12J\n" +
+	"\rlegal_actions\x18\x12 \x03(\v2%.tragedylooper.v1.PlayerActionPayloadR\flegalActions\x1ad\n" +
 	"\x0fCharactersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12;\n" +
 	"\x05value\x18\x02 \x01(\v2%.tragedylooper.v1.PlayerViewCharacterR\x05value:\x028\x01\x1a^\n" +
 	"\fPlayersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x128\n" +
-	"\x05value\x18\x02 \x01(\v2\".tragedylooper.v1.PlayerViewPlayerR\x05value:\x028\x01\"\x90\x04\n" +
+	"\x05value\x18\x02 \x01(\v2\".tragedylooper.v1.PlayerViewPlayerR\x05value:\x028\x01\"\xc6\x04\n" +
 	"\x13PlayerViewCharacter\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -671,15 +1122,48 @@ const file_tragedylooper_v1_game_proto_rawDesc = "" +
 	"\rin_panic_mode\x18\n" +
 	" \x01(\bR\vinPanicMode\x125\n" +
 	"\x05rules\x18\v \x03(\v2\x1f.tragedylooper.v1.CharacterRuleR\x05rules\x12A\n" +
-	"\rrevealed_role\x18\f \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\frevealedRole\x1a8\n" +
+	"\rrevealed_role\x18\f \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\frevealedRole\x12\x17\n" +
+	"\arole_id\x18\r \x01(\x05R\x06roleId\x12\x1b\n" +
+	"\trole_name\x18\x0e \x01(\tR\broleName\x1a8\n" +
 	"\n" +
 	"StatsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"h\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xa9\x01\n" +
 	"\x10PlayerViewPlayer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\x04roleB\xb9\x01\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\x04role\x12\x1b\n" +
+	"\thand_size\x18\x04 \x01(\x05R\bhandSize\x12\"\n" +
+	"\fdisconnected\x18\x05 \x01(\bR\fdisconnected\"|\n" +
+	"\x14PlayerViewPlayedCard\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12*\n" +
+	"\x04card\x18\x02 \x01(\v2\x16.tragedylooper.v1.CardR\x04card\x12\x1b\n" +
+	"\tface_down\x18\x03 \x01(\bR\bfaceDown\"\x82\x01\n" +
+	"\x12PlayerViewIncident\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x1c\n" +
+	"\ttriggered\x18\x04 \x01(\bR\ttriggered\x12\x18\n" +
+	"\aculprit\x18\x05 \x01(\tR\aculprit\"\x9c\x05\n" +
+	"\tViewDelta\x12!\n" +
+	"\fbase_version\x18\x01 \x01(\x04R\vbaseVersion\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\x126\n" +
+	"\achanged\x18\x03 \x01(\v2\x1c.tragedylooper.v1.PlayerViewR\achanged\x12%\n" +
+	"\x0echanged_fields\x18\x04 \x03(\tR\rchangedFields\x12K\n" +
+	"\n" +
+	"characters\x18\x05 \x03(\v2+.tragedylooper.v1.ViewDelta.CharactersEntryR\n" +
+	"characters\x122\n" +
+	"\x15removed_character_ids\x18\x06 \x03(\x05R\x13removedCharacterIds\x12B\n" +
+	"\aplayers\x18\a \x03(\v2(.tragedylooper.v1.ViewDelta.PlayersEntryR\aplayers\x12,\n" +
+	"\x12removed_player_ids\x18\b \x03(\x05R\x10removedPlayerIds\x12:\n" +
+	"\n" +
+	"new_events\x18\t \x03(\v2\x1b.tragedylooper.v1.GameEventR\tnewEvents\x1ad\n" +
+	"\x0fCharactersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12;\n" +
+	"\x05value\x18\x02 \x01(\v2%.tragedylooper.v1.PlayerViewCharacterR\x05value:\x028\x01\x1a^\n" +
+	"\fPlayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x128\n" +
+	"\x05value\x18\x02 \x01(\v2\".tragedylooper.v1.PlayerViewPlayerR\x05value:\x028\x01B\xb9\x01\n" +
 	"\x14com.tragedylooper.v1B\tGameProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
 
 var (
@@ -694,7 +1178,7 @@ func file_tragedylooper_v1_game_proto_rawDescGZIP() []byte {
 	return file_tragedylooper_v1_game_proto_rawDescData
 }
 
-var file_tragedylooper_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_tragedylooper_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_tragedylooper_v1_game_proto_goTypes = []any{
 	(*GameState)(nil),                // 0: tragedylooper.v1.GameState
 	(*Player)(nil),                   // 1: tragedylooper.v1.Player
@@ -702,54 +1186,86 @@ var file_tragedylooper_v1_game_proto_goTypes = []any{
 	(*PlayerView)(nil),               // 3: tragedylooper.v1.PlayerView
 	(*PlayerViewCharacter)(nil),      // 4: tragedylooper.v1.PlayerViewCharacter
 	(*PlayerViewPlayer)(nil),         // 5: tragedylooper.v1.PlayerViewPlayer
-	nil,                              // 6: tragedylooper.v1.GameState.CharactersEntry
-	nil,                              // 7: tragedylooper.v1.GameState.PlayersEntry
-	nil,                              // 8: tragedylooper.v1.GameState.TriggeredIncidentsEntry
-	nil,                              // 9: tragedylooper.v1.PlayerDeductionKnowledge.GuessedRolesEntry
-	nil,                              // 10: tragedylooper.v1.PlayerView.CharactersEntry
-	nil,                              // 11: tragedylooper.v1.PlayerView.PlayersEntry
-	nil,                              // 12: tragedylooper.v1.PlayerViewCharacter.StatsEntry
-	(GamePhase)(0),                   // 13: tragedylooper.v1.GamePhase
-	(*GameEvent)(nil),                // 14: tragedylooper.v1.GameEvent
-	(PlayerRole)(0),                  // 15: tragedylooper.v1.PlayerRole
-	(*CardList)(nil),                 // 16: tragedylooper.v1.CardList
-	(*Card)(nil),                     // 17: tragedylooper.v1.Card
-	(LocationType)(0),                // 18: tragedylooper.v1.LocationType
-	(*Ability)(nil),                  // 19: tragedylooper.v1.Ability
-	(*CharacterRule)(nil),            // 20: tragedylooper.v1.CharacterRule
-	(*Character)(nil),                // 21: tragedylooper.v1.Character
+	(*PlayerViewPlayedCard)(nil),     // 6: tragedylooper.v1.PlayerViewPlayedCard
+	(*PlayerViewIncident)(nil),       // 7: tragedylooper.v1.PlayerViewIncident
+	(*ViewDelta)(nil),                // 8: tragedylooper.v1.ViewDelta
+	nil,                              // 9: tragedylooper.v1.GameState.CharactersEntry
+	nil,                              // 10: tragedylooper.v1.GameState.PlayersEntry
+	nil,                              // 11: tragedylooper.v1.GameState.TriggeredIncidentsEntry
+	nil,                              // 12: tragedylooper.v1.GameState.PlayedCardsThisDayEntry
+	nil,                              // 13: tragedylooper.v1.GameState.PlayedCardsThisLoopEntry
+	nil,                              // 14: tragedylooper.v1.GameState.RevealedRolesEntry
+	nil,                              // 15: tragedylooper.v1.GameState.DisconnectedPlayersEntry
+	nil,                              // 16: tragedylooper.v1.PlayerDeductionKnowledge.GuessedRolesEntry
+	nil,                              // 17: tragedylooper.v1.PlayerView.CharactersEntry
+	nil,                              // 18: tragedylooper.v1.PlayerView.PlayersEntry
+	nil,                              // 19: tragedylooper.v1.PlayerViewCharacter.StatsEntry
+	nil,                              // 20: tragedylooper.v1.ViewDelta.CharactersEntry
+	nil,                              // 21: tragedylooper.v1.ViewDelta.PlayersEntry
+	(GamePhase)(0),                   // 22: tragedylooper.v1.GamePhase
+	(*GameEvent)(nil),                // 23: tragedylooper.v1.GameEvent
+	(*DifficultySet)(nil),            // 24: tragedylooper.v1.DifficultySet
+	(PlayerRole)(0),                  // 25: tragedylooper.v1.PlayerRole
+	(*CardList)(nil),                 // 26: tragedylooper.v1.CardList
+	(*Card)(nil),                     // 27: tragedylooper.v1.Card
+	(*PublicScriptSheet)(nil),        // 28: tragedylooper.v1.PublicScriptSheet
+	(*PrivateScriptSheet)(nil),       // 29: tragedylooper.v1.PrivateScriptSheet
+	(*PlayerActionPayload)(nil),      // 30: tragedylooper.v1.PlayerActionPayload
+	(LocationType)(0),                // 31: tragedylooper.v1.LocationType
+	(*Ability)(nil),                  // 32: tragedylooper.v1.Ability
+	(*CharacterRule)(nil),            // 33: tragedylooper.v1.CharacterRule
+	(*Character)(nil),                // 34: tragedylooper.v1.Character
 }
 var file_tragedylooper_v1_game_proto_depIdxs = []int32{
-	13, // 0: tragedylooper.v1.GameState.current_phase:type_name -> tragedylooper.v1.GamePhase
-	6,  // 1: tragedylooper.v1.GameState.characters:type_name -> tragedylooper.v1.GameState.CharactersEntry
-	7,  // 2: tragedylooper.v1.GameState.players:type_name -> tragedylooper.v1.GameState.PlayersEntry
-	8,  // 3: tragedylooper.v1.GameState.triggered_incidents:type_name -> tragedylooper.v1.GameState.TriggeredIncidentsEntry
-	14, // 4: tragedylooper.v1.GameState.loop_events:type_name -> tragedylooper.v1.GameEvent
-	14, // 5: tragedylooper.v1.GameState.day_events:type_name -> tragedylooper.v1.GameEvent
-	15, // 6: tragedylooper.v1.Player.role:type_name -> tragedylooper.v1.PlayerRole
-	16, // 7: tragedylooper.v1.Player.hand:type_name -> tragedylooper.v1.CardList
-	2,  // 8: tragedylooper.v1.Player.deduction_knowledge:type_name -> tragedylooper.v1.PlayerDeductionKnowledge
-	9,  // 9: tragedylooper.v1.PlayerDeductionKnowledge.guessed_roles:type_name -> tragedylooper.v1.PlayerDeductionKnowledge.GuessedRolesEntry
-	13, // 10: tragedylooper.v1.PlayerView.current_phase:type_name -> tragedylooper.v1.GamePhase
-	10, // 11: tragedylooper.v1.PlayerView.characters:type_name -> tragedylooper.v1.PlayerView.CharactersEntry
-	11, // 12: tragedylooper.v1.PlayerView.players:type_name -> tragedylooper.v1.PlayerView.PlayersEntry
-	17, // 13: tragedylooper.v1.PlayerView.your_hand:type_name -> tragedylooper.v1.Card
-	2,  // 14: tragedylooper.v1.PlayerView.your_deductions:type_name -> tragedylooper.v1.PlayerDeductionKnowledge
-	18, // 15: tragedylooper.v1.PlayerViewCharacter.current_location:type_name -> tragedylooper.v1.LocationType
-	12, // 16: tragedylooper.v1.PlayerViewCharacter.stats:type_name -> tragedylooper.v1.PlayerViewCharacter.StatsEntry
-	19, // 17: tragedylooper.v1.PlayerViewCharacter.abilities:type_name -> tragedylooper.v1.Ability
-	20, // 18: tragedylooper.v1.PlayerViewCharacter.rules:type_name -> tragedylooper.v1.CharacterRule
-	15, // 19: tragedylooper.v1.PlayerViewCharacter.revealed_role:type_name -> tragedylooper.v1.PlayerRole
-	15, // 20: tragedylooper.v1.PlayerViewPlayer.role:type_name -> tragedylooper.v1.PlayerRole
-	21, // 21: tragedylooper.v1.GameState.CharactersEntry.value:type_name -> tragedylooper.v1.Character
-	1,  // 22: tragedylooper.v1.GameState.PlayersEntry.value:type_name -> tragedylooper.v1.Player
-	4,  // 23: tragedylooper.v1.PlayerView.CharactersEntry.value:type_name -> tragedylooper.v1.PlayerViewCharacter
-	5,  // 24: tragedylooper.v1.PlayerView.PlayersEntry.value:type_name -> tragedylooper.v1.PlayerViewPlayer
-	25, // [25:25] is the sub-list for method output_type
-	25, // [25:25] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	22, // 0: tragedylooper.v1.GameState.current_phase:type_name -> tragedylooper.v1.GamePhase
+	9,  // 1: tragedylooper.v1.GameState.characters:type_name -> tragedylooper.v1.GameState.CharactersEntry
+	10, // 2: tragedylooper.v1.GameState.players:type_name -> tragedylooper.v1.GameState.PlayersEntry
+	11, // 3: tragedylooper.v1.GameState.triggered_incidents:type_name -> tragedylooper.v1.GameState.TriggeredIncidentsEntry
+	23, // 4: tragedylooper.v1.GameState.loop_events:type_name -> tragedylooper.v1.GameEvent
+	23, // 5: tragedylooper.v1.GameState.day_events:type_name -> tragedylooper.v1.GameEvent
+	24, // 6: tragedylooper.v1.GameState.difficulty_set:type_name -> tragedylooper.v1.DifficultySet
+	12, // 7: tragedylooper.v1.GameState.played_cards_this_day:type_name -> tragedylooper.v1.GameState.PlayedCardsThisDayEntry
+	13, // 8: tragedylooper.v1.GameState.played_cards_this_loop:type_name -> tragedylooper.v1.GameState.PlayedCardsThisLoopEntry
+	14, // 9: tragedylooper.v1.GameState.revealed_roles:type_name -> tragedylooper.v1.GameState.RevealedRolesEntry
+	15, // 10: tragedylooper.v1.GameState.disconnected_players:type_name -> tragedylooper.v1.GameState.DisconnectedPlayersEntry
+	25, // 11: tragedylooper.v1.Player.role:type_name -> tragedylooper.v1.PlayerRole
+	26, // 12: tragedylooper.v1.Player.hand:type_name -> tragedylooper.v1.CardList
+	2,  // 13: tragedylooper.v1.Player.deduction_knowledge:type_name -> tragedylooper.v1.PlayerDeductionKnowledge
+	16, // 14: tragedylooper.v1.PlayerDeductionKnowledge.guessed_roles:type_name -> tragedylooper.v1.PlayerDeductionKnowledge.GuessedRolesEntry
+	22, // 15: tragedylooper.v1.PlayerView.current_phase:type_name -> tragedylooper.v1.GamePhase
+	17, // 16: tragedylooper.v1.PlayerView.characters:type_name -> tragedylooper.v1.PlayerView.CharactersEntry
+	18, // 17: tragedylooper.v1.PlayerView.players:type_name -> tragedylooper.v1.PlayerView.PlayersEntry
+	27, // 18: tragedylooper.v1.PlayerView.your_hand:type_name -> tragedylooper.v1.Card
+	2,  // 19: tragedylooper.v1.PlayerView.your_deductions:type_name -> tragedylooper.v1.PlayerDeductionKnowledge
+	23, // 20: tragedylooper.v1.PlayerView.public_events:type_name -> tragedylooper.v1.GameEvent
+	28, // 21: tragedylooper.v1.PlayerView.public_sheet:type_name -> tragedylooper.v1.PublicScriptSheet
+	29, // 22: tragedylooper.v1.PlayerView.private_sheet:type_name -> tragedylooper.v1.PrivateScriptSheet
+	6,  // 23: tragedylooper.v1.PlayerView.played_cards:type_name -> tragedylooper.v1.PlayerViewPlayedCard
+	7,  // 24: tragedylooper.v1.PlayerView.incidents:type_name -> tragedylooper.v1.PlayerViewIncident
+	30, // 25: tragedylooper.v1.PlayerView.legal_actions:type_name -> tragedylooper.v1.PlayerActionPayload
+	31, // 26: tragedylooper.v1.PlayerViewCharacter.current_location:type_name -> tragedylooper.v1.LocationType
+	19, // 27: tragedylooper.v1.PlayerViewCharacter.stats:type_name -> tragedylooper.v1.PlayerViewCharacter.StatsEntry
+	32, // 28: tragedylooper.v1.PlayerViewCharacter.abilities:type_name -> tragedylooper.v1.Ability
+	33, // 29: tragedylooper.v1.PlayerViewCharacter.rules:type_name -> tragedylooper.v1.CharacterRule
+	25, // 30: tragedylooper.v1.PlayerViewCharacter.revealed_role:type_name -> tragedylooper.v1.PlayerRole
+	25, // 31: tragedylooper.v1.PlayerViewPlayer.role:type_name -> tragedylooper.v1.PlayerRole
+	27, // 32: tragedylooper.v1.PlayerViewPlayedCard.card:type_name -> tragedylooper.v1.Card
+	3,  // 33: tragedylooper.v1.ViewDelta.changed:type_name -> tragedylooper.v1.PlayerView
+	20, // 34: tragedylooper.v1.ViewDelta.characters:type_name -> tragedylooper.v1.ViewDelta.CharactersEntry
+	21, // 35: tragedylooper.v1.ViewDelta.players:type_name -> tragedylooper.v1.ViewDelta.PlayersEntry
+	23, // 36: tragedylooper.v1.ViewDelta.new_events:type_name -> tragedylooper.v1.GameEvent
+	34, // 37: tragedylooper.v1.GameState.CharactersEntry.value:type_name -> tragedylooper.v1.Character
+	1,  // 38: tragedylooper.v1.GameState.PlayersEntry.value:type_name -> tragedylooper.v1.Player
+	26, // 39: tragedylooper.v1.GameState.PlayedCardsThisDayEntry.value:type_name -> tragedylooper.v1.CardList
+	4,  // 40: tragedylooper.v1.PlayerView.CharactersEntry.value:type_name -> tragedylooper.v1.PlayerViewCharacter
+	5,  // 41: tragedylooper.v1.PlayerView.PlayersEntry.value:type_name -> tragedylooper.v1.PlayerViewPlayer
+	4,  // 42: tragedylooper.v1.ViewDelta.CharactersEntry.value:type_name -> tragedylooper.v1.PlayerViewCharacter
+	5,  // 43: tragedylooper.v1.ViewDelta.PlayersEntry.value:type_name -> tragedylooper.v1.PlayerViewPlayer
+	44, // [44:44] is the sub-list for method output_type
+	44, // [44:44] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_tragedylooper_v1_game_proto_init() }
@@ -762,13 +1278,15 @@ func file_tragedylooper_v1_game_proto_init() {
 	file_tragedylooper_v1_character_proto_init()
 	file_tragedylooper_v1_enums_proto_init()
 	file_tragedylooper_v1_event_proto_init()
+	file_tragedylooper_v1_payload_proto_init()
+	file_tragedylooper_v1_script_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_game_proto_rawDesc), len(file_tragedylooper_v1_game_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
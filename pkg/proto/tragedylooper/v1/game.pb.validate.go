@@ -231,6 +231,93 @@ func (m *GameState) validate(all bool) error {
 
 	}
 
+	// no validation rules for LoopCount
+
+	if all {
+		switch v := interface{}(m.GetDifficultySet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GameStateValidationError{
+					field:  "DifficultySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GameStateValidationError{
+					field:  "DifficultySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDifficultySet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GameStateValidationError{
+				field:  "DifficultySet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]int32, len(m.GetPlayedCardsThisDay()))
+		i := 0
+		for key := range m.GetPlayedCardsThisDay() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPlayedCardsThisDay()[key]
+			_ = val
+
+			// no validation rules for PlayedCardsThisDay[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GameStateValidationError{
+							field:  fmt.Sprintf("PlayedCardsThisDay[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GameStateValidationError{
+							field:  fmt.Sprintf("PlayedCardsThisDay[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GameStateValidationError{
+						field:  fmt.Sprintf("PlayedCardsThisDay[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for PlayedCardsThisLoop
+
+	// no validation rules for RevealedRoles
+
+	// no validation rules for DisconnectedPlayers
+
+	// no validation rules for Paused
+
+	// no validation rules for PhaseTurn
+
 	if len(errors) > 0 {
 		return GameStateMultiError(errors)
 	}
@@ -765,6 +852,206 @@ func (m *PlayerView) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for LoopCount
+
+	// no validation rules for LoopsRemaining
+
+	for idx, item := range m.GetPublicEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("PublicEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("PublicEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlayerViewValidationError{
+					field:  fmt.Sprintf("PublicEvents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPublicSheet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlayerViewValidationError{
+					field:  "PublicSheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlayerViewValidationError{
+					field:  "PublicSheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublicSheet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlayerViewValidationError{
+				field:  "PublicSheet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrivateSheet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlayerViewValidationError{
+					field:  "PrivateSheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlayerViewValidationError{
+					field:  "PrivateSheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrivateSheet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlayerViewValidationError{
+				field:  "PrivateSheet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPlayedCards() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("PlayedCards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("PlayedCards[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlayerViewValidationError{
+					field:  fmt.Sprintf("PlayedCards[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetIncidents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("Incidents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("Incidents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlayerViewValidationError{
+					field:  fmt.Sprintf("Incidents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Paused
+
+	for idx, item := range m.GetLegalActions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("LegalActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlayerViewValidationError{
+						field:  fmt.Sprintf("LegalActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlayerViewValidationError{
+					field:  fmt.Sprintf("LegalActions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PlayerViewMultiError(errors)
 	}
@@ -946,6 +1233,10 @@ func (m *PlayerViewCharacter) validate(all bool) error {
 
 	// no validation rules for RevealedRole
 
+	// no validation rules for RoleId
+
+	// no validation rules for RoleName
+
 	if len(errors) > 0 {
 		return PlayerViewCharacterMultiError(errors)
 	}
@@ -1054,6 +1345,10 @@ func (m *PlayerViewPlayer) validate(all bool) error {
 
 	// no validation rules for Role
 
+	// no validation rules for HandSize
+
+	// no validation rules for Disconnected
+
 	if len(errors) > 0 {
 		return PlayerViewPlayerMultiError(errors)
 	}
@@ -1131,3 +1426,508 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PlayerViewPlayerValidationError{}
+
+// Validate checks the field values on PlayerViewPlayedCard with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlayerViewPlayedCard) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlayerViewPlayedCard with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlayerViewPlayedCardMultiError, or nil if none found.
+func (m *PlayerViewPlayedCard) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlayerViewPlayedCard) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlayerId
+
+	if all {
+		switch v := interface{}(m.GetCard()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlayerViewPlayedCardValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlayerViewPlayedCardValidationError{
+					field:  "Card",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCard()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlayerViewPlayedCardValidationError{
+				field:  "Card",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FaceDown
+
+	if len(errors) > 0 {
+		return PlayerViewPlayedCardMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlayerViewPlayedCardMultiError is an error wrapping multiple validation
+// errors returned by PlayerViewPlayedCard.ValidateAll() if the designated
+// constraints aren't met.
+type PlayerViewPlayedCardMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlayerViewPlayedCardMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlayerViewPlayedCardMultiError) AllErrors() []error { return m }
+
+// PlayerViewPlayedCardValidationError is the validation error returned by
+// PlayerViewPlayedCard.Validate if the designated constraints aren't met.
+type PlayerViewPlayedCardValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlayerViewPlayedCardValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlayerViewPlayedCardValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlayerViewPlayedCardValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlayerViewPlayedCardValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlayerViewPlayedCardValidationError) ErrorName() string {
+	return "PlayerViewPlayedCardValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlayerViewPlayedCardValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlayerViewPlayedCard.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlayerViewPlayedCardValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlayerViewPlayedCardValidationError{}
+
+// Validate checks the field values on PlayerViewIncident with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlayerViewIncident) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlayerViewIncident with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PlayerViewIncidentMultiError, or nil if none found.
+func (m *PlayerViewIncident) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlayerViewIncident) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for Day
+
+	// no validation rules for Triggered
+
+	// no validation rules for Culprit
+
+	if len(errors) > 0 {
+		return PlayerViewIncidentMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlayerViewIncidentMultiError is an error wrapping multiple validation errors
+// returned by PlayerViewIncident.ValidateAll() if the designated constraints
+// aren't met.
+type PlayerViewIncidentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlayerViewIncidentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlayerViewIncidentMultiError) AllErrors() []error { return m }
+
+// PlayerViewIncidentValidationError is the validation error returned by
+// PlayerViewIncident.Validate if the designated constraints aren't met.
+type PlayerViewIncidentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlayerViewIncidentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlayerViewIncidentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlayerViewIncidentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlayerViewIncidentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlayerViewIncidentValidationError) ErrorName() string {
+	return "PlayerViewIncidentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlayerViewIncidentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlayerViewIncident.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlayerViewIncidentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlayerViewIncidentValidationError{}
+
+// Validate checks the field values on ViewDelta with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ViewDelta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ViewDelta with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViewDeltaMultiError, or nil
+// if none found.
+func (m *ViewDelta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ViewDelta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BaseVersion
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetChanged()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ViewDeltaValidationError{
+					field:  "Changed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ViewDeltaValidationError{
+					field:  "Changed",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChanged()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ViewDeltaValidationError{
+				field:  "Changed",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	{
+		sorted_keys := make([]int32, len(m.GetCharacters()))
+		i := 0
+		for key := range m.GetCharacters() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetCharacters()[key]
+			_ = val
+
+			// no validation rules for Characters[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ViewDeltaValidationError{
+							field:  fmt.Sprintf("Characters[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ViewDeltaValidationError{
+							field:  fmt.Sprintf("Characters[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ViewDeltaValidationError{
+						field:  fmt.Sprintf("Characters[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]int32, len(m.GetPlayers()))
+		i := 0
+		for key := range m.GetPlayers() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPlayers()[key]
+			_ = val
+
+			// no validation rules for Players[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ViewDeltaValidationError{
+							field:  fmt.Sprintf("Players[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ViewDeltaValidationError{
+							field:  fmt.Sprintf("Players[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ViewDeltaValidationError{
+						field:  fmt.Sprintf("Players[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetNewEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ViewDeltaValidationError{
+						field:  fmt.Sprintf("NewEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ViewDeltaValidationError{
+						field:  fmt.Sprintf("NewEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ViewDeltaValidationError{
+					field:  fmt.Sprintf("NewEvents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ViewDeltaMultiError(errors)
+	}
+
+	return nil
+}
+
+// ViewDeltaMultiError is an error wrapping multiple validation errors
+// returned by ViewDelta.ValidateAll() if the designated constraints aren't met.
+type ViewDeltaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ViewDeltaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ViewDeltaMultiError) AllErrors() []error { return m }
+
+// ViewDeltaValidationError is the validation error returned by
+// ViewDelta.Validate if the designated constraints aren't met.
+type ViewDeltaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ViewDeltaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ViewDeltaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ViewDeltaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ViewDeltaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ViewDeltaValidationError) ErrorName() string { return "ViewDeltaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ViewDeltaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sViewDelta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ViewDeltaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ViewDeltaValidationError{}
@@ -0,0 +1,1685 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tragedylooper/v1/protocol.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ErrorCode 定义了协议错误的类型。
+type ErrorCode int32
+
+const (
+	ErrorCode_ERROR_CODE_UNSPECIFIED          ErrorCode = 0  // 未指定
+	ErrorCode_ERROR_CODE_INVALID_MESSAGE      ErrorCode = 1  // 无法解析或内容不合法的消息
+	ErrorCode_ERROR_CODE_NOT_IN_ROOM          ErrorCode = 2  // 连接尚未加入房间
+	ErrorCode_ERROR_CODE_ALREADY_IN_ROOM      ErrorCode = 3  // 连接已经加入了房间
+	ErrorCode_ERROR_CODE_ROOM_NOT_FOUND       ErrorCode = 4  // 房间不存在
+	ErrorCode_ERROR_CODE_PLAYER_NOT_FOUND     ErrorCode = 5  // 房间中不存在该玩家
+	ErrorCode_ERROR_CODE_INTERNAL             ErrorCode = 6  // 服务器内部错误
+	ErrorCode_ERROR_CODE_INVALID_SESSION      ErrorCode = 7  // 席位会话令牌无效或与请求不符
+	ErrorCode_ERROR_CODE_GAME_NOT_STARTED     ErrorCode = 8  // 游戏尚未开始，房间仍在大厅中
+	ErrorCode_ERROR_CODE_GAME_ALREADY_STARTED ErrorCode = 9  // 游戏已经开始，大厅操作不再可用
+	ErrorCode_ERROR_CODE_FORBIDDEN            ErrorCode = 10 // 没有执行该操作的权限，例如旁观者提交操作
+	ErrorCode_ERROR_CODE_RATE_LIMITED         ErrorCode = 11 // 席位提交操作过于频繁，请稍后重试
+	ErrorCode_ERROR_CODE_ILLEGAL_ACTION       ErrorCode = 12 // 操作在当前阶段不合法，合法的操作见 PlayerView.legal_actions
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "ERROR_CODE_UNSPECIFIED",
+		1:  "ERROR_CODE_INVALID_MESSAGE",
+		2:  "ERROR_CODE_NOT_IN_ROOM",
+		3:  "ERROR_CODE_ALREADY_IN_ROOM",
+		4:  "ERROR_CODE_ROOM_NOT_FOUND",
+		5:  "ERROR_CODE_PLAYER_NOT_FOUND",
+		6:  "ERROR_CODE_INTERNAL",
+		7:  "ERROR_CODE_INVALID_SESSION",
+		8:  "ERROR_CODE_GAME_NOT_STARTED",
+		9:  "ERROR_CODE_GAME_ALREADY_STARTED",
+		10: "ERROR_CODE_FORBIDDEN",
+		11: "ERROR_CODE_RATE_LIMITED",
+		12: "ERROR_CODE_ILLEGAL_ACTION",
+	}
+	ErrorCode_value = map[string]int32{
+		"ERROR_CODE_UNSPECIFIED":          0,
+		"ERROR_CODE_INVALID_MESSAGE":      1,
+		"ERROR_CODE_NOT_IN_ROOM":          2,
+		"ERROR_CODE_ALREADY_IN_ROOM":      3,
+		"ERROR_CODE_ROOM_NOT_FOUND":       4,
+		"ERROR_CODE_PLAYER_NOT_FOUND":     5,
+		"ERROR_CODE_INTERNAL":             6,
+		"ERROR_CODE_INVALID_SESSION":      7,
+		"ERROR_CODE_GAME_NOT_STARTED":     8,
+		"ERROR_CODE_GAME_ALREADY_STARTED": 9,
+		"ERROR_CODE_FORBIDDEN":            10,
+		"ERROR_CODE_RATE_LIMITED":         11,
+		"ERROR_CODE_ILLEGAL_ACTION":       12,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_tragedylooper_v1_protocol_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_tragedylooper_v1_protocol_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{0}
+}
+
+// RoomState 定义了房间的生命周期状态。
+type RoomState int32
+
+const (
+	RoomState_ROOM_STATE_UNSPECIFIED RoomState = 0 // 未指定
+	RoomState_ROOM_STATE_LOBBY       RoomState = 1 // 大厅中：玩家入座、选择剧本并准备
+	RoomState_ROOM_STATE_RUNNING     RoomState = 2 // 游戏进行中
+	RoomState_ROOM_STATE_FINISHED    RoomState = 3 // 游戏已结束，房间保留一段时间以便查看结果
+	RoomState_ROOM_STATE_ABANDONED   RoomState = 4 // 房间因长时间无人活动或引擎意外停止而被放弃
+)
+
+// Enum value maps for RoomState.
+var (
+	RoomState_name = map[int32]string{
+		0: "ROOM_STATE_UNSPECIFIED",
+		1: "ROOM_STATE_LOBBY",
+		2: "ROOM_STATE_RUNNING",
+		3: "ROOM_STATE_FINISHED",
+		4: "ROOM_STATE_ABANDONED",
+	}
+	RoomState_value = map[string]int32{
+		"ROOM_STATE_UNSPECIFIED": 0,
+		"ROOM_STATE_LOBBY":       1,
+		"ROOM_STATE_RUNNING":     2,
+		"ROOM_STATE_FINISHED":    3,
+		"ROOM_STATE_ABANDONED":   4,
+	}
+)
+
+func (x RoomState) Enum() *RoomState {
+	p := new(RoomState)
+	*p = x
+	return p
+}
+
+func (x RoomState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RoomState) Descriptor() protoreflect.EnumDescriptor {
+	return file_tragedylooper_v1_protocol_proto_enumTypes[1].Descriptor()
+}
+
+func (RoomState) Type() protoreflect.EnumType {
+	return &file_tragedylooper_v1_protocol_proto_enumTypes[1]
+}
+
+func (x RoomState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RoomState.Descriptor instead.
+func (RoomState) EnumDescriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{1}
+}
+
+// ClientMessage 是客户端通过 WebSocket 发往服务器的消息信封。
+// 文本帧使用 protojson 编码，二进制帧使用 protobuf 二进制编码。
+type ClientMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 客户端消息序号，由客户端单调递增；服务器在 Ack 中回显。
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*ClientMessage_JoinRoom
+	//	*ClientMessage_SubmitAction
+	//	*ClientMessage_ChooseOption
+	//	*ClientMessage_Ping
+	//	*ClientMessage_Ack
+	//	*ClientMessage_SetReady
+	//	*ClientMessage_Spectate
+	//	*ClientMessage_SendChat
+	//	*ClientMessage_RequestView
+	Message       isClientMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientMessage) Reset() {
+	*x = ClientMessage{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientMessage) ProtoMessage() {}
+
+func (x *ClientMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientMessage.ProtoReflect.Descriptor instead.
+func (*ClientMessage) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientMessage) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ClientMessage) GetMessage() isClientMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetJoinRoom() *JoinRoomRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_JoinRoom); ok {
+			return x.JoinRoom
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetSubmitAction() *SubmitActionRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_SubmitAction); ok {
+			return x.SubmitAction
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetChooseOption() *ChooseOptionPayload {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_ChooseOption); ok {
+			return x.ChooseOption
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetPing() *Ping {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_Ping); ok {
+			return x.Ping
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetAck() *Ack {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetSetReady() *SetReady {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_SetReady); ok {
+			return x.SetReady
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetSpectate() *SpectateRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_Spectate); ok {
+			return x.Spectate
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetSendChat() *SendChat {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_SendChat); ok {
+			return x.SendChat
+		}
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetRequestView() *RequestView {
+	if x != nil {
+		if x, ok := x.Message.(*ClientMessage_RequestView); ok {
+			return x.RequestView
+		}
+	}
+	return nil
+}
+
+type isClientMessage_Message interface {
+	isClientMessage_Message()
+}
+
+type ClientMessage_JoinRoom struct {
+	JoinRoom *JoinRoomRequest `protobuf:"bytes,2,opt,name=join_room,json=joinRoom,proto3,oneof"` // 加入房间
+}
+
+type ClientMessage_SubmitAction struct {
+	SubmitAction *SubmitActionRequest `protobuf:"bytes,3,opt,name=submit_action,json=submitAction,proto3,oneof"` // 提交玩家操作
+}
+
+type ClientMessage_ChooseOption struct {
+	ChooseOption *ChooseOptionPayload `protobuf:"bytes,4,opt,name=choose_option,json=chooseOption,proto3,oneof"` // 回应选择请求
+}
+
+type ClientMessage_Ping struct {
+	Ping *Ping `protobuf:"bytes,5,opt,name=ping,proto3,oneof"` // 心跳
+}
+
+type ClientMessage_Ack struct {
+	Ack *Ack `protobuf:"bytes,6,opt,name=ack,proto3,oneof"` // 确认已收到的服务器消息
+}
+
+type ClientMessage_SetReady struct {
+	SetReady *SetReady `protobuf:"bytes,7,opt,name=set_ready,json=setReady,proto3,oneof"` // 在大厅中设置准备状态
+}
+
+type ClientMessage_Spectate struct {
+	Spectate *SpectateRequest `protobuf:"bytes,8,opt,name=spectate,proto3,oneof"` // 以旁观者身份观看房间
+}
+
+type ClientMessage_SendChat struct {
+	SendChat *SendChat `protobuf:"bytes,9,opt,name=send_chat,json=sendChat,proto3,oneof"` // 发送聊天消息
+}
+
+type ClientMessage_RequestView struct {
+	RequestView *RequestView `protobuf:"bytes,10,opt,name=request_view,json=requestView,proto3,oneof"` // 请求完整视图
+}
+
+func (*ClientMessage_JoinRoom) isClientMessage_Message() {}
+
+func (*ClientMessage_SubmitAction) isClientMessage_Message() {}
+
+func (*ClientMessage_ChooseOption) isClientMessage_Message() {}
+
+func (*ClientMessage_Ping) isClientMessage_Message() {}
+
+func (*ClientMessage_Ack) isClientMessage_Message() {}
+
+func (*ClientMessage_SetReady) isClientMessage_Message() {}
+
+func (*ClientMessage_Spectate) isClientMessage_Message() {}
+
+func (*ClientMessage_SendChat) isClientMessage_Message() {}
+
+func (*ClientMessage_RequestView) isClientMessage_Message() {}
+
+// ServerMessage 是服务器通过 WebSocket 发往客户端的消息信封。
+type ServerMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 服务器消息序号，对每个玩家席位单调递增，从 1 开始，并在重连后继续递增。
+	// 加入席位之前发送的消息序号为 0。重连时补发的事件保留其原始序号。
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*ServerMessage_Joined
+	//	*ServerMessage_ViewUpdate
+	//	*ServerMessage_Event
+	//	*ServerMessage_Error
+	//	*ServerMessage_Ack
+	//	*ServerMessage_Pong
+	//	*ServerMessage_LobbyUpdate
+	//	*ServerMessage_ViewDelta
+	Message       isServerMessage_Message `protobuf_oneof:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerMessage) Reset() {
+	*x = ServerMessage{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerMessage) ProtoMessage() {}
+
+func (x *ServerMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
+func (*ServerMessage) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServerMessage) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ServerMessage) GetMessage() isServerMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetJoined() *JoinedRoom {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_Joined); ok {
+			return x.Joined
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetViewUpdate() *ViewUpdate {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_ViewUpdate); ok {
+			return x.ViewUpdate
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetEvent() *GameEvent {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_Event); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetError() *ErrorMessage {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetAck() *Ack {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_Ack); ok {
+			return x.Ack
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetPong() *Pong {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_Pong); ok {
+			return x.Pong
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetLobbyUpdate() *LobbyState {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_LobbyUpdate); ok {
+			return x.LobbyUpdate
+		}
+	}
+	return nil
+}
+
+func (x *ServerMessage) GetViewDelta() *ViewDelta {
+	if x != nil {
+		if x, ok := x.Message.(*ServerMessage_ViewDelta); ok {
+			return x.ViewDelta
+		}
+	}
+	return nil
+}
+
+type isServerMessage_Message interface {
+	isServerMessage_Message()
+}
+
+type ServerMessage_Joined struct {
+	Joined *JoinedRoom `protobuf:"bytes,2,opt,name=joined,proto3,oneof"` // 成功加入房间
+}
+
+type ServerMessage_ViewUpdate struct {
+	ViewUpdate *ViewUpdate `protobuf:"bytes,3,opt,name=view_update,json=viewUpdate,proto3,oneof"` // 玩家视图更新
+}
+
+type ServerMessage_Event struct {
+	Event *GameEvent `protobuf:"bytes,4,opt,name=event,proto3,oneof"` // 游戏事件（已按玩家身份过滤）
+}
+
+type ServerMessage_Error struct {
+	Error *ErrorMessage `protobuf:"bytes,5,opt,name=error,proto3,oneof"` // 错误
+}
+
+type ServerMessage_Ack struct {
+	Ack *Ack `protobuf:"bytes,6,opt,name=ack,proto3,oneof"` // 确认已收到的客户端消息
+}
+
+type ServerMessage_Pong struct {
+	Pong *Pong `protobuf:"bytes,7,opt,name=pong,proto3,oneof"` // 心跳回应
+}
+
+type ServerMessage_LobbyUpdate struct {
+	LobbyUpdate *LobbyState `protobuf:"bytes,8,opt,name=lobby_update,json=lobbyUpdate,proto3,oneof"` // 大厅状态更新
+}
+
+type ServerMessage_ViewDelta struct {
+	ViewDelta *ViewDelta `protobuf:"bytes,9,opt,name=view_delta,json=viewDelta,proto3,oneof"` // 玩家视图的增量更新
+}
+
+func (*ServerMessage_Joined) isServerMessage_Message() {}
+
+func (*ServerMessage_ViewUpdate) isServerMessage_Message() {}
+
+func (*ServerMessage_Event) isServerMessage_Message() {}
+
+func (*ServerMessage_Error) isServerMessage_Message() {}
+
+func (*ServerMessage_Ack) isServerMessage_Message() {}
+
+func (*ServerMessage_Pong) isServerMessage_Message() {}
+
+func (*ServerMessage_LobbyUpdate) isServerMessage_Message() {}
+
+func (*ServerMessage_ViewDelta) isServerMessage_Message() {}
+
+// JoinRoomRequest 请求将连接加入房间中的一个玩家席位。
+// 席位由 session_token 确定；game_id 和 player_id 可选，如果填写则必须与令牌一致。
+type JoinRoomRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                   // 房间的游戏 ID
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`            // 要占用的玩家 ID
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 加入房间时获得的席位会话令牌
+	LastSeq       uint64                 `protobuf:"varint,4,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`               // 重连时，客户端已确认收到的最后一条服务器消息序号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinRoomRequest) ProtoMessage() {}
+
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JoinRoomRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *JoinRoomRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *JoinRoomRequest) GetLastSeq() uint64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+// JoinedRoom 通知客户端已成功加入房间。
+type JoinedRoom struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GameId           string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                                // 房间的游戏 ID
+	PlayerId         int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`                         // 客户端占用的玩家 ID
+	Role             PlayerRole             `protobuf:"varint,3,opt,name=role,proto3,enum=tragedylooper.v1.PlayerRole" json:"role,omitempty"`                // 该玩家的身份
+	Resumed          bool                   `protobuf:"varint,4,opt,name=resumed,proto3" json:"resumed,omitempty"`                                           // 是否为重连；如果是，随后会补发 last_seq 之后错过的事件。joined 消息本身没有序号
+	Spectator        bool                   `protobuf:"varint,5,opt,name=spectator,proto3" json:"spectator,omitempty"`                                       // 是否以旁观者身份加入；旁观者没有席位，player_id 为 0
+	DelaySeconds     int32                  `protobuf:"varint,6,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`             // 旁观者收到的事件和视图相对实际游戏的延迟
+	HistoryTruncated bool                   `protobuf:"varint,7,opt,name=history_truncated,json=historyTruncated,proto3" json:"history_truncated,omitempty"` // 重连时 last_seq 之后的部分事件已超出席位保留的历史而无法补发；客户端应以随后完整视图中的 public_events 为准
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JoinedRoom) Reset() {
+	*x = JoinedRoom{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinedRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinedRoom) ProtoMessage() {}
+
+func (x *JoinedRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinedRoom.ProtoReflect.Descriptor instead.
+func (*JoinedRoom) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JoinedRoom) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinedRoom) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *JoinedRoom) GetRole() PlayerRole {
+	if x != nil {
+		return x.Role
+	}
+	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
+}
+
+func (x *JoinedRoom) GetResumed() bool {
+	if x != nil {
+		return x.Resumed
+	}
+	return false
+}
+
+func (x *JoinedRoom) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+func (x *JoinedRoom) GetDelaySeconds() int32 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+func (x *JoinedRoom) GetHistoryTruncated() bool {
+	if x != nil {
+		return x.HistoryTruncated
+	}
+	return false
+}
+
+// SpectateRequest 请求以旁观者身份观看房间。旁观者不能执行任何操作。
+type SpectateRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	GameId string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // 房间的游戏 ID
+	// 是否请求主谋级别的完整视图。只有在房主允许（此时按房间设置延迟发送）或游戏已结束时可用；
+	// 否则旁观者收到主角级别的视图。
+	FullView      bool `protobuf:"varint,2,opt,name=full_view,json=fullView,proto3" json:"full_view,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpectateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpectateRequest) ProtoMessage() {}
+
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SpectateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SpectateRequest) GetFullView() bool {
+	if x != nil {
+		return x.FullView
+	}
+	return false
+}
+
+// SendChat 是玩家在游戏中发送的聊天消息。消息作为 CHAT_MESSAGE 事件推送给频道中的玩家，并记录在游戏日志中。
+// 剧本不允许讨论时（can_discuss 为 false），主角只能在游戏开始前、循环结束阶段和游戏结束后发言。
+type SendChat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       ChatChannel            `protobuf:"varint,1,opt,name=channel,proto3,enum=tragedylooper.v1.ChatChannel" json:"channel,omitempty"` // 消息的频道
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                                          // 消息内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChat) Reset() {
+	*x = SendChat{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChat) ProtoMessage() {}
+
+func (x *SendChat) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChat.ProtoReflect.Descriptor instead.
+func (*SendChat) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SendChat) GetChannel() ChatChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return ChatChannel_CHAT_CHANNEL_UNSPECIFIED
+}
+
+func (x *SendChat) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// SubmitActionRequest 提交一个玩家操作。
+type SubmitActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        *PlayerActionPayload   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` // 玩家操作
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitActionRequest) Reset() {
+	*x = SubmitActionRequest{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitActionRequest) ProtoMessage() {}
+
+func (x *SubmitActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitActionRequest.ProtoReflect.Descriptor instead.
+func (*SubmitActionRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubmitActionRequest) GetAction() *PlayerActionPayload {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+// ViewUpdate 携带接收者最新的完整玩家视图。
+// 客户端收到后应丢弃之前的视图；之后的 ViewDelta 以 version 为基准。
+type ViewUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	View          *PlayerView            `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`        // 玩家视图
+	Version       uint64                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 视图版本，随游戏进行单调递增；旁观者视图没有版本，为 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ViewUpdate) Reset() {
+	*x = ViewUpdate{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ViewUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViewUpdate) ProtoMessage() {}
+
+func (x *ViewUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViewUpdate.ProtoReflect.Descriptor instead.
+func (*ViewUpdate) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ViewUpdate) GetView() *PlayerView {
+	if x != nil {
+		return x.View
+	}
+	return nil
+}
+
+func (x *ViewUpdate) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// RequestView 请求服务器重新发送完整的玩家视图，服务器以 ViewUpdate 回应。
+// 客户端收到的 ViewDelta 的 base_version 与本地视图版本不一致时（例如丢失了消息），应发送此请求。
+type RequestView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestView) Reset() {
+	*x = RequestView{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestView) ProtoMessage() {}
+
+func (x *RequestView) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestView.ProtoReflect.Descriptor instead.
+func (*RequestView) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{8}
+}
+
+// Ping 是客户端发出的心跳。
+type Ping struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClientTimeUnixMs int64                  `protobuf:"varint,1,opt,name=client_time_unix_ms,json=clientTimeUnixMs,proto3" json:"client_time_unix_ms,omitempty"` // 客户端发送时间，服务器在 Pong 中回显
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Ping) Reset() {
+	*x = Ping{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ping) ProtoMessage() {}
+
+func (x *Ping) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ping.ProtoReflect.Descriptor instead.
+func (*Ping) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Ping) GetClientTimeUnixMs() int64 {
+	if x != nil {
+		return x.ClientTimeUnixMs
+	}
+	return 0
+}
+
+// Pong 是服务器对 Ping 的回应。
+type Pong struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClientTimeUnixMs int64                  `protobuf:"varint,1,opt,name=client_time_unix_ms,json=clientTimeUnixMs,proto3" json:"client_time_unix_ms,omitempty"` // 回显 Ping 中的客户端时间
+	ServerTimeUnixMs int64                  `protobuf:"varint,2,opt,name=server_time_unix_ms,json=serverTimeUnixMs,proto3" json:"server_time_unix_ms,omitempty"` // 服务器时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Pong) Reset() {
+	*x = Pong{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pong) ProtoMessage() {}
+
+func (x *Pong) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pong.ProtoReflect.Descriptor instead.
+func (*Pong) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Pong) GetClientTimeUnixMs() int64 {
+	if x != nil {
+		return x.ClientTimeUnixMs
+	}
+	return 0
+}
+
+func (x *Pong) GetServerTimeUnixMs() int64 {
+	if x != nil {
+		return x.ServerTimeUnixMs
+	}
+	return 0
+}
+
+// Ack 确认对方的消息已被接收。
+type Ack struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Seq           uint64                 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"` // 被确认的消息序号（包含此序号及之前的所有消息）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ack) Reset() {
+	*x = Ack{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ack) ProtoMessage() {}
+
+func (x *Ack) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ack.ProtoReflect.Descriptor instead.
+func (*Ack) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Ack) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// ErrorMessage 描述一个协议错误。
+type ErrorMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=tragedylooper.v1.ErrorCode" json:"code,omitempty"` // 错误类型
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`                            // 人类可读的错误描述
+	ReplyTo       uint64                 `protobuf:"varint,3,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`            // 引发错误的客户端消息序号（如果有）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorMessage) Reset() {
+	*x = ErrorMessage{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorMessage) ProtoMessage() {}
+
+func (x *ErrorMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorMessage.ProtoReflect.Descriptor instead.
+func (*ErrorMessage) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ErrorMessage) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
+}
+
+func (x *ErrorMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorMessage) GetReplyTo() uint64 {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return 0
+}
+
+// LobbySeat 是大厅中的一个已占用座位。
+type LobbySeat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`          // 服务器分配的玩家 ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                   // 玩家名称
+	Role          PlayerRole             `protobuf:"varint,3,opt,name=role,proto3,enum=tragedylooper.v1.PlayerRole" json:"role,omitempty"` // 座位的身份
+	IsLlm         bool                   `protobuf:"varint,4,opt,name=is_llm,json=isLlm,proto3" json:"is_llm,omitempty"`                   // 是否由 AI 控制
+	Ready         bool                   `protobuf:"varint,5,opt,name=ready,proto3" json:"ready,omitempty"`                                // 是否已准备；AI 座位总是已准备
+	Connected     bool                   `protobuf:"varint,6,opt,name=connected,proto3" json:"connected,omitempty"`                        // 人类玩家当前是否有 WebSocket 连接
+	Host          bool                   `protobuf:"varint,7,opt,name=host,proto3" json:"host,omitempty"`                                  // 是否为房主；只有房主可以修改设置和开始游戏
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LobbySeat) Reset() {
+	*x = LobbySeat{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbySeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbySeat) ProtoMessage() {}
+
+func (x *LobbySeat) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbySeat.ProtoReflect.Descriptor instead.
+func (*LobbySeat) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LobbySeat) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *LobbySeat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LobbySeat) GetRole() PlayerRole {
+	if x != nil {
+		return x.Role
+	}
+	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
+}
+
+func (x *LobbySeat) GetIsLlm() bool {
+	if x != nil {
+		return x.IsLlm
+	}
+	return false
+}
+
+func (x *LobbySeat) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *LobbySeat) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *LobbySeat) GetHost() bool {
+	if x != nil {
+		return x.Host
+	}
+	return false
+}
+
+// LobbyState 是房间大厅的当前状态。
+type LobbyState struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	GameId                        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                                                                              // 房间的游戏 ID
+	State                         RoomState              `protobuf:"varint,2,opt,name=state,proto3,enum=tragedylooper.v1.RoomState" json:"state,omitempty"`                                                             // 房间状态
+	ScriptId                      string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`                                                                        // 所选剧本
+	ModelId                       int32                  `protobuf:"varint,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`                                                                          // 所选剧本模型
+	DifficultySet                 *DifficultySet         `protobuf:"bytes,5,opt,name=difficulty_set,json=difficultySet,proto3" json:"difficulty_set,omitempty"`                                                         // 所选难度组合（可能为空，表示使用剧本默认值）
+	FillWithAi                    bool                   `protobuf:"varint,6,opt,name=fill_with_ai,json=fillWithAi,proto3" json:"fill_with_ai,omitempty"`                                                               // 开始游戏时是否用 AI 填补空座位
+	Seats                         []*LobbySeat           `protobuf:"bytes,7,rep,name=seats,proto3" json:"seats,omitempty"`                                                                                              // 已占用的座位，按玩家 ID 排列
+	MaxProtagonists               int32                  `protobuf:"varint,8,opt,name=max_protagonists,json=maxProtagonists,proto3" json:"max_protagonists,omitempty"`                                                  // 主角座位的数量上限
+	Spectators                    int32                  `protobuf:"varint,9,opt,name=spectators,proto3" json:"spectators,omitempty"`                                                                                   // 当前旁观者数量
+	SpectatorFullViewDelaySeconds int32                  `protobuf:"varint,10,opt,name=spectator_full_view_delay_seconds,json=spectatorFullViewDelaySeconds,proto3" json:"spectator_full_view_delay_seconds,omitempty"` // 旁观者完整视图的延迟；0 表示游戏进行中不提供完整视图
+	ScriptName                    string                 `protobuf:"bytes,11,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`                                                                 // 所选剧本的名称
+	ModelTitle                    string                 `protobuf:"bytes,12,opt,name=model_title,json=modelTitle,proto3" json:"model_title,omitempty"`                                                                 // 所选剧本模型的标题
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *LobbyState) Reset() {
+	*x = LobbyState{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyState) ProtoMessage() {}
+
+func (x *LobbyState) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyState.ProtoReflect.Descriptor instead.
+func (*LobbyState) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LobbyState) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *LobbyState) GetState() RoomState {
+	if x != nil {
+		return x.State
+	}
+	return RoomState_ROOM_STATE_UNSPECIFIED
+}
+
+func (x *LobbyState) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *LobbyState) GetModelId() int32 {
+	if x != nil {
+		return x.ModelId
+	}
+	return 0
+}
+
+func (x *LobbyState) GetDifficultySet() *DifficultySet {
+	if x != nil {
+		return x.DifficultySet
+	}
+	return nil
+}
+
+func (x *LobbyState) GetFillWithAi() bool {
+	if x != nil {
+		return x.FillWithAi
+	}
+	return false
+}
+
+func (x *LobbyState) GetSeats() []*LobbySeat {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *LobbyState) GetMaxProtagonists() int32 {
+	if x != nil {
+		return x.MaxProtagonists
+	}
+	return 0
+}
+
+func (x *LobbyState) GetSpectators() int32 {
+	if x != nil {
+		return x.Spectators
+	}
+	return 0
+}
+
+func (x *LobbyState) GetSpectatorFullViewDelaySeconds() int32 {
+	if x != nil {
+		return x.SpectatorFullViewDelaySeconds
+	}
+	return 0
+}
+
+func (x *LobbyState) GetScriptName() string {
+	if x != nil {
+		return x.ScriptName
+	}
+	return ""
+}
+
+func (x *LobbyState) GetModelTitle() string {
+	if x != nil {
+		return x.ModelTitle
+	}
+	return ""
+}
+
+// SetReady 设置发送者在大厅中的准备状态。
+type SetReady struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ready         bool                   `protobuf:"varint,1,opt,name=ready,proto3" json:"ready,omitempty"` // 是否准备
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReady) Reset() {
+	*x = SetReady{}
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReady) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReady) ProtoMessage() {}
+
+func (x *SetReady) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_protocol_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReady.ProtoReflect.Descriptor instead.
+func (*SetReady) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_protocol_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetReady) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+var File_tragedylooper_v1_protocol_proto protoreflect.FileDescriptor
+
+const file_tragedylooper_v1_protocol_proto_rawDesc = "" +
+	"\n" +
+	"\x1ftragedylooper/v1/protocol.proto\x12\x10tragedylooper.v1\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1ctragedylooper/v1/event.proto\x1a\x1btragedylooper/v1/game.proto\x1a\x1etragedylooper/v1/payload.proto\x1a\x1dtragedylooper/v1/script.proto\"\xde\x04\n" +
+	"\rClientMessage\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x12@\n" +
+	"\tjoin_room\x18\x02 \x01(\v2!.tragedylooper.v1.JoinRoomRequestH\x00R\bjoinRoom\x12L\n" +
+	"\rsubmit_action\x18\x03 \x01(\v2%.tragedylooper.v1.SubmitActionRequestH\x00R\fsubmitAction\x12L\n" +
+	"\rchoose_option\x18\x04 \x01(\v2%.tragedylooper.v1.ChooseOptionPayloadH\x00R\fchooseOption\x12,\n" +
+	"\x04ping\x18\x05 \x01(\v2\x16.tragedylooper.v1.PingH\x00R\x04ping\x12)\n" +
+	"\x03ack\x18\x06 \x01(\v2\x15.tragedylooper.v1.AckH\x00R\x03ack\x129\n" +
+	"\tset_ready\x18\a \x01(\v2\x1a.tragedylooper.v1.SetReadyH\x00R\bsetReady\x12?\n" +
+	"\bspectate\x18\b \x01(\v2!.tragedylooper.v1.SpectateRequestH\x00R\bspectate\x129\n" +
+	"\tsend_chat\x18\t \x01(\v2\x1a.tragedylooper.v1.SendChatH\x00R\bsendChat\x12B\n" +
+	"\frequest_view\x18\n" +
+	" \x01(\v2\x1d.tragedylooper.v1.RequestViewH\x00R\vrequestViewB\t\n" +
+	"\amessage\"\xec\x03\n" +
+	"\rServerMessage\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\x126\n" +
+	"\x06joined\x18\x02 \x01(\v2\x1c.tragedylooper.v1.JoinedRoomH\x00R\x06joined\x12?\n" +
+	"\vview_update\x18\x03 \x01(\v2\x1c.tragedylooper.v1.ViewUpdateH\x00R\n" +
+	"viewUpdate\x123\n" +
+	"\x05event\x18\x04 \x01(\v2\x1b.tragedylooper.v1.GameEventH\x00R\x05event\x126\n" +
+	"\x05error\x18\x05 \x01(\v2\x1e.tragedylooper.v1.ErrorMessageH\x00R\x05error\x12)\n" +
+	"\x03ack\x18\x06 \x01(\v2\x15.tragedylooper.v1.AckH\x00R\x03ack\x12,\n" +
+	"\x04pong\x18\a \x01(\v2\x16.tragedylooper.v1.PongH\x00R\x04pong\x12A\n" +
+	"\flobby_update\x18\b \x01(\v2\x1c.tragedylooper.v1.LobbyStateH\x00R\vlobbyUpdate\x12<\n" +
+	"\n" +
+	"view_delta\x18\t \x01(\v2\x1b.tragedylooper.v1.ViewDeltaH\x00R\tviewDeltaB\t\n" +
+	"\amessage\"\x87\x01\n" +
+	"\x0fJoinRoomRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12#\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\x12\x19\n" +
+	"\blast_seq\x18\x04 \x01(\x04R\alastSeq\"\xfe\x01\n" +
+	"\n" +
+	"JoinedRoom\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x120\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\x04role\x12\x18\n" +
+	"\aresumed\x18\x04 \x01(\bR\aresumed\x12\x1c\n" +
+	"\tspectator\x18\x05 \x01(\bR\tspectator\x12#\n" +
+	"\rdelay_seconds\x18\x06 \x01(\x05R\fdelaySeconds\x12+\n" +
+	"\x11history_truncated\x18\a \x01(\bR\x10historyTruncated\"G\n" +
+	"\x0fSpectateRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tfull_view\x18\x02 \x01(\bR\bfullView\"W\n" +
+	"\bSendChat\x127\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x1d.tragedylooper.v1.ChatChannelR\achannel\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"T\n" +
+	"\x13SubmitActionRequest\x12=\n" +
+	"\x06action\x18\x01 \x01(\v2%.tragedylooper.v1.PlayerActionPayloadR\x06action\"X\n" +
+	"\n" +
+	"ViewUpdate\x120\n" +
+	"\x04view\x18\x01 \x01(\v2\x1c.tragedylooper.v1.PlayerViewR\x04view\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"\r\n" +
+	"\vRequestView\"5\n" +
+	"\x04Ping\x12-\n" +
+	"\x13client_time_unix_ms\x18\x01 \x01(\x03R\x10clientTimeUnixMs\"d\n" +
+	"\x04Pong\x12-\n" +
+	"\x13client_time_unix_ms\x18\x01 \x01(\x03R\x10clientTimeUnixMs\x12-\n" +
+	"\x13server_time_unix_ms\x18\x02 \x01(\x03R\x10serverTimeUnixMs\"\x17\n" +
+	"\x03Ack\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x04R\x03seq\"t\n" +
+	"\fErrorMessage\x12/\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x1b.tragedylooper.v1.ErrorCodeR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\breply_to\x18\x03 \x01(\x04R\areplyTo\"\xcd\x01\n" +
+	"\tLobbySeat\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\x04role\x12\x15\n" +
+	"\x06is_llm\x18\x04 \x01(\bR\x05isLlm\x12\x14\n" +
+	"\x05ready\x18\x05 \x01(\bR\x05ready\x12\x1c\n" +
+	"\tconnected\x18\x06 \x01(\bR\tconnected\x12\x12\n" +
+	"\x04host\x18\a \x01(\bR\x04host\"\x84\x04\n" +
+	"\n" +
+	"LobbyState\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x121\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x1b.tragedylooper.v1.RoomStateR\x05state\x12\x1b\n" +
+	"\tscript_id\x18\x03 \x01(\tR\bscriptId\x12\x19\n" +
+	"\bmodel_id\x18\x04 \x01(\x05R\amodelId\x12F\n" +
+	"\x0edifficulty_set\x18\x05 \x01(\v2\x1f.tragedylooper.v1.DifficultySetR\rdifficultySet\x12 \n" +
+	"\ffill_with_ai\x18\x06 \x01(\bR\n" +
+	"fillWithAi\x121\n" +
+	"\x05seats\x18\a \x03(\v2\x1b.tragedylooper.v1.LobbySeatR\x05seats\x12)\n" +
+	"\x10max_protagonists\x18\b \x01(\x05R\x0fmaxProtagonists\x12\x1e\n" +
+	"\n" +
+	"spectators\x18\t \x01(\x05R\n" +
+	"spectators\x12H\n" +
+	"!spectator_full_view_delay_seconds\x18\n" +
+	" \x01(\x05R\x1dspectatorFullViewDelaySeconds\x12\x1f\n" +
+	"\vscript_name\x18\v \x01(\tR\n" +
+	"scriptName\x12\x1f\n" +
+	"\vmodel_title\x18\f \x01(\tR\n" +
+	"modelTitle\" \n" +
+	"\bSetReady\x12\x14\n" +
+	"\x05ready\x18\x01 \x01(\bR\x05ready*\x98\x03\n" +
+	"\tErrorCode\x12\x1a\n" +
+	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aERROR_CODE_INVALID_MESSAGE\x10\x01\x12\x1a\n" +
+	"\x16ERROR_CODE_NOT_IN_ROOM\x10\x02\x12\x1e\n" +
+	"\x1aERROR_CODE_ALREADY_IN_ROOM\x10\x03\x12\x1d\n" +
+	"\x19ERROR_CODE_ROOM_NOT_FOUND\x10\x04\x12\x1f\n" +
+	"\x1bERROR_CODE_PLAYER_NOT_FOUND\x10\x05\x12\x17\n" +
+	"\x13ERROR_CODE_INTERNAL\x10\x06\x12\x1e\n" +
+	"\x1aERROR_CODE_INVALID_SESSION\x10\a\x12\x1f\n" +
+	"\x1bERROR_CODE_GAME_NOT_STARTED\x10\b\x12#\n" +
+	"\x1fERROR_CODE_GAME_ALREADY_STARTED\x10\t\x12\x18\n" +
+	"\x14ERROR_CODE_FORBIDDEN\x10\n" +
+	"\x12\x1b\n" +
+	"\x17ERROR_CODE_RATE_LIMITED\x10\v\x12\x1d\n" +
+	"\x19ERROR_CODE_ILLEGAL_ACTION\x10\f*\x88\x01\n" +
+	"\tRoomState\x12\x1a\n" +
+	"\x16ROOM_STATE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ROOM_STATE_LOBBY\x10\x01\x12\x16\n" +
+	"\x12ROOM_STATE_RUNNING\x10\x02\x12\x17\n" +
+	"\x13ROOM_STATE_FINISHED\x10\x03\x12\x18\n" +
+	"\x14ROOM_STATE_ABANDONED\x10\x04B\xbd\x01\n" +
+	"\x14com.tragedylooper.v1B\rProtocolProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
+
+var (
+	file_tragedylooper_v1_protocol_proto_rawDescOnce sync.Once
+	file_tragedylooper_v1_protocol_proto_rawDescData []byte
+)
+
+func file_tragedylooper_v1_protocol_proto_rawDescGZIP() []byte {
+	file_tragedylooper_v1_protocol_proto_rawDescOnce.Do(func() {
+		file_tragedylooper_v1_protocol_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_protocol_proto_rawDesc), len(file_tragedylooper_v1_protocol_proto_rawDesc)))
+	})
+	return file_tragedylooper_v1_protocol_proto_rawDescData
+}
+
+var file_tragedylooper_v1_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_tragedylooper_v1_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_tragedylooper_v1_protocol_proto_goTypes = []any{
+	(ErrorCode)(0),              // 0: tragedylooper.v1.ErrorCode
+	(RoomState)(0),              // 1: tragedylooper.v1.RoomState
+	(*ClientMessage)(nil),       // 2: tragedylooper.v1.ClientMessage
+	(*ServerMessage)(nil),       // 3: tragedylooper.v1.ServerMessage
+	(*JoinRoomRequest)(nil),     // 4: tragedylooper.v1.JoinRoomRequest
+	(*JoinedRoom)(nil),          // 5: tragedylooper.v1.JoinedRoom
+	(*SpectateRequest)(nil),     // 6: tragedylooper.v1.SpectateRequest
+	(*SendChat)(nil),            // 7: tragedylooper.v1.SendChat
+	(*SubmitActionRequest)(nil), // 8: tragedylooper.v1.SubmitActionRequest
+	(*ViewUpdate)(nil),          // 9: tragedylooper.v1.ViewUpdate
+	(*RequestView)(nil),         // 10: tragedylooper.v1.RequestView
+	(*Ping)(nil),                // 11: tragedylooper.v1.Ping
+	(*Pong)(nil),                // 12: tragedylooper.v1.Pong
+	(*Ack)(nil),                 // 13: tragedylooper.v1.Ack
+	(*ErrorMessage)(nil),        // 14: tragedylooper.v1.ErrorMessage
+	(*LobbySeat)(nil),           // 15: tragedylooper.v1.LobbySeat
+	(*LobbyState)(nil),          // 16: tragedylooper.v1.LobbyState
+	(*SetReady)(nil),            // 17: tragedylooper.v1.SetReady
+	(*ChooseOptionPayload)(nil), // 18: tragedylooper.v1.ChooseOptionPayload
+	(*GameEvent)(nil),           // 19: tragedylooper.v1.GameEvent
+	(*ViewDelta)(nil),           // 20: tragedylooper.v1.ViewDelta
+	(PlayerRole)(0),             // 21: tragedylooper.v1.PlayerRole
+	(ChatChannel)(0),            // 22: tragedylooper.v1.ChatChannel
+	(*PlayerActionPayload)(nil), // 23: tragedylooper.v1.PlayerActionPayload
+	(*PlayerView)(nil),          // 24: tragedylooper.v1.PlayerView
+	(*DifficultySet)(nil),       // 25: tragedylooper.v1.DifficultySet
+}
+var file_tragedylooper_v1_protocol_proto_depIdxs = []int32{
+	4,  // 0: tragedylooper.v1.ClientMessage.join_room:type_name -> tragedylooper.v1.JoinRoomRequest
+	8,  // 1: tragedylooper.v1.ClientMessage.submit_action:type_name -> tragedylooper.v1.SubmitActionRequest
+	18, // 2: tragedylooper.v1.ClientMessage.choose_option:type_name -> tragedylooper.v1.ChooseOptionPayload
+	11, // 3: tragedylooper.v1.ClientMessage.ping:type_name -> tragedylooper.v1.Ping
+	13, // 4: tragedylooper.v1.ClientMessage.ack:type_name -> tragedylooper.v1.Ack
+	17, // 5: tragedylooper.v1.ClientMessage.set_ready:type_name -> tragedylooper.v1.SetReady
+	6,  // 6: tragedylooper.v1.ClientMessage.spectate:type_name -> tragedylooper.v1.SpectateRequest
+	7,  // 7: tragedylooper.v1.ClientMessage.send_chat:type_name -> tragedylooper.v1.SendChat
+	10, // 8: tragedylooper.v1.ClientMessage.request_view:type_name -> tragedylooper.v1.RequestView
+	5,  // 9: tragedylooper.v1.ServerMessage.joined:type_name -> tragedylooper.v1.JoinedRoom
+	9,  // 10: tragedylooper.v1.ServerMessage.view_update:type_name -> tragedylooper.v1.ViewUpdate
+	19, // 11: tragedylooper.v1.ServerMessage.event:type_name -> tragedylooper.v1.GameEvent
+	14, // 12: tragedylooper.v1.ServerMessage.error:type_name -> tragedylooper.v1.ErrorMessage
+	13, // 13: tragedylooper.v1.ServerMessage.ack:type_name -> tragedylooper.v1.Ack
+	12, // 14: tragedylooper.v1.ServerMessage.pong:type_name -> tragedylooper.v1.Pong
+	16, // 15: tragedylooper.v1.ServerMessage.lobby_update:type_name -> tragedylooper.v1.LobbyState
+	20, // 16: tragedylooper.v1.ServerMessage.view_delta:type_name -> tragedylooper.v1.ViewDelta
+	21, // 17: tragedylooper.v1.JoinedRoom.role:type_name -> tragedylooper.v1.PlayerRole
+	22, // 18: tragedylooper.v1.SendChat.channel:type_name -> tragedylooper.v1.ChatChannel
+	23, // 19: tragedylooper.v1.SubmitActionRequest.action:type_name -> tragedylooper.v1.PlayerActionPayload
+	24, // 20: tragedylooper.v1.ViewUpdate.view:type_name -> tragedylooper.v1.PlayerView
+	0,  // 21: tragedylooper.v1.ErrorMessage.code:type_name -> tragedylooper.v1.ErrorCode
+	21, // 22: tragedylooper.v1.LobbySeat.role:type_name -> tragedylooper.v1.PlayerRole
+	1,  // 23: tragedylooper.v1.LobbyState.state:type_name -> tragedylooper.v1.RoomState
+	25, // 24: tragedylooper.v1.LobbyState.difficulty_set:type_name -> tragedylooper.v1.DifficultySet
+	15, // 25: tragedylooper.v1.LobbyState.seats:type_name -> tragedylooper.v1.LobbySeat
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_tragedylooper_v1_protocol_proto_init() }
+func file_tragedylooper_v1_protocol_proto_init() {
+	if File_tragedylooper_v1_protocol_proto != nil {
+		return
+	}
+	file_tragedylooper_v1_enums_proto_init()
+	file_tragedylooper_v1_event_proto_init()
+	file_tragedylooper_v1_game_proto_init()
+	file_tragedylooper_v1_payload_proto_init()
+	file_tragedylooper_v1_script_proto_init()
+	file_tragedylooper_v1_protocol_proto_msgTypes[0].OneofWrappers = []any{
+		(*ClientMessage_JoinRoom)(nil),
+		(*ClientMessage_SubmitAction)(nil),
+		(*ClientMessage_ChooseOption)(nil),
+		(*ClientMessage_Ping)(nil),
+		(*ClientMessage_Ack)(nil),
+		(*ClientMessage_SetReady)(nil),
+		(*ClientMessage_Spectate)(nil),
+		(*ClientMessage_SendChat)(nil),
+		(*ClientMessage_RequestView)(nil),
+	}
+	file_tragedylooper_v1_protocol_proto_msgTypes[1].OneofWrappers = []any{
+		(*ServerMessage_Joined)(nil),
+		(*ServerMessage_ViewUpdate)(nil),
+		(*ServerMessage_Event)(nil),
+		(*ServerMessage_Error)(nil),
+		(*ServerMessage_Ack)(nil),
+		(*ServerMessage_Pong)(nil),
+		(*ServerMessage_LobbyUpdate)(nil),
+		(*ServerMessage_ViewDelta)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_protocol_proto_rawDesc), len(file_tragedylooper_v1_protocol_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tragedylooper_v1_protocol_proto_goTypes,
+		DependencyIndexes: file_tragedylooper_v1_protocol_proto_depIdxs,
+		EnumInfos:         file_tragedylooper_v1_protocol_proto_enumTypes,
+		MessageInfos:      file_tragedylooper_v1_protocol_proto_msgTypes,
+	}.Build()
+	File_tragedylooper_v1_protocol_proto = out.File
+	file_tragedylooper_v1_protocol_proto_goTypes = nil
+	file_tragedylooper_v1_protocol_proto_depIdxs = nil
+}
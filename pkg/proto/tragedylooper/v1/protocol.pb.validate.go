@@ -0,0 +1,2538 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: tragedylooper/v1/protocol.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ClientMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClientMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClientMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClientMessageMultiError, or
+// nil if none found.
+func (m *ClientMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClientMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	switch v := m.Message.(type) {
+	case *ClientMessage_JoinRoom:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetJoinRoom()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "JoinRoom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "JoinRoom",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJoinRoom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "JoinRoom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_SubmitAction:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSubmitAction()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "SubmitAction",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "SubmitAction",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSubmitAction()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "SubmitAction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_ChooseOption:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetChooseOption()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "ChooseOption",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "ChooseOption",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetChooseOption()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "ChooseOption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_Ping:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPing()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPing()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "Ping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_Ack:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAck()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAck()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_SetReady:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSetReady()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "SetReady",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "SetReady",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSetReady()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "SetReady",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_Spectate:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSpectate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Spectate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "Spectate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSpectate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "Spectate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_SendChat:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSendChat()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "SendChat",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "SendChat",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendChat()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "SendChat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ClientMessage_RequestView:
+		if v == nil {
+			err := ClientMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRequestView()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "RequestView",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClientMessageValidationError{
+						field:  "RequestView",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRequestView()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClientMessageValidationError{
+					field:  "RequestView",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ClientMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClientMessageMultiError is an error wrapping multiple validation errors
+// returned by ClientMessage.ValidateAll() if the designated constraints
+// aren't met.
+type ClientMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClientMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClientMessageMultiError) AllErrors() []error { return m }
+
+// ClientMessageValidationError is the validation error returned by
+// ClientMessage.Validate if the designated constraints aren't met.
+type ClientMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClientMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClientMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClientMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClientMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClientMessageValidationError) ErrorName() string { return "ClientMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClientMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClientMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClientMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClientMessageValidationError{}
+
+// Validate checks the field values on ServerMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServerMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServerMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServerMessageMultiError, or
+// nil if none found.
+func (m *ServerMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServerMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	switch v := m.Message.(type) {
+	case *ServerMessage_Joined:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetJoined()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Joined",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Joined",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJoined()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "Joined",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_ViewUpdate:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetViewUpdate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "ViewUpdate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "ViewUpdate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetViewUpdate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "ViewUpdate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_Event:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetEvent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Event",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Event",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_Error:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_Ack:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAck()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Ack",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAck()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "Ack",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_Pong:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPong()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Pong",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Pong",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPong()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "Pong",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_LobbyUpdate:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLobbyUpdate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "LobbyUpdate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "LobbyUpdate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLobbyUpdate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "LobbyUpdate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_ViewDelta:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetViewDelta()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "ViewDelta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "ViewDelta",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetViewDelta()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "ViewDelta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ServerMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServerMessageMultiError is an error wrapping multiple validation errors
+// returned by ServerMessage.ValidateAll() if the designated constraints
+// aren't met.
+type ServerMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServerMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServerMessageMultiError) AllErrors() []error { return m }
+
+// ServerMessageValidationError is the validation error returned by
+// ServerMessage.Validate if the designated constraints aren't met.
+type ServerMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServerMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServerMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServerMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServerMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServerMessageValidationError) ErrorName() string { return "ServerMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServerMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServerMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServerMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServerMessageValidationError{}
+
+// Validate checks the field values on JoinRoomRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JoinRoomRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinRoomRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JoinRoomRequestMultiError, or nil if none found.
+func (m *JoinRoomRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinRoomRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for PlayerId
+
+	// no validation rules for SessionToken
+
+	// no validation rules for LastSeq
+
+	if len(errors) > 0 {
+		return JoinRoomRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinRoomRequestMultiError is an error wrapping multiple validation errors
+// returned by JoinRoomRequest.ValidateAll() if the designated constraints
+// aren't met.
+type JoinRoomRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinRoomRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinRoomRequestMultiError) AllErrors() []error { return m }
+
+// JoinRoomRequestValidationError is the validation error returned by
+// JoinRoomRequest.Validate if the designated constraints aren't met.
+type JoinRoomRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinRoomRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinRoomRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinRoomRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinRoomRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinRoomRequestValidationError) ErrorName() string { return "JoinRoomRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JoinRoomRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinRoomRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinRoomRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinRoomRequestValidationError{}
+
+// Validate checks the field values on JoinedRoom with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JoinedRoom) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JoinedRoom with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JoinedRoomMultiError, or
+// nil if none found.
+func (m *JoinedRoom) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JoinedRoom) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for PlayerId
+
+	// no validation rules for Role
+
+	// no validation rules for Resumed
+
+	// no validation rules for Spectator
+
+	// no validation rules for DelaySeconds
+
+	// no validation rules for HistoryTruncated
+
+	if len(errors) > 0 {
+		return JoinedRoomMultiError(errors)
+	}
+
+	return nil
+}
+
+// JoinedRoomMultiError is an error wrapping multiple validation errors
+// returned by JoinedRoom.ValidateAll() if the designated constraints aren't met.
+type JoinedRoomMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JoinedRoomMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JoinedRoomMultiError) AllErrors() []error { return m }
+
+// JoinedRoomValidationError is the validation error returned by
+// JoinedRoom.Validate if the designated constraints aren't met.
+type JoinedRoomValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JoinedRoomValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JoinedRoomValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JoinedRoomValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JoinedRoomValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JoinedRoomValidationError) ErrorName() string { return "JoinedRoomValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JoinedRoomValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJoinedRoom.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JoinedRoomValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JoinedRoomValidationError{}
+
+// Validate checks the field values on SpectateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SpectateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpectateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpectateRequestMultiError, or nil if none found.
+func (m *SpectateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpectateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for FullView
+
+	if len(errors) > 0 {
+		return SpectateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpectateRequestMultiError is an error wrapping multiple validation errors
+// returned by SpectateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SpectateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpectateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpectateRequestMultiError) AllErrors() []error { return m }
+
+// SpectateRequestValidationError is the validation error returned by
+// SpectateRequest.Validate if the designated constraints aren't met.
+type SpectateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpectateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpectateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpectateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpectateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpectateRequestValidationError) ErrorName() string { return "SpectateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SpectateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpectateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpectateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpectateRequestValidationError{}
+
+// Validate checks the field values on SendChat with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendChat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendChat with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendChatMultiError, or nil
+// if none found.
+func (m *SendChat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendChat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Channel
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return SendChatMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendChatMultiError is an error wrapping multiple validation errors returned
+// by SendChat.ValidateAll() if the designated constraints aren't met.
+type SendChatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendChatMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendChatMultiError) AllErrors() []error { return m }
+
+// SendChatValidationError is the validation error returned by
+// SendChat.Validate if the designated constraints aren't met.
+type SendChatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendChatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendChatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendChatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendChatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendChatValidationError) ErrorName() string { return "SendChatValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendChatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendChat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendChatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendChatValidationError{}
+
+// Validate checks the field values on SubmitActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitActionRequestMultiError, or nil if none found.
+func (m *SubmitActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitActionRequestValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitActionRequestValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitActionRequestValidationError{
+				field:  "Action",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitActionRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitActionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitActionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitActionRequestMultiError) AllErrors() []error { return m }
+
+// SubmitActionRequestValidationError is the validation error returned by
+// SubmitActionRequest.Validate if the designated constraints aren't met.
+type SubmitActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitActionRequestValidationError) ErrorName() string {
+	return "SubmitActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitActionRequestValidationError{}
+
+// Validate checks the field values on ViewUpdate with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ViewUpdate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ViewUpdate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ViewUpdateMultiError, or
+// nil if none found.
+func (m *ViewUpdate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ViewUpdate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetView()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ViewUpdateValidationError{
+					field:  "View",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ViewUpdateValidationError{
+					field:  "View",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetView()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ViewUpdateValidationError{
+				field:  "View",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return ViewUpdateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ViewUpdateMultiError is an error wrapping multiple validation errors
+// returned by ViewUpdate.ValidateAll() if the designated constraints aren't met.
+type ViewUpdateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ViewUpdateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ViewUpdateMultiError) AllErrors() []error { return m }
+
+// ViewUpdateValidationError is the validation error returned by
+// ViewUpdate.Validate if the designated constraints aren't met.
+type ViewUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ViewUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ViewUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ViewUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ViewUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ViewUpdateValidationError) ErrorName() string { return "ViewUpdateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ViewUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sViewUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ViewUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ViewUpdateValidationError{}
+
+// Validate checks the field values on RequestView with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RequestView) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestView with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RequestViewMultiError, or
+// nil if none found.
+func (m *RequestView) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestView) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestViewMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestViewMultiError is an error wrapping multiple validation errors
+// returned by RequestView.ValidateAll() if the designated constraints aren't met.
+type RequestViewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestViewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestViewMultiError) AllErrors() []error { return m }
+
+// RequestViewValidationError is the validation error returned by
+// RequestView.Validate if the designated constraints aren't met.
+type RequestViewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestViewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestViewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestViewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestViewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestViewValidationError) ErrorName() string { return "RequestViewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RequestViewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestView.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestViewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestViewValidationError{}
+
+// Validate checks the field values on Ping with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Ping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Ping with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PingMultiError, or nil if none found.
+func (m *Ping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Ping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientTimeUnixMs
+
+	if len(errors) > 0 {
+		return PingMultiError(errors)
+	}
+
+	return nil
+}
+
+// PingMultiError is an error wrapping multiple validation errors returned by
+// Ping.ValidateAll() if the designated constraints aren't met.
+type PingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PingMultiError) AllErrors() []error { return m }
+
+// PingValidationError is the validation error returned by Ping.Validate if the
+// designated constraints aren't met.
+type PingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PingValidationError) ErrorName() string { return "PingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PingValidationError{}
+
+// Validate checks the field values on Pong with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Pong) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Pong with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in PongMultiError, or nil if none found.
+func (m *Pong) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Pong) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClientTimeUnixMs
+
+	// no validation rules for ServerTimeUnixMs
+
+	if len(errors) > 0 {
+		return PongMultiError(errors)
+	}
+
+	return nil
+}
+
+// PongMultiError is an error wrapping multiple validation errors returned by
+// Pong.ValidateAll() if the designated constraints aren't met.
+type PongMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PongMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PongMultiError) AllErrors() []error { return m }
+
+// PongValidationError is the validation error returned by Pong.Validate if the
+// designated constraints aren't met.
+type PongValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PongValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PongValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PongValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PongValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PongValidationError) ErrorName() string { return "PongValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PongValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPong.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PongValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PongValidationError{}
+
+// Validate checks the field values on Ack with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Ack) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Ack with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AckMultiError, or nil if none found.
+func (m *Ack) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Ack) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	if len(errors) > 0 {
+		return AckMultiError(errors)
+	}
+
+	return nil
+}
+
+// AckMultiError is an error wrapping multiple validation errors returned by
+// Ack.ValidateAll() if the designated constraints aren't met.
+type AckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AckMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AckMultiError) AllErrors() []error { return m }
+
+// AckValidationError is the validation error returned by Ack.Validate if the
+// designated constraints aren't met.
+type AckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AckValidationError) ErrorName() string { return "AckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AckValidationError{}
+
+// Validate checks the field values on ErrorMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ErrorMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ErrorMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ErrorMessageMultiError, or
+// nil if none found.
+func (m *ErrorMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ErrorMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	// no validation rules for ReplyTo
+
+	if len(errors) > 0 {
+		return ErrorMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// ErrorMessageMultiError is an error wrapping multiple validation errors
+// returned by ErrorMessage.ValidateAll() if the designated constraints aren't met.
+type ErrorMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ErrorMessageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ErrorMessageMultiError) AllErrors() []error { return m }
+
+// ErrorMessageValidationError is the validation error returned by
+// ErrorMessage.Validate if the designated constraints aren't met.
+type ErrorMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ErrorMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ErrorMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ErrorMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ErrorMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ErrorMessageValidationError) ErrorName() string { return "ErrorMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ErrorMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sErrorMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ErrorMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ErrorMessageValidationError{}
+
+// Validate checks the field values on LobbySeat with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LobbySeat) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LobbySeat with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LobbySeatMultiError, or nil
+// if none found.
+func (m *LobbySeat) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LobbySeat) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlayerId
+
+	// no validation rules for Name
+
+	// no validation rules for Role
+
+	// no validation rules for IsLlm
+
+	// no validation rules for Ready
+
+	// no validation rules for Connected
+
+	// no validation rules for Host
+
+	if len(errors) > 0 {
+		return LobbySeatMultiError(errors)
+	}
+
+	return nil
+}
+
+// LobbySeatMultiError is an error wrapping multiple validation errors returned
+// by LobbySeat.ValidateAll() if the designated constraints aren't met.
+type LobbySeatMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LobbySeatMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LobbySeatMultiError) AllErrors() []error { return m }
+
+// LobbySeatValidationError is the validation error returned by
+// LobbySeat.Validate if the designated constraints aren't met.
+type LobbySeatValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LobbySeatValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LobbySeatValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LobbySeatValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LobbySeatValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LobbySeatValidationError) ErrorName() string { return "LobbySeatValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LobbySeatValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLobbySeat.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LobbySeatValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LobbySeatValidationError{}
+
+// Validate checks the field values on LobbyState with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LobbyState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LobbyState with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LobbyStateMultiError, or
+// nil if none found.
+func (m *LobbyState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LobbyState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for State
+
+	// no validation rules for ScriptId
+
+	// no validation rules for ModelId
+
+	if all {
+		switch v := interface{}(m.GetDifficultySet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LobbyStateValidationError{
+					field:  "DifficultySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LobbyStateValidationError{
+					field:  "DifficultySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDifficultySet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LobbyStateValidationError{
+				field:  "DifficultySet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FillWithAi
+
+	for idx, item := range m.GetSeats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LobbyStateValidationError{
+						field:  fmt.Sprintf("Seats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LobbyStateValidationError{
+						field:  fmt.Sprintf("Seats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LobbyStateValidationError{
+					field:  fmt.Sprintf("Seats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for MaxProtagonists
+
+	// no validation rules for Spectators
+
+	// no validation rules for SpectatorFullViewDelaySeconds
+
+	// no validation rules for ScriptName
+
+	// no validation rules for ModelTitle
+
+	if len(errors) > 0 {
+		return LobbyStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// LobbyStateMultiError is an error wrapping multiple validation errors
+// returned by LobbyState.ValidateAll() if the designated constraints aren't met.
+type LobbyStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LobbyStateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LobbyStateMultiError) AllErrors() []error { return m }
+
+// LobbyStateValidationError is the validation error returned by
+// LobbyState.Validate if the designated constraints aren't met.
+type LobbyStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LobbyStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LobbyStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LobbyStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LobbyStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LobbyStateValidationError) ErrorName() string { return "LobbyStateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LobbyStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLobbyState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LobbyStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LobbyStateValidationError{}
+
+// Validate checks the field values on SetReady with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SetReady) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetReady with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SetReadyMultiError, or nil
+// if none found.
+func (m *SetReady) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetReady) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ready
+
+	if len(errors) > 0 {
+		return SetReadyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetReadyMultiError is an error wrapping multiple validation errors returned
+// by SetReady.ValidateAll() if the designated constraints aren't met.
+type SetReadyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetReadyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetReadyMultiError) AllErrors() []error { return m }
+
+// SetReadyValidationError is the validation error returned by
+// SetReady.Validate if the designated constraints aren't met.
+type SetReadyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetReadyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetReadyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetReadyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetReadyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetReadyValidationError) ErrorName() string { return "SetReadyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetReadyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetReady.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetReadyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetReadyValidationError{}
@@ -1019,6 +1019,370 @@ func (x *PlotConfig) GetRoleAssignments() map[int32]int32 {
 	return nil
 }
 
+// ScriptSheetIncident 是剧本表中按日期列出的一个事件。
+type ScriptSheetIncident struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 事件发生的日期
+	Day int32 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	// 事件名称
+	Incident string `protobuf:"bytes,2,opt,name=incident,proto3" json:"incident,omitempty"`
+	// 事件的罪魁祸首，仅在私有剧本表中填写
+	Culprit string `protobuf:"bytes,3,opt,name=culprit,proto3" json:"culprit,omitempty"`
+	// 对应的事件配置 ID；剧本中找不到该事件的配置时为 0
+	IncidentId    int32 `protobuf:"varint,4,opt,name=incident_id,json=incidentId,proto3" json:"incident_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScriptSheetIncident) Reset() {
+	*x = ScriptSheetIncident{}
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScriptSheetIncident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptSheetIncident) ProtoMessage() {}
+
+func (x *ScriptSheetIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptSheetIncident.ProtoReflect.Descriptor instead.
+func (*ScriptSheetIncident) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_script_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScriptSheetIncident) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *ScriptSheetIncident) GetIncident() string {
+	if x != nil {
+		return x.Incident
+	}
+	return ""
+}
+
+func (x *ScriptSheetIncident) GetCulprit() string {
+	if x != nil {
+		return x.Culprit
+	}
+	return ""
+}
+
+func (x *ScriptSheetIncident) GetIncidentId() int32 {
+	if x != nil {
+		return x.IncidentId
+	}
+	return 0
+}
+
+// ScriptSheetCast 是私有剧本表中的一条角色身份分配。
+type ScriptSheetCast struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 角色名称
+	Character string `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	// 身份名称
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScriptSheetCast) Reset() {
+	*x = ScriptSheetCast{}
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScriptSheetCast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptSheetCast) ProtoMessage() {}
+
+func (x *ScriptSheetCast) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScriptSheetCast.ProtoReflect.Descriptor instead.
+func (*ScriptSheetCast) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_script_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScriptSheetCast) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *ScriptSheetCast) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// PublicScriptSheet 是所有玩家都能看到的公开剧本表。
+type PublicScriptSheet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 剧本名称
+	ScriptName string `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	// 剧本标题
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// 悲剧集名称
+	TragedySet string `protobuf:"bytes,3,opt,name=tragedy_set,json=tragedySet,proto3" json:"tragedy_set,omitempty"`
+	// 循环次数
+	LoopCount int32 `protobuf:"varint,4,opt,name=loop_count,json=loopCount,proto3" json:"loop_count,omitempty"`
+	// 每个循环的天数
+	DaysPerLoop int32 `protobuf:"varint,5,opt,name=days_per_loop,json=daysPerLoop,proto3" json:"days_per_loop,omitempty"`
+	// 难度等级，未知时为 0
+	Difficulty int32 `protobuf:"varint,6,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// 主角是否可以讨论
+	CanDiscuss bool `protobuf:"varint,7,opt,name=can_discuss,json=canDiscuss,proto3" json:"can_discuss,omitempty"`
+	// 按日期排列的事件（不含罪魁祸首）
+	Incidents []*ScriptSheetIncident `protobuf:"bytes,8,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	// 特殊规则列表
+	SpecialRules []string `protobuf:"bytes,9,rep,name=special_rules,json=specialRules,proto3" json:"special_rules,omitempty"`
+	// 登场角色名称列表
+	Characters    []string `protobuf:"bytes,10,rep,name=characters,proto3" json:"characters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicScriptSheet) Reset() {
+	*x = PublicScriptSheet{}
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicScriptSheet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicScriptSheet) ProtoMessage() {}
+
+func (x *PublicScriptSheet) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicScriptSheet.ProtoReflect.Descriptor instead.
+func (*PublicScriptSheet) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_script_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PublicScriptSheet) GetScriptName() string {
+	if x != nil {
+		return x.ScriptName
+	}
+	return ""
+}
+
+func (x *PublicScriptSheet) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PublicScriptSheet) GetTragedySet() string {
+	if x != nil {
+		return x.TragedySet
+	}
+	return ""
+}
+
+func (x *PublicScriptSheet) GetLoopCount() int32 {
+	if x != nil {
+		return x.LoopCount
+	}
+	return 0
+}
+
+func (x *PublicScriptSheet) GetDaysPerLoop() int32 {
+	if x != nil {
+		return x.DaysPerLoop
+	}
+	return 0
+}
+
+func (x *PublicScriptSheet) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *PublicScriptSheet) GetCanDiscuss() bool {
+	if x != nil {
+		return x.CanDiscuss
+	}
+	return false
+}
+
+func (x *PublicScriptSheet) GetIncidents() []*ScriptSheetIncident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *PublicScriptSheet) GetSpecialRules() []string {
+	if x != nil {
+		return x.SpecialRules
+	}
+	return nil
+}
+
+func (x *PublicScriptSheet) GetCharacters() []string {
+	if x != nil {
+		return x.Characters
+	}
+	return nil
+}
+
+// PrivateScriptSheet 是仅主谋可见的私有剧本表。
+type PrivateScriptSheet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 对应的公开剧本表
+	PublicSheet *PublicScriptSheet `protobuf:"bytes,1,opt,name=public_sheet,json=publicSheet,proto3" json:"public_sheet,omitempty"`
+	// 主线剧情名称
+	MainPlot string `protobuf:"bytes,2,opt,name=main_plot,json=mainPlot,proto3" json:"main_plot,omitempty"`
+	// 支线剧情名称列表
+	SubPlots []string `protobuf:"bytes,3,rep,name=sub_plots,json=subPlots,proto3" json:"sub_plots,omitempty"`
+	// 角色身份分配
+	Cast []*ScriptSheetCast `protobuf:"bytes,4,rep,name=cast,proto3" json:"cast,omitempty"`
+	// 按日期排列的事件（含罪魁祸首）
+	Incidents []*ScriptSheetIncident `protobuf:"bytes,5,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	// 胜利条件描述
+	VictoryConditions string `protobuf:"bytes,6,opt,name=victory_conditions,json=victoryConditions,proto3" json:"victory_conditions,omitempty"`
+	// 剧本故事情节
+	Story string `protobuf:"bytes,7,opt,name=story,proto3" json:"story,omitempty"`
+	// 主谋提示信息
+	MastermindHints string `protobuf:"bytes,8,opt,name=mastermind_hints,json=mastermindHints,proto3" json:"mastermind_hints,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PrivateScriptSheet) Reset() {
+	*x = PrivateScriptSheet{}
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateScriptSheet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateScriptSheet) ProtoMessage() {}
+
+func (x *PrivateScriptSheet) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_script_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateScriptSheet.ProtoReflect.Descriptor instead.
+func (*PrivateScriptSheet) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_script_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PrivateScriptSheet) GetPublicSheet() *PublicScriptSheet {
+	if x != nil {
+		return x.PublicSheet
+	}
+	return nil
+}
+
+func (x *PrivateScriptSheet) GetMainPlot() string {
+	if x != nil {
+		return x.MainPlot
+	}
+	return ""
+}
+
+func (x *PrivateScriptSheet) GetSubPlots() []string {
+	if x != nil {
+		return x.SubPlots
+	}
+	return nil
+}
+
+func (x *PrivateScriptSheet) GetCast() []*ScriptSheetCast {
+	if x != nil {
+		return x.Cast
+	}
+	return nil
+}
+
+func (x *PrivateScriptSheet) GetIncidents() []*ScriptSheetIncident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *PrivateScriptSheet) GetVictoryConditions() string {
+	if x != nil {
+		return x.VictoryConditions
+	}
+	return ""
+}
+
+func (x *PrivateScriptSheet) GetStory() string {
+	if x != nil {
+		return x.Story
+	}
+	return ""
+}
+
+func (x *PrivateScriptSheet) GetMastermindHints() string {
+	if x != nil {
+		return x.MastermindHints
+	}
+	return ""
+}
+
 var File_tragedylooper_v1_script_proto protoreflect.FileDescriptor
 
 const file_tragedylooper_v1_script_proto_rawDesc = "" +
@@ -1162,7 +1526,45 @@ const file_tragedylooper_v1_script_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2 .tragedylooper.v1.IncidentConfigR\x05value:\x028\x01\x1aB\n" +
 	"\x14RoleAssignmentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01B\xbb\x01\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"~\n" +
+	"\x13ScriptSheetIncident\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\x05R\x03day\x12\x1a\n" +
+	"\bincident\x18\x02 \x01(\tR\bincident\x12\x18\n" +
+	"\aculprit\x18\x03 \x01(\tR\aculprit\x12\x1f\n" +
+	"\vincident_id\x18\x04 \x01(\x05R\n" +
+	"incidentId\"C\n" +
+	"\x0fScriptSheetCast\x12\x1c\n" +
+	"\tcharacter\x18\x01 \x01(\tR\tcharacter\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\xf9\x02\n" +
+	"\x11PublicScriptSheet\x12\x1f\n" +
+	"\vscript_name\x18\x01 \x01(\tR\n" +
+	"scriptName\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1f\n" +
+	"\vtragedy_set\x18\x03 \x01(\tR\n" +
+	"tragedySet\x12\x1d\n" +
+	"\n" +
+	"loop_count\x18\x04 \x01(\x05R\tloopCount\x12\"\n" +
+	"\rdays_per_loop\x18\x05 \x01(\x05R\vdaysPerLoop\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x06 \x01(\x05R\n" +
+	"difficulty\x12\x1f\n" +
+	"\vcan_discuss\x18\a \x01(\bR\n" +
+	"canDiscuss\x12C\n" +
+	"\tincidents\x18\b \x03(\v2%.tragedylooper.v1.ScriptSheetIncidentR\tincidents\x12#\n" +
+	"\rspecial_rules\x18\t \x03(\tR\fspecialRules\x12\x1e\n" +
+	"\n" +
+	"characters\x18\n" +
+	" \x03(\tR\n" +
+	"characters\"\x82\x03\n" +
+	"\x12PrivateScriptSheet\x12F\n" +
+	"\fpublic_sheet\x18\x01 \x01(\v2#.tragedylooper.v1.PublicScriptSheetR\vpublicSheet\x12\x1b\n" +
+	"\tmain_plot\x18\x02 \x01(\tR\bmainPlot\x12\x1b\n" +
+	"\tsub_plots\x18\x03 \x03(\tR\bsubPlots\x125\n" +
+	"\x04cast\x18\x04 \x03(\v2!.tragedylooper.v1.ScriptSheetCastR\x04cast\x12C\n" +
+	"\tincidents\x18\x05 \x03(\v2%.tragedylooper.v1.ScriptSheetIncidentR\tincidents\x12-\n" +
+	"\x12victory_conditions\x18\x06 \x01(\tR\x11victoryConditions\x12\x14\n" +
+	"\x05story\x18\a \x01(\tR\x05story\x12)\n" +
+	"\x10mastermind_hints\x18\b \x01(\tR\x0fmastermindHintsB\xbb\x01\n" +
 	"\x14com.tragedylooper.v1B\vScriptProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
 
 var (
@@ -1177,79 +1579,87 @@ func file_tragedylooper_v1_script_proto_rawDescGZIP() []byte {
 	return file_tragedylooper_v1_script_proto_rawDescData
 }
 
-var file_tragedylooper_v1_script_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_tragedylooper_v1_script_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_tragedylooper_v1_script_proto_goTypes = []any{
-	(*ScriptConfig)(nil),     // 0: tragedylooper.v1.ScriptConfig
-	(*DifficultySet)(nil),    // 1: tragedylooper.v1.DifficultySet
-	(*IncidentInstance)(nil), // 2: tragedylooper.v1.IncidentInstance
-	(*CastRole)(nil),         // 3: tragedylooper.v1.CastRole
-	(*CastAssignment)(nil),   // 4: tragedylooper.v1.CastAssignment
-	(*ScriptMetadata)(nil),   // 5: tragedylooper.v1.ScriptMetadata
-	(*ScriptModel)(nil),      // 6: tragedylooper.v1.ScriptModel
-	(*PrivateConfig)(nil),    // 7: tragedylooper.v1.PrivateConfig
-	(*PublicConfig)(nil),     // 8: tragedylooper.v1.PublicConfig
-	(*RoleConfig)(nil),       // 9: tragedylooper.v1.RoleConfig
-	(*PlotConfig)(nil),       // 10: tragedylooper.v1.PlotConfig
-	nil,                      // 11: tragedylooper.v1.ScriptConfig.MainPlotsEntry
-	nil,                      // 12: tragedylooper.v1.ScriptConfig.SubPlotsEntry
-	nil,                      // 13: tragedylooper.v1.ScriptConfig.RolesEntry
-	nil,                      // 14: tragedylooper.v1.ScriptConfig.IncidentsEntry
-	nil,                      // 15: tragedylooper.v1.ScriptConfig.CharactersEntry
-	nil,                      // 16: tragedylooper.v1.ScriptConfig.MastermindCardsEntry
-	nil,                      // 17: tragedylooper.v1.ScriptConfig.ProtagonistCardsEntry
-	nil,                      // 18: tragedylooper.v1.ScriptConfig.ScriptModelsEntry
-	nil,                      // 19: tragedylooper.v1.CastRole.ExtraInfoEntry
-	nil,                      // 20: tragedylooper.v1.ScriptMetadata.CastEntry
-	nil,                      // 21: tragedylooper.v1.PrivateConfig.RoleAssignmentsEntry
-	nil,                      // 22: tragedylooper.v1.RoleConfig.AbilitiesEntry
-	nil,                      // 23: tragedylooper.v1.PlotConfig.IncidentIdsEntry
-	nil,                      // 24: tragedylooper.v1.PlotConfig.RoleAssignmentsEntry
-	(GoodwillRuleType)(0),    // 25: tragedylooper.v1.GoodwillRuleType
-	(PlotType)(0),            // 26: tragedylooper.v1.PlotType
-	(*IncidentConfig)(nil),   // 27: tragedylooper.v1.IncidentConfig
-	(*CharacterConfig)(nil),  // 28: tragedylooper.v1.CharacterConfig
-	(*CardConfig)(nil),       // 29: tragedylooper.v1.CardConfig
-	(*AbilityConfig)(nil),    // 30: tragedylooper.v1.AbilityConfig
+	(*ScriptConfig)(nil),        // 0: tragedylooper.v1.ScriptConfig
+	(*DifficultySet)(nil),       // 1: tragedylooper.v1.DifficultySet
+	(*IncidentInstance)(nil),    // 2: tragedylooper.v1.IncidentInstance
+	(*CastRole)(nil),            // 3: tragedylooper.v1.CastRole
+	(*CastAssignment)(nil),      // 4: tragedylooper.v1.CastAssignment
+	(*ScriptMetadata)(nil),      // 5: tragedylooper.v1.ScriptMetadata
+	(*ScriptModel)(nil),         // 6: tragedylooper.v1.ScriptModel
+	(*PrivateConfig)(nil),       // 7: tragedylooper.v1.PrivateConfig
+	(*PublicConfig)(nil),        // 8: tragedylooper.v1.PublicConfig
+	(*RoleConfig)(nil),          // 9: tragedylooper.v1.RoleConfig
+	(*PlotConfig)(nil),          // 10: tragedylooper.v1.PlotConfig
+	(*ScriptSheetIncident)(nil), // 11: tragedylooper.v1.ScriptSheetIncident
+	(*ScriptSheetCast)(nil),     // 12: tragedylooper.v1.ScriptSheetCast
+	(*PublicScriptSheet)(nil),   // 13: tragedylooper.v1.PublicScriptSheet
+	(*PrivateScriptSheet)(nil),  // 14: tragedylooper.v1.PrivateScriptSheet
+	nil,                         // 15: tragedylooper.v1.ScriptConfig.MainPlotsEntry
+	nil,                         // 16: tragedylooper.v1.ScriptConfig.SubPlotsEntry
+	nil,                         // 17: tragedylooper.v1.ScriptConfig.RolesEntry
+	nil,                         // 18: tragedylooper.v1.ScriptConfig.IncidentsEntry
+	nil,                         // 19: tragedylooper.v1.ScriptConfig.CharactersEntry
+	nil,                         // 20: tragedylooper.v1.ScriptConfig.MastermindCardsEntry
+	nil,                         // 21: tragedylooper.v1.ScriptConfig.ProtagonistCardsEntry
+	nil,                         // 22: tragedylooper.v1.ScriptConfig.ScriptModelsEntry
+	nil,                         // 23: tragedylooper.v1.CastRole.ExtraInfoEntry
+	nil,                         // 24: tragedylooper.v1.ScriptMetadata.CastEntry
+	nil,                         // 25: tragedylooper.v1.PrivateConfig.RoleAssignmentsEntry
+	nil,                         // 26: tragedylooper.v1.RoleConfig.AbilitiesEntry
+	nil,                         // 27: tragedylooper.v1.PlotConfig.IncidentIdsEntry
+	nil,                         // 28: tragedylooper.v1.PlotConfig.RoleAssignmentsEntry
+	(GoodwillRuleType)(0),       // 29: tragedylooper.v1.GoodwillRuleType
+	(PlotType)(0),               // 30: tragedylooper.v1.PlotType
+	(*IncidentConfig)(nil),      // 31: tragedylooper.v1.IncidentConfig
+	(*CharacterConfig)(nil),     // 32: tragedylooper.v1.CharacterConfig
+	(*CardConfig)(nil),          // 33: tragedylooper.v1.CardConfig
+	(*AbilityConfig)(nil),       // 34: tragedylooper.v1.AbilityConfig
 }
 var file_tragedylooper_v1_script_proto_depIdxs = []int32{
-	11, // 0: tragedylooper.v1.ScriptConfig.main_plots:type_name -> tragedylooper.v1.ScriptConfig.MainPlotsEntry
-	12, // 1: tragedylooper.v1.ScriptConfig.sub_plots:type_name -> tragedylooper.v1.ScriptConfig.SubPlotsEntry
-	13, // 2: tragedylooper.v1.ScriptConfig.roles:type_name -> tragedylooper.v1.ScriptConfig.RolesEntry
-	14, // 3: tragedylooper.v1.ScriptConfig.incidents:type_name -> tragedylooper.v1.ScriptConfig.IncidentsEntry
-	15, // 4: tragedylooper.v1.ScriptConfig.characters:type_name -> tragedylooper.v1.ScriptConfig.CharactersEntry
-	16, // 5: tragedylooper.v1.ScriptConfig.mastermind_cards:type_name -> tragedylooper.v1.ScriptConfig.MastermindCardsEntry
-	17, // 6: tragedylooper.v1.ScriptConfig.protagonist_cards:type_name -> tragedylooper.v1.ScriptConfig.ProtagonistCardsEntry
-	18, // 7: tragedylooper.v1.ScriptConfig.script_models:type_name -> tragedylooper.v1.ScriptConfig.ScriptModelsEntry
-	19, // 8: tragedylooper.v1.CastRole.extra_info:type_name -> tragedylooper.v1.CastRole.ExtraInfoEntry
+	15, // 0: tragedylooper.v1.ScriptConfig.main_plots:type_name -> tragedylooper.v1.ScriptConfig.MainPlotsEntry
+	16, // 1: tragedylooper.v1.ScriptConfig.sub_plots:type_name -> tragedylooper.v1.ScriptConfig.SubPlotsEntry
+	17, // 2: tragedylooper.v1.ScriptConfig.roles:type_name -> tragedylooper.v1.ScriptConfig.RolesEntry
+	18, // 3: tragedylooper.v1.ScriptConfig.incidents:type_name -> tragedylooper.v1.ScriptConfig.IncidentsEntry
+	19, // 4: tragedylooper.v1.ScriptConfig.characters:type_name -> tragedylooper.v1.ScriptConfig.CharactersEntry
+	20, // 5: tragedylooper.v1.ScriptConfig.mastermind_cards:type_name -> tragedylooper.v1.ScriptConfig.MastermindCardsEntry
+	21, // 6: tragedylooper.v1.ScriptConfig.protagonist_cards:type_name -> tragedylooper.v1.ScriptConfig.ProtagonistCardsEntry
+	22, // 7: tragedylooper.v1.ScriptConfig.script_models:type_name -> tragedylooper.v1.ScriptConfig.ScriptModelsEntry
+	23, // 8: tragedylooper.v1.CastRole.extra_info:type_name -> tragedylooper.v1.CastRole.ExtraInfoEntry
 	3,  // 9: tragedylooper.v1.CastAssignment.role_with_extra:type_name -> tragedylooper.v1.CastRole
 	1,  // 10: tragedylooper.v1.ScriptMetadata.difficulty_sets:type_name -> tragedylooper.v1.DifficultySet
-	20, // 11: tragedylooper.v1.ScriptMetadata.cast:type_name -> tragedylooper.v1.ScriptMetadata.CastEntry
+	24, // 11: tragedylooper.v1.ScriptMetadata.cast:type_name -> tragedylooper.v1.ScriptMetadata.CastEntry
 	2,  // 12: tragedylooper.v1.ScriptMetadata.incidents:type_name -> tragedylooper.v1.IncidentInstance
 	7,  // 13: tragedylooper.v1.ScriptModel.private_config:type_name -> tragedylooper.v1.PrivateConfig
 	8,  // 14: tragedylooper.v1.ScriptModel.public_config:type_name -> tragedylooper.v1.PublicConfig
 	5,  // 15: tragedylooper.v1.ScriptModel.metadata:type_name -> tragedylooper.v1.ScriptMetadata
-	21, // 16: tragedylooper.v1.PrivateConfig.role_assignments:type_name -> tragedylooper.v1.PrivateConfig.RoleAssignmentsEntry
-	22, // 17: tragedylooper.v1.RoleConfig.abilities:type_name -> tragedylooper.v1.RoleConfig.AbilitiesEntry
-	25, // 18: tragedylooper.v1.RoleConfig.goodwill_rule:type_name -> tragedylooper.v1.GoodwillRuleType
-	26, // 19: tragedylooper.v1.PlotConfig.plot_type:type_name -> tragedylooper.v1.PlotType
-	23, // 20: tragedylooper.v1.PlotConfig.incident_ids:type_name -> tragedylooper.v1.PlotConfig.IncidentIdsEntry
-	24, // 21: tragedylooper.v1.PlotConfig.role_assignments:type_name -> tragedylooper.v1.PlotConfig.RoleAssignmentsEntry
-	10, // 22: tragedylooper.v1.ScriptConfig.MainPlotsEntry.value:type_name -> tragedylooper.v1.PlotConfig
-	10, // 23: tragedylooper.v1.ScriptConfig.SubPlotsEntry.value:type_name -> tragedylooper.v1.PlotConfig
-	9,  // 24: tragedylooper.v1.ScriptConfig.RolesEntry.value:type_name -> tragedylooper.v1.RoleConfig
-	27, // 25: tragedylooper.v1.ScriptConfig.IncidentsEntry.value:type_name -> tragedylooper.v1.IncidentConfig
-	28, // 26: tragedylooper.v1.ScriptConfig.CharactersEntry.value:type_name -> tragedylooper.v1.CharacterConfig
-	29, // 27: tragedylooper.v1.ScriptConfig.MastermindCardsEntry.value:type_name -> tragedylooper.v1.CardConfig
-	29, // 28: tragedylooper.v1.ScriptConfig.ProtagonistCardsEntry.value:type_name -> tragedylooper.v1.CardConfig
-	6,  // 29: tragedylooper.v1.ScriptConfig.ScriptModelsEntry.value:type_name -> tragedylooper.v1.ScriptModel
-	4,  // 30: tragedylooper.v1.ScriptMetadata.CastEntry.value:type_name -> tragedylooper.v1.CastAssignment
-	30, // 31: tragedylooper.v1.RoleConfig.AbilitiesEntry.value:type_name -> tragedylooper.v1.AbilityConfig
-	27, // 32: tragedylooper.v1.PlotConfig.IncidentIdsEntry.value:type_name -> tragedylooper.v1.IncidentConfig
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	25, // 16: tragedylooper.v1.PrivateConfig.role_assignments:type_name -> tragedylooper.v1.PrivateConfig.RoleAssignmentsEntry
+	26, // 17: tragedylooper.v1.RoleConfig.abilities:type_name -> tragedylooper.v1.RoleConfig.AbilitiesEntry
+	29, // 18: tragedylooper.v1.RoleConfig.goodwill_rule:type_name -> tragedylooper.v1.GoodwillRuleType
+	30, // 19: tragedylooper.v1.PlotConfig.plot_type:type_name -> tragedylooper.v1.PlotType
+	27, // 20: tragedylooper.v1.PlotConfig.incident_ids:type_name -> tragedylooper.v1.PlotConfig.IncidentIdsEntry
+	28, // 21: tragedylooper.v1.PlotConfig.role_assignments:type_name -> tragedylooper.v1.PlotConfig.RoleAssignmentsEntry
+	11, // 22: tragedylooper.v1.PublicScriptSheet.incidents:type_name -> tragedylooper.v1.ScriptSheetIncident
+	13, // 23: tragedylooper.v1.PrivateScriptSheet.public_sheet:type_name -> tragedylooper.v1.PublicScriptSheet
+	12, // 24: tragedylooper.v1.PrivateScriptSheet.cast:type_name -> tragedylooper.v1.ScriptSheetCast
+	11, // 25: tragedylooper.v1.PrivateScriptSheet.incidents:type_name -> tragedylooper.v1.ScriptSheetIncident
+	10, // 26: tragedylooper.v1.ScriptConfig.MainPlotsEntry.value:type_name -> tragedylooper.v1.PlotConfig
+	10, // 27: tragedylooper.v1.ScriptConfig.SubPlotsEntry.value:type_name -> tragedylooper.v1.PlotConfig
+	9,  // 28: tragedylooper.v1.ScriptConfig.RolesEntry.value:type_name -> tragedylooper.v1.RoleConfig
+	31, // 29: tragedylooper.v1.ScriptConfig.IncidentsEntry.value:type_name -> tragedylooper.v1.IncidentConfig
+	32, // 30: tragedylooper.v1.ScriptConfig.CharactersEntry.value:type_name -> tragedylooper.v1.CharacterConfig
+	33, // 31: tragedylooper.v1.ScriptConfig.MastermindCardsEntry.value:type_name -> tragedylooper.v1.CardConfig
+	33, // 32: tragedylooper.v1.ScriptConfig.ProtagonistCardsEntry.value:type_name -> tragedylooper.v1.CardConfig
+	6,  // 33: tragedylooper.v1.ScriptConfig.ScriptModelsEntry.value:type_name -> tragedylooper.v1.ScriptModel
+	4,  // 34: tragedylooper.v1.ScriptMetadata.CastEntry.value:type_name -> tragedylooper.v1.CastAssignment
+	34, // 35: tragedylooper.v1.RoleConfig.AbilitiesEntry.value:type_name -> tragedylooper.v1.AbilityConfig
+	31, // 36: tragedylooper.v1.PlotConfig.IncidentIdsEntry.value:type_name -> tragedylooper.v1.IncidentConfig
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_tragedylooper_v1_script_proto_init() }
@@ -1272,7 +1682,7 @@ func file_tragedylooper_v1_script_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_script_proto_rawDesc), len(file_tragedylooper_v1_script_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2256,3 +2256,574 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PlotConfigValidationError{}
+
+// Validate checks the field values on ScriptSheetIncident with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScriptSheetIncident) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScriptSheetIncident with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScriptSheetIncidentMultiError, or nil if none found.
+func (m *ScriptSheetIncident) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScriptSheetIncident) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Day
+
+	// no validation rules for Incident
+
+	// no validation rules for Culprit
+
+	// no validation rules for IncidentId
+
+	if len(errors) > 0 {
+		return ScriptSheetIncidentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScriptSheetIncidentMultiError is an error wrapping multiple validation
+// errors returned by ScriptSheetIncident.ValidateAll() if the designated
+// constraints aren't met.
+type ScriptSheetIncidentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScriptSheetIncidentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScriptSheetIncidentMultiError) AllErrors() []error { return m }
+
+// ScriptSheetIncidentValidationError is the validation error returned by
+// ScriptSheetIncident.Validate if the designated constraints aren't met.
+type ScriptSheetIncidentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScriptSheetIncidentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScriptSheetIncidentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScriptSheetIncidentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScriptSheetIncidentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScriptSheetIncidentValidationError) ErrorName() string {
+	return "ScriptSheetIncidentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScriptSheetIncidentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScriptSheetIncident.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScriptSheetIncidentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScriptSheetIncidentValidationError{}
+
+// Validate checks the field values on ScriptSheetCast with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScriptSheetCast) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScriptSheetCast with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScriptSheetCastMultiError, or nil if none found.
+func (m *ScriptSheetCast) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScriptSheetCast) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Character
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return ScriptSheetCastMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScriptSheetCastMultiError is an error wrapping multiple validation errors
+// returned by ScriptSheetCast.ValidateAll() if the designated constraints
+// aren't met.
+type ScriptSheetCastMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScriptSheetCastMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScriptSheetCastMultiError) AllErrors() []error { return m }
+
+// ScriptSheetCastValidationError is the validation error returned by
+// ScriptSheetCast.Validate if the designated constraints aren't met.
+type ScriptSheetCastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScriptSheetCastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScriptSheetCastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScriptSheetCastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScriptSheetCastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScriptSheetCastValidationError) ErrorName() string { return "ScriptSheetCastValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScriptSheetCastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScriptSheetCast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScriptSheetCastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScriptSheetCastValidationError{}
+
+// Validate checks the field values on PublicScriptSheet with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PublicScriptSheet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PublicScriptSheet with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PublicScriptSheetMultiError, or nil if none found.
+func (m *PublicScriptSheet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PublicScriptSheet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScriptName
+
+	// no validation rules for Title
+
+	// no validation rules for TragedySet
+
+	// no validation rules for LoopCount
+
+	// no validation rules for DaysPerLoop
+
+	// no validation rules for Difficulty
+
+	// no validation rules for CanDiscuss
+
+	for idx, item := range m.GetIncidents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PublicScriptSheetValidationError{
+						field:  fmt.Sprintf("Incidents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PublicScriptSheetValidationError{
+						field:  fmt.Sprintf("Incidents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PublicScriptSheetValidationError{
+					field:  fmt.Sprintf("Incidents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PublicScriptSheetMultiError(errors)
+	}
+
+	return nil
+}
+
+// PublicScriptSheetMultiError is an error wrapping multiple validation errors
+// returned by PublicScriptSheet.ValidateAll() if the designated constraints
+// aren't met.
+type PublicScriptSheetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PublicScriptSheetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PublicScriptSheetMultiError) AllErrors() []error { return m }
+
+// PublicScriptSheetValidationError is the validation error returned by
+// PublicScriptSheet.Validate if the designated constraints aren't met.
+type PublicScriptSheetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublicScriptSheetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublicScriptSheetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublicScriptSheetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublicScriptSheetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublicScriptSheetValidationError) ErrorName() string {
+	return "PublicScriptSheetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublicScriptSheetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublicScriptSheet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublicScriptSheetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublicScriptSheetValidationError{}
+
+// Validate checks the field values on PrivateScriptSheet with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrivateScriptSheet) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrivateScriptSheet with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrivateScriptSheetMultiError, or nil if none found.
+func (m *PrivateScriptSheet) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrivateScriptSheet) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPublicSheet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrivateScriptSheetValidationError{
+					field:  "PublicSheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PrivateScriptSheetValidationError{
+					field:  "PublicSheet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublicSheet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrivateScriptSheetValidationError{
+				field:  "PublicSheet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MainPlot
+
+	for idx, item := range m.GetCast() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrivateScriptSheetValidationError{
+						field:  fmt.Sprintf("Cast[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrivateScriptSheetValidationError{
+						field:  fmt.Sprintf("Cast[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrivateScriptSheetValidationError{
+					field:  fmt.Sprintf("Cast[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetIncidents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrivateScriptSheetValidationError{
+						field:  fmt.Sprintf("Incidents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrivateScriptSheetValidationError{
+						field:  fmt.Sprintf("Incidents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PrivateScriptSheetValidationError{
+					field:  fmt.Sprintf("Incidents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for VictoryConditions
+
+	// no validation rules for Story
+
+	// no validation rules for MastermindHints
+
+	if len(errors) > 0 {
+		return PrivateScriptSheetMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrivateScriptSheetMultiError is an error wrapping multiple validation errors
+// returned by PrivateScriptSheet.ValidateAll() if the designated constraints
+// aren't met.
+type PrivateScriptSheetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrivateScriptSheetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrivateScriptSheetMultiError) AllErrors() []error { return m }
+
+// PrivateScriptSheetValidationError is the validation error returned by
+// PrivateScriptSheet.Validate if the designated constraints aren't met.
+type PrivateScriptSheetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrivateScriptSheetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrivateScriptSheetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrivateScriptSheetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrivateScriptSheetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrivateScriptSheetValidationError) ErrorName() string {
+	return "PrivateScriptSheetValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PrivateScriptSheetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrivateScriptSheet.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrivateScriptSheetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrivateScriptSheetValidationError{}
@@ -0,0 +1,703 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tragedylooper/v1/service.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CreateRoomRequest 是创建房间的请求。剧本设置是可选的，也可以稍后在大厅中选择。
+type CreateRoomRequest struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName                    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`                                                                 // 房主的玩家名称；认证器提供名称时以认证器为准
+	PlayerRole                    PlayerRole             `protobuf:"varint,2,opt,name=player_role,json=playerRole,proto3,enum=tragedylooper.v1.PlayerRole" json:"player_role,omitempty"`                               // 房主的身份
+	ScriptId                      string                 `protobuf:"bytes,3,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`                                                                       // 可选：所选剧本
+	ModelId                       int32                  `protobuf:"varint,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`                                                                         // 所选剧本模型
+	DifficultySet                 *DifficultySet         `protobuf:"bytes,5,opt,name=difficulty_set,json=difficultySet,proto3" json:"difficulty_set,omitempty"`                                                        // 可选：所选难度组合
+	FillWithAi                    bool                   `protobuf:"varint,6,opt,name=fill_with_ai,json=fillWithAi,proto3" json:"fill_with_ai,omitempty"`                                                              // 开始游戏时是否用 AI 填补空座位
+	SpectatorFullViewDelaySeconds int32                  `protobuf:"varint,7,opt,name=spectator_full_view_delay_seconds,json=spectatorFullViewDelaySeconds,proto3" json:"spectator_full_view_delay_seconds,omitempty"` // 旁观者完整视图的延迟；0 表示游戏进行中不提供完整视图
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRoomRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetPlayerRole() PlayerRole {
+	if x != nil {
+		return x.PlayerRole
+	}
+	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
+}
+
+func (x *CreateRoomRequest) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetModelId() int32 {
+	if x != nil {
+		return x.ModelId
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetDifficultySet() *DifficultySet {
+	if x != nil {
+		return x.DifficultySet
+	}
+	return nil
+}
+
+func (x *CreateRoomRequest) GetFillWithAi() bool {
+	if x != nil {
+		return x.FillWithAi
+	}
+	return false
+}
+
+func (x *CreateRoomRequest) GetSpectatorFullViewDelaySeconds() int32 {
+	if x != nil {
+		return x.SpectatorFullViewDelaySeconds
+	}
+	return 0
+}
+
+// TakeSeatRequest 是在大厅中入座的请求。
+type TakeSeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                                               // 房间的游戏 ID
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`                                   // 玩家名称；认证器提供名称时以认证器为准
+	PlayerRole    PlayerRole             `protobuf:"varint,3,opt,name=player_role,json=playerRole,proto3,enum=tragedylooper.v1.PlayerRole" json:"player_role,omitempty"` // 要占用的身份
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeSeatRequest) Reset() {
+	*x = TakeSeatRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeSeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeSeatRequest) ProtoMessage() {}
+
+func (x *TakeSeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeSeatRequest.ProtoReflect.Descriptor instead.
+func (*TakeSeatRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TakeSeatRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *TakeSeatRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *TakeSeatRequest) GetPlayerRole() PlayerRole {
+	if x != nil {
+		return x.PlayerRole
+	}
+	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
+}
+
+// SeatGrant 是入座成功后获得的席位。
+type SeatGrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                   // 房间的游戏 ID
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`            // 服务器分配的玩家 ID
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 席位会话令牌，用于之后的所有席位操作和重连
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatGrant) Reset() {
+	*x = SeatGrant{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatGrant) ProtoMessage() {}
+
+func (x *SeatGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatGrant.ProtoReflect.Descriptor instead.
+func (*SeatGrant) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SeatGrant) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SeatGrant) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *SeatGrant) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// ListRoomsRequest 是列出房间的请求。
+type ListRoomsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	States        []RoomState            `protobuf:"varint,1,rep,packed,name=states,proto3,enum=tragedylooper.v1.RoomState" json:"states,omitempty"` // 要列出的房间状态；为空时只列出大厅中的房间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRoomsRequest) ProtoMessage() {}
+
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListRoomsRequest) GetStates() []RoomState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+// SeatActionRequest 是以席位身份提交玩家操作的请求。
+type SeatActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 席位会话令牌
+	Action        *PlayerActionPayload   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                 // 玩家操作；player_id 由服务器根据席位填写
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatActionRequest) Reset() {
+	*x = SeatActionRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatActionRequest) ProtoMessage() {}
+
+func (x *SeatActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatActionRequest.ProtoReflect.Descriptor instead.
+func (*SeatActionRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SeatActionRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SeatActionRequest) GetAction() *PlayerActionPayload {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+// SeatActionResponse 表示操作已提交给游戏引擎。操作的结果通过事件推送。
+type SeatActionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatActionResponse) Reset() {
+	*x = SeatActionResponse{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatActionResponse) ProtoMessage() {}
+
+func (x *SeatActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatActionResponse.ProtoReflect.Descriptor instead.
+func (*SeatActionResponse) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+// StreamEventsRequest 是订阅席位消息的请求。
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 席位会话令牌
+	LastSeq       uint64                 `protobuf:"varint,2,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`               // 重连时，客户端已收到的最后一条服务器消息序号；之后的事件会被补发
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StreamEventsRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetLastSeq() uint64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+// GetPlayerViewRequest 是查询玩家视图的请求。
+type GetPlayerViewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 席位会话令牌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerViewRequest) Reset() {
+	*x = GetPlayerViewRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerViewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerViewRequest) ProtoMessage() {}
+
+func (x *GetPlayerViewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlayerViewRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerViewRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPlayerViewRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+// SeatChatRequest 是以席位身份发送聊天消息的请求。
+type SeatChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionToken  string                 `protobuf:"bytes,1,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 席位会话令牌
+	Chat          *SendChat              `protobuf:"bytes,2,opt,name=chat,proto3" json:"chat,omitempty"`                                     // 聊天消息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatChatRequest) Reset() {
+	*x = SeatChatRequest{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatChatRequest) ProtoMessage() {}
+
+func (x *SeatChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatChatRequest.ProtoReflect.Descriptor instead.
+func (*SeatChatRequest) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SeatChatRequest) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SeatChatRequest) GetChat() *SendChat {
+	if x != nil {
+		return x.Chat
+	}
+	return nil
+}
+
+// SeatChatResponse 表示聊天消息已发送。
+type SeatChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatChatResponse) Reset() {
+	*x = SeatChatResponse{}
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatChatResponse) ProtoMessage() {}
+
+func (x *SeatChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatChatResponse.ProtoReflect.Descriptor instead.
+func (*SeatChatResponse) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+var File_tragedylooper_v1_service_proto protoreflect.FileDescriptor
+
+const file_tragedylooper_v1_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1etragedylooper/v1/service.proto\x12\x10tragedylooper.v1\x1a\x1atragedylooper/v1/api.proto\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1btragedylooper/v1/game.proto\x1a\x1etragedylooper/v1/payload.proto\x1a\x1ftragedylooper/v1/protocol.proto\x1a\x1dtragedylooper/v1/script.proto\"\xdf\x02\n" +
+	"\x11CreateRoomRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12=\n" +
+	"\vplayer_role\x18\x02 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\n" +
+	"playerRole\x12\x1b\n" +
+	"\tscript_id\x18\x03 \x01(\tR\bscriptId\x12\x19\n" +
+	"\bmodel_id\x18\x04 \x01(\x05R\amodelId\x12F\n" +
+	"\x0edifficulty_set\x18\x05 \x01(\v2\x1f.tragedylooper.v1.DifficultySetR\rdifficultySet\x12 \n" +
+	"\ffill_with_ai\x18\x06 \x01(\bR\n" +
+	"fillWithAi\x12H\n" +
+	"!spectator_full_view_delay_seconds\x18\a \x01(\x05R\x1dspectatorFullViewDelaySeconds\"\x8a\x01\n" +
+	"\x0fTakeSeatRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12=\n" +
+	"\vplayer_role\x18\x03 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\n" +
+	"playerRole\"f\n" +
+	"\tSeatGrant\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12#\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\"G\n" +
+	"\x10ListRoomsRequest\x123\n" +
+	"\x06states\x18\x01 \x03(\x0e2\x1b.tragedylooper.v1.RoomStateR\x06states\"w\n" +
+	"\x11SeatActionRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12=\n" +
+	"\x06action\x18\x02 \x01(\v2%.tragedylooper.v1.PlayerActionPayloadR\x06action\"\x14\n" +
+	"\x12SeatActionResponse\"U\n" +
+	"\x13StreamEventsRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12\x19\n" +
+	"\blast_seq\x18\x02 \x01(\x04R\alastSeq\";\n" +
+	"\x14GetPlayerViewRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\"f\n" +
+	"\x0fSeatChatRequest\x12#\n" +
+	"\rsession_token\x18\x01 \x01(\tR\fsessionToken\x12.\n" +
+	"\x04chat\x18\x02 \x01(\v2\x1a.tragedylooper.v1.SendChatR\x04chat\"\x12\n" +
+	"\x10SeatChatResponse2\xde\x04\n" +
+	"\vGameService\x12N\n" +
+	"\n" +
+	"CreateRoom\x12#.tragedylooper.v1.CreateRoomRequest\x1a\x1b.tragedylooper.v1.SeatGrant\x12J\n" +
+	"\bJoinRoom\x12!.tragedylooper.v1.TakeSeatRequest\x1a\x1b.tragedylooper.v1.SeatGrant\x12T\n" +
+	"\tListRooms\x12\".tragedylooper.v1.ListRoomsRequest\x1a#.tragedylooper.v1.ListRoomsResponse\x12Y\n" +
+	"\fSubmitAction\x12#.tragedylooper.v1.SeatActionRequest\x1a$.tragedylooper.v1.SeatActionResponse\x12X\n" +
+	"\fStreamEvents\x12%.tragedylooper.v1.StreamEventsRequest\x1a\x1f.tragedylooper.v1.ServerMessage0\x01\x12U\n" +
+	"\rGetPlayerView\x12&.tragedylooper.v1.GetPlayerViewRequest\x1a\x1c.tragedylooper.v1.PlayerView\x12Q\n" +
+	"\bSendChat\x12!.tragedylooper.v1.SeatChatRequest\x1a\".tragedylooper.v1.SeatChatResponseB\xbc\x01\n" +
+	"\x14com.tragedylooper.v1B\fServiceProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
+
+var (
+	file_tragedylooper_v1_service_proto_rawDescOnce sync.Once
+	file_tragedylooper_v1_service_proto_rawDescData []byte
+)
+
+func file_tragedylooper_v1_service_proto_rawDescGZIP() []byte {
+	file_tragedylooper_v1_service_proto_rawDescOnce.Do(func() {
+		file_tragedylooper_v1_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_service_proto_rawDesc), len(file_tragedylooper_v1_service_proto_rawDesc)))
+	})
+	return file_tragedylooper_v1_service_proto_rawDescData
+}
+
+var file_tragedylooper_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_tragedylooper_v1_service_proto_goTypes = []any{
+	(*CreateRoomRequest)(nil),    // 0: tragedylooper.v1.CreateRoomRequest
+	(*TakeSeatRequest)(nil),      // 1: tragedylooper.v1.TakeSeatRequest
+	(*SeatGrant)(nil),            // 2: tragedylooper.v1.SeatGrant
+	(*ListRoomsRequest)(nil),     // 3: tragedylooper.v1.ListRoomsRequest
+	(*SeatActionRequest)(nil),    // 4: tragedylooper.v1.SeatActionRequest
+	(*SeatActionResponse)(nil),   // 5: tragedylooper.v1.SeatActionResponse
+	(*StreamEventsRequest)(nil),  // 6: tragedylooper.v1.StreamEventsRequest
+	(*GetPlayerViewRequest)(nil), // 7: tragedylooper.v1.GetPlayerViewRequest
+	(*SeatChatRequest)(nil),      // 8: tragedylooper.v1.SeatChatRequest
+	(*SeatChatResponse)(nil),     // 9: tragedylooper.v1.SeatChatResponse
+	(PlayerRole)(0),              // 10: tragedylooper.v1.PlayerRole
+	(*DifficultySet)(nil),        // 11: tragedylooper.v1.DifficultySet
+	(RoomState)(0),               // 12: tragedylooper.v1.RoomState
+	(*PlayerActionPayload)(nil),  // 13: tragedylooper.v1.PlayerActionPayload
+	(*SendChat)(nil),             // 14: tragedylooper.v1.SendChat
+	(*ListRoomsResponse)(nil),    // 15: tragedylooper.v1.ListRoomsResponse
+	(*ServerMessage)(nil),        // 16: tragedylooper.v1.ServerMessage
+	(*PlayerView)(nil),           // 17: tragedylooper.v1.PlayerView
+}
+var file_tragedylooper_v1_service_proto_depIdxs = []int32{
+	10, // 0: tragedylooper.v1.CreateRoomRequest.player_role:type_name -> tragedylooper.v1.PlayerRole
+	11, // 1: tragedylooper.v1.CreateRoomRequest.difficulty_set:type_name -> tragedylooper.v1.DifficultySet
+	10, // 2: tragedylooper.v1.TakeSeatRequest.player_role:type_name -> tragedylooper.v1.PlayerRole
+	12, // 3: tragedylooper.v1.ListRoomsRequest.states:type_name -> tragedylooper.v1.RoomState
+	13, // 4: tragedylooper.v1.SeatActionRequest.action:type_name -> tragedylooper.v1.PlayerActionPayload
+	14, // 5: tragedylooper.v1.SeatChatRequest.chat:type_name -> tragedylooper.v1.SendChat
+	0,  // 6: tragedylooper.v1.GameService.CreateRoom:input_type -> tragedylooper.v1.CreateRoomRequest
+	1,  // 7: tragedylooper.v1.GameService.JoinRoom:input_type -> tragedylooper.v1.TakeSeatRequest
+	3,  // 8: tragedylooper.v1.GameService.ListRooms:input_type -> tragedylooper.v1.ListRoomsRequest
+	4,  // 9: tragedylooper.v1.GameService.SubmitAction:input_type -> tragedylooper.v1.SeatActionRequest
+	6,  // 10: tragedylooper.v1.GameService.StreamEvents:input_type -> tragedylooper.v1.StreamEventsRequest
+	7,  // 11: tragedylooper.v1.GameService.GetPlayerView:input_type -> tragedylooper.v1.GetPlayerViewRequest
+	8,  // 12: tragedylooper.v1.GameService.SendChat:input_type -> tragedylooper.v1.SeatChatRequest
+	2,  // 13: tragedylooper.v1.GameService.CreateRoom:output_type -> tragedylooper.v1.SeatGrant
+	2,  // 14: tragedylooper.v1.GameService.JoinRoom:output_type -> tragedylooper.v1.SeatGrant
+	15, // 15: tragedylooper.v1.GameService.ListRooms:output_type -> tragedylooper.v1.ListRoomsResponse
+	5,  // 16: tragedylooper.v1.GameService.SubmitAction:output_type -> tragedylooper.v1.SeatActionResponse
+	16, // 17: tragedylooper.v1.GameService.StreamEvents:output_type -> tragedylooper.v1.ServerMessage
+	17, // 18: tragedylooper.v1.GameService.GetPlayerView:output_type -> tragedylooper.v1.PlayerView
+	9,  // 19: tragedylooper.v1.GameService.SendChat:output_type -> tragedylooper.v1.SeatChatResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_tragedylooper_v1_service_proto_init() }
+func file_tragedylooper_v1_service_proto_init() {
+	if File_tragedylooper_v1_service_proto != nil {
+		return
+	}
+	file_tragedylooper_v1_api_proto_init()
+	file_tragedylooper_v1_enums_proto_init()
+	file_tragedylooper_v1_game_proto_init()
+	file_tragedylooper_v1_payload_proto_init()
+	file_tragedylooper_v1_protocol_proto_init()
+	file_tragedylooper_v1_script_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_service_proto_rawDesc), len(file_tragedylooper_v1_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_tragedylooper_v1_service_proto_goTypes,
+		DependencyIndexes: file_tragedylooper_v1_service_proto_depIdxs,
+		MessageInfos:      file_tragedylooper_v1_service_proto_msgTypes,
+	}.Build()
+	File_tragedylooper_v1_service_proto = out.File
+	file_tragedylooper_v1_service_proto_goTypes = nil
+	file_tragedylooper_v1_service_proto_depIdxs = nil
+}
@@ -0,0 +1,1166 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: tragedylooper/v1/service.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateRoomRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateRoomRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRoomRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRoomRequestMultiError, or nil if none found.
+func (m *CreateRoomRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRoomRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlayerName
+
+	// no validation rules for PlayerRole
+
+	// no validation rules for ScriptId
+
+	// no validation rules for ModelId
+
+	if all {
+		switch v := interface{}(m.GetDifficultySet()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRoomRequestValidationError{
+					field:  "DifficultySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRoomRequestValidationError{
+					field:  "DifficultySet",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDifficultySet()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRoomRequestValidationError{
+				field:  "DifficultySet",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FillWithAi
+
+	// no validation rules for SpectatorFullViewDelaySeconds
+
+	if len(errors) > 0 {
+		return CreateRoomRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRoomRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRoomRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRoomRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRoomRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRoomRequestMultiError) AllErrors() []error { return m }
+
+// CreateRoomRequestValidationError is the validation error returned by
+// CreateRoomRequest.Validate if the designated constraints aren't met.
+type CreateRoomRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRoomRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRoomRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRoomRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRoomRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRoomRequestValidationError) ErrorName() string {
+	return "CreateRoomRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRoomRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRoomRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRoomRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRoomRequestValidationError{}
+
+// Validate checks the field values on TakeSeatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TakeSeatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TakeSeatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TakeSeatRequestMultiError, or nil if none found.
+func (m *TakeSeatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TakeSeatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for PlayerName
+
+	// no validation rules for PlayerRole
+
+	if len(errors) > 0 {
+		return TakeSeatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TakeSeatRequestMultiError is an error wrapping multiple validation errors
+// returned by TakeSeatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TakeSeatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TakeSeatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TakeSeatRequestMultiError) AllErrors() []error { return m }
+
+// TakeSeatRequestValidationError is the validation error returned by
+// TakeSeatRequest.Validate if the designated constraints aren't met.
+type TakeSeatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TakeSeatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TakeSeatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TakeSeatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TakeSeatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TakeSeatRequestValidationError) ErrorName() string { return "TakeSeatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TakeSeatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTakeSeatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TakeSeatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TakeSeatRequestValidationError{}
+
+// Validate checks the field values on SeatGrant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SeatGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeatGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SeatGrantMultiError, or nil
+// if none found.
+func (m *SeatGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeatGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for PlayerId
+
+	// no validation rules for SessionToken
+
+	if len(errors) > 0 {
+		return SeatGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeatGrantMultiError is an error wrapping multiple validation errors returned
+// by SeatGrant.ValidateAll() if the designated constraints aren't met.
+type SeatGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeatGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeatGrantMultiError) AllErrors() []error { return m }
+
+// SeatGrantValidationError is the validation error returned by
+// SeatGrant.Validate if the designated constraints aren't met.
+type SeatGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeatGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeatGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeatGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeatGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeatGrantValidationError) ErrorName() string { return "SeatGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeatGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeatGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeatGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeatGrantValidationError{}
+
+// Validate checks the field values on ListRoomsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListRoomsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListRoomsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRoomsRequestMultiError, or nil if none found.
+func (m *ListRoomsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListRoomsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListRoomsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListRoomsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListRoomsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListRoomsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListRoomsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListRoomsRequestMultiError) AllErrors() []error { return m }
+
+// ListRoomsRequestValidationError is the validation error returned by
+// ListRoomsRequest.Validate if the designated constraints aren't met.
+type ListRoomsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRoomsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRoomsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRoomsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRoomsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRoomsRequestValidationError) ErrorName() string { return "ListRoomsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRoomsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRoomsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRoomsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRoomsRequestValidationError{}
+
+// Validate checks the field values on SeatActionRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SeatActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeatActionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SeatActionRequestMultiError, or nil if none found.
+func (m *SeatActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeatActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionToken
+
+	if all {
+		switch v := interface{}(m.GetAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeatActionRequestValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeatActionRequestValidationError{
+					field:  "Action",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeatActionRequestValidationError{
+				field:  "Action",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SeatActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeatActionRequestMultiError is an error wrapping multiple validation errors
+// returned by SeatActionRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SeatActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeatActionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeatActionRequestMultiError) AllErrors() []error { return m }
+
+// SeatActionRequestValidationError is the validation error returned by
+// SeatActionRequest.Validate if the designated constraints aren't met.
+type SeatActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeatActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeatActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeatActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeatActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeatActionRequestValidationError) ErrorName() string {
+	return "SeatActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SeatActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeatActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeatActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeatActionRequestValidationError{}
+
+// Validate checks the field values on SeatActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SeatActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeatActionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SeatActionResponseMultiError, or nil if none found.
+func (m *SeatActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeatActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SeatActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeatActionResponseMultiError is an error wrapping multiple validation errors
+// returned by SeatActionResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SeatActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeatActionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeatActionResponseMultiError) AllErrors() []error { return m }
+
+// SeatActionResponseValidationError is the validation error returned by
+// SeatActionResponse.Validate if the designated constraints aren't met.
+type SeatActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeatActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeatActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeatActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeatActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeatActionResponseValidationError) ErrorName() string {
+	return "SeatActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SeatActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeatActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeatActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeatActionResponseValidationError{}
+
+// Validate checks the field values on StreamEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StreamEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamEventsRequestMultiError, or nil if none found.
+func (m *StreamEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionToken
+
+	// no validation rules for LastSeq
+
+	if len(errors) > 0 {
+		return StreamEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by StreamEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StreamEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamEventsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamEventsRequestMultiError) AllErrors() []error { return m }
+
+// StreamEventsRequestValidationError is the validation error returned by
+// StreamEventsRequest.Validate if the designated constraints aren't met.
+type StreamEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamEventsRequestValidationError) ErrorName() string {
+	return "StreamEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamEventsRequestValidationError{}
+
+// Validate checks the field values on GetPlayerViewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPlayerViewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPlayerViewRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPlayerViewRequestMultiError, or nil if none found.
+func (m *GetPlayerViewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPlayerViewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionToken
+
+	if len(errors) > 0 {
+		return GetPlayerViewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPlayerViewRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPlayerViewRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPlayerViewRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPlayerViewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPlayerViewRequestMultiError) AllErrors() []error { return m }
+
+// GetPlayerViewRequestValidationError is the validation error returned by
+// GetPlayerViewRequest.Validate if the designated constraints aren't met.
+type GetPlayerViewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPlayerViewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPlayerViewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPlayerViewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPlayerViewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPlayerViewRequestValidationError) ErrorName() string {
+	return "GetPlayerViewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPlayerViewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPlayerViewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPlayerViewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPlayerViewRequestValidationError{}
+
+// Validate checks the field values on SeatChatRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SeatChatRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeatChatRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SeatChatRequestMultiError, or nil if none found.
+func (m *SeatChatRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeatChatRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionToken
+
+	if all {
+		switch v := interface{}(m.GetChat()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SeatChatRequestValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SeatChatRequestValidationError{
+					field:  "Chat",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetChat()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SeatChatRequestValidationError{
+				field:  "Chat",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SeatChatRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeatChatRequestMultiError is an error wrapping multiple validation errors
+// returned by SeatChatRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SeatChatRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeatChatRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeatChatRequestMultiError) AllErrors() []error { return m }
+
+// SeatChatRequestValidationError is the validation error returned by
+// SeatChatRequest.Validate if the designated constraints aren't met.
+type SeatChatRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeatChatRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeatChatRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeatChatRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeatChatRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeatChatRequestValidationError) ErrorName() string { return "SeatChatRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeatChatRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeatChatRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeatChatRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeatChatRequestValidationError{}
+
+// Validate checks the field values on SeatChatResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SeatChatResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeatChatResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SeatChatResponseMultiError, or nil if none found.
+func (m *SeatChatResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeatChatResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SeatChatResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeatChatResponseMultiError is an error wrapping multiple validation errors
+// returned by SeatChatResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SeatChatResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeatChatResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeatChatResponseMultiError) AllErrors() []error { return m }
+
+// SeatChatResponseValidationError is the validation error returned by
+// SeatChatResponse.Validate if the designated constraints aren't met.
+type SeatChatResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeatChatResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeatChatResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeatChatResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeatChatResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeatChatResponseValidationError) ErrorName() string { return "SeatChatResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeatChatResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeatChatResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeatChatResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeatChatResponseValidationError{}
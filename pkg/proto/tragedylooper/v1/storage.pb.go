@@ -0,0 +1,353 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: tragedylooper/v1/storage.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RoomRecord 是持久化的房间元数据，用于在服务器重启后恢复房间。
+type RoomRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                      // 房间的游戏 ID
+	Lobby         *LobbyState            `protobuf:"bytes,2,opt,name=lobby,proto3" json:"lobby,omitempty"`                                      // 大厅状态，包括房间状态、所选剧本和座位
+	Seats         []*SeatRecord          `protobuf:"bytes,3,rep,name=seats,proto3" json:"seats,omitempty"`                                      // 人类玩家的席位
+	NextPlayerId  int32                  `protobuf:"varint,4,opt,name=next_player_id,json=nextPlayerId,proto3" json:"next_player_id,omitempty"` // 下一个分配给新玩家的 ID
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`             // 记录的保存时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomRecord) Reset() {
+	*x = RoomRecord{}
+	mi := &file_tragedylooper_v1_storage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomRecord) ProtoMessage() {}
+
+func (x *RoomRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_storage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomRecord.ProtoReflect.Descriptor instead.
+func (*RoomRecord) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_storage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RoomRecord) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RoomRecord) GetLobby() *LobbyState {
+	if x != nil {
+		return x.Lobby
+	}
+	return nil
+}
+
+func (x *RoomRecord) GetSeats() []*SeatRecord {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+func (x *RoomRecord) GetNextPlayerId() int32 {
+	if x != nil {
+		return x.NextPlayerId
+	}
+	return 0
+}
+
+func (x *RoomRecord) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SeatRecord 是持久化的人类玩家席位。玩家重启后仍可凭原会话令牌重新连接。
+type SeatRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`            // 玩家 ID
+	Role          PlayerRole             `protobuf:"varint,2,opt,name=role,proto3,enum=tragedylooper.v1.PlayerRole" json:"role,omitempty"`   // 玩家身份
+	SessionToken  string                 `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"` // 席位会话令牌
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // 占用席位的用户 ID；匿名时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeatRecord) Reset() {
+	*x = SeatRecord{}
+	mi := &file_tragedylooper_v1_storage_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeatRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatRecord) ProtoMessage() {}
+
+func (x *SeatRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_storage_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatRecord.ProtoReflect.Descriptor instead.
+func (*SeatRecord) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_storage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SeatRecord) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *SeatRecord) GetRole() PlayerRole {
+	if x != nil {
+		return x.Role
+	}
+	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
+}
+
+func (x *SeatRecord) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *SeatRecord) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GameResult 是一局游戏的最终结果。
+type GameResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                     // 房间的游戏 ID
+	ScriptId      string                 `protobuf:"bytes,2,opt,name=script_id,json=scriptId,proto3" json:"script_id,omitempty"`               // 剧本
+	ModelId       int32                  `protobuf:"varint,3,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`                 // 剧本模型
+	Winner        PlayerRole             `protobuf:"varint,4,opt,name=winner,proto3,enum=tragedylooper.v1.PlayerRole" json:"winner,omitempty"` // 胜利的玩家角色
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                   // 游戏结束原因
+	LoopsPlayed   int32                  `protobuf:"varint,6,opt,name=loops_played,json=loopsPlayed,proto3" json:"loops_played,omitempty"`     // 游戏结束时所在的循环
+	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`                  // 游戏结束的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameResult) Reset() {
+	*x = GameResult{}
+	mi := &file_tragedylooper_v1_storage_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameResult) ProtoMessage() {}
+
+func (x *GameResult) ProtoReflect() protoreflect.Message {
+	mi := &file_tragedylooper_v1_storage_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameResult.ProtoReflect.Descriptor instead.
+func (*GameResult) Descriptor() ([]byte, []int) {
+	return file_tragedylooper_v1_storage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameResult) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameResult) GetScriptId() string {
+	if x != nil {
+		return x.ScriptId
+	}
+	return ""
+}
+
+func (x *GameResult) GetModelId() int32 {
+	if x != nil {
+		return x.ModelId
+	}
+	return 0
+}
+
+func (x *GameResult) GetWinner() PlayerRole {
+	if x != nil {
+		return x.Winner
+	}
+	return PlayerRole_PLAYER_ROLE_UNSPECIFIED
+}
+
+func (x *GameResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *GameResult) GetLoopsPlayed() int32 {
+	if x != nil {
+		return x.LoopsPlayed
+	}
+	return 0
+}
+
+func (x *GameResult) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+var File_tragedylooper_v1_storage_proto protoreflect.FileDescriptor
+
+const file_tragedylooper_v1_storage_proto_rawDesc = "" +
+	"\n" +
+	"\x1etragedylooper/v1/storage.proto\x12\x10tragedylooper.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1ctragedylooper/v1/enums.proto\x1a\x1ftragedylooper/v1/protocol.proto\"\xee\x01\n" +
+	"\n" +
+	"RoomRecord\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x122\n" +
+	"\x05lobby\x18\x02 \x01(\v2\x1c.tragedylooper.v1.LobbyStateR\x05lobby\x122\n" +
+	"\x05seats\x18\x03 \x03(\v2\x1c.tragedylooper.v1.SeatRecordR\x05seats\x12$\n" +
+	"\x0enext_player_id\x18\x04 \x01(\x05R\fnextPlayerId\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x99\x01\n" +
+	"\n" +
+	"SeatRecord\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x120\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\x04role\x12#\n" +
+	"\rsession_token\x18\x03 \x01(\tR\fsessionToken\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\x85\x02\n" +
+	"\n" +
+	"GameResult\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tscript_id\x18\x02 \x01(\tR\bscriptId\x12\x19\n" +
+	"\bmodel_id\x18\x03 \x01(\x05R\amodelId\x124\n" +
+	"\x06winner\x18\x04 \x01(\x0e2\x1c.tragedylooper.v1.PlayerRoleR\x06winner\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\floops_played\x18\x06 \x01(\x05R\vloopsPlayed\x125\n" +
+	"\bended_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendedAtB\xbc\x01\n" +
+	"\x14com.tragedylooper.v1B\fStorageProtoP\x01Z5github.com/constellation39/tragedyLooper/pkg/proto/v1\xa2\x02\x03TXX\xaa\x02\x10Tragedylooper.V1\xca\x02\x10Tragedylooper\\V1\xe2\x02\x1cTragedylooper\\V1\\GPBMetadata\xea\x02\x11Tragedylooper::V1b\x06proto3"
+
+var (
+	file_tragedylooper_v1_storage_proto_rawDescOnce sync.Once
+	file_tragedylooper_v1_storage_proto_rawDescData []byte
+)
+
+func file_tragedylooper_v1_storage_proto_rawDescGZIP() []byte {
+	file_tragedylooper_v1_storage_proto_rawDescOnce.Do(func() {
+		file_tragedylooper_v1_storage_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_storage_proto_rawDesc), len(file_tragedylooper_v1_storage_proto_rawDesc)))
+	})
+	return file_tragedylooper_v1_storage_proto_rawDescData
+}
+
+var file_tragedylooper_v1_storage_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_tragedylooper_v1_storage_proto_goTypes = []any{
+	(*RoomRecord)(nil),            // 0: tragedylooper.v1.RoomRecord
+	(*SeatRecord)(nil),            // 1: tragedylooper.v1.SeatRecord
+	(*GameResult)(nil),            // 2: tragedylooper.v1.GameResult
+	(*LobbyState)(nil),            // 3: tragedylooper.v1.LobbyState
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(PlayerRole)(0),               // 5: tragedylooper.v1.PlayerRole
+}
+var file_tragedylooper_v1_storage_proto_depIdxs = []int32{
+	3, // 0: tragedylooper.v1.RoomRecord.lobby:type_name -> tragedylooper.v1.LobbyState
+	1, // 1: tragedylooper.v1.RoomRecord.seats:type_name -> tragedylooper.v1.SeatRecord
+	4, // 2: tragedylooper.v1.RoomRecord.updated_at:type_name -> google.protobuf.Timestamp
+	5, // 3: tragedylooper.v1.SeatRecord.role:type_name -> tragedylooper.v1.PlayerRole
+	5, // 4: tragedylooper.v1.GameResult.winner:type_name -> tragedylooper.v1.PlayerRole
+	4, // 5: tragedylooper.v1.GameResult.ended_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_tragedylooper_v1_storage_proto_init() }
+func file_tragedylooper_v1_storage_proto_init() {
+	if File_tragedylooper_v1_storage_proto != nil {
+		return
+	}
+	file_tragedylooper_v1_enums_proto_init()
+	file_tragedylooper_v1_protocol_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tragedylooper_v1_storage_proto_rawDesc), len(file_tragedylooper_v1_storage_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tragedylooper_v1_storage_proto_goTypes,
+		DependencyIndexes: file_tragedylooper_v1_storage_proto_depIdxs,
+		MessageInfos:      file_tragedylooper_v1_storage_proto_msgTypes,
+	}.Build()
+	File_tragedylooper_v1_storage_proto = out.File
+	file_tragedylooper_v1_storage_proto_goTypes = nil
+	file_tragedylooper_v1_storage_proto_depIdxs = nil
+}
@@ -0,0 +1,478 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: tragedylooper/v1/storage.proto
+
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RoomRecord with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoomRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoomRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoomRecordMultiError, or
+// nil if none found.
+func (m *RoomRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoomRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	if all {
+		switch v := interface{}(m.GetLobby()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomRecordValidationError{
+					field:  "Lobby",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomRecordValidationError{
+					field:  "Lobby",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLobby()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomRecordValidationError{
+				field:  "Lobby",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSeats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoomRecordValidationError{
+						field:  fmt.Sprintf("Seats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoomRecordValidationError{
+						field:  fmt.Sprintf("Seats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoomRecordValidationError{
+					field:  fmt.Sprintf("Seats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPlayerId
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RoomRecordValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RoomRecordValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RoomRecordValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RoomRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoomRecordMultiError is an error wrapping multiple validation errors
+// returned by RoomRecord.ValidateAll() if the designated constraints aren't met.
+type RoomRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoomRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoomRecordMultiError) AllErrors() []error { return m }
+
+// RoomRecordValidationError is the validation error returned by
+// RoomRecord.Validate if the designated constraints aren't met.
+type RoomRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoomRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoomRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoomRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoomRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoomRecordValidationError) ErrorName() string { return "RoomRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoomRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoomRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoomRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoomRecordValidationError{}
+
+// Validate checks the field values on SeatRecord with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SeatRecord) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SeatRecord with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SeatRecordMultiError, or
+// nil if none found.
+func (m *SeatRecord) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SeatRecord) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlayerId
+
+	// no validation rules for Role
+
+	// no validation rules for SessionToken
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return SeatRecordMultiError(errors)
+	}
+
+	return nil
+}
+
+// SeatRecordMultiError is an error wrapping multiple validation errors
+// returned by SeatRecord.ValidateAll() if the designated constraints aren't met.
+type SeatRecordMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SeatRecordMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SeatRecordMultiError) AllErrors() []error { return m }
+
+// SeatRecordValidationError is the validation error returned by
+// SeatRecord.Validate if the designated constraints aren't met.
+type SeatRecordValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SeatRecordValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SeatRecordValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SeatRecordValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SeatRecordValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SeatRecordValidationError) ErrorName() string { return "SeatRecordValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SeatRecordValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSeatRecord.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SeatRecordValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SeatRecordValidationError{}
+
+// Validate checks the field values on GameResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GameResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GameResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GameResultMultiError, or
+// nil if none found.
+func (m *GameResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GameResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for GameId
+
+	// no validation rules for ScriptId
+
+	// no validation rules for ModelId
+
+	// no validation rules for Winner
+
+	// no validation rules for Reason
+
+	// no validation rules for LoopsPlayed
+
+	if all {
+		switch v := interface{}(m.GetEndedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GameResultValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GameResultValidationError{
+					field:  "EndedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GameResultValidationError{
+				field:  "EndedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GameResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// GameResultMultiError is an error wrapping multiple validation errors
+// returned by GameResult.ValidateAll() if the designated constraints aren't met.
+type GameResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GameResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GameResultMultiError) AllErrors() []error { return m }
+
+// GameResultValidationError is the validation error returned by
+// GameResult.Validate if the designated constraints aren't met.
+type GameResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GameResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GameResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GameResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GameResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GameResultValidationError) ErrorName() string { return "GameResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GameResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGameResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GameResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GameResultValidationError{}
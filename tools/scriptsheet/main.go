@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/constellation39/tragedyLooper/internal/game/loader"
+	v1 "github.com/constellation39/tragedyLooper/pkg/proto/tragedylooper/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// main renders the public and/or private script sheet of a script model.
+//
+// Example:
+//
+//	go run ./tools/scriptsheet -script basic_tragedy_x -model 8001 -sheet both -format markdown
+func main() {
+	dataDir := flag.String("data", "data", "game data directory")
+	scriptID := flag.String("script", "", "script id (file name under <data>/scripts without extension)")
+	modelID := flag.Int("model", 0, "script model id")
+	loops := flag.Int("loops", 0, "number of loops of the difficulty set to use (0 = model default)")
+	difficulty := flag.Int("difficulty", 0, "difficulty of the difficulty set to use")
+	sheet := flag.String("sheet", "public", "which sheet to render: public, private or both")
+	format := flag.String("format", "markdown", "output format: markdown or json")
+	output := flag.String("o", "", "output file (default stdout)")
+	flag.Parse()
+
+	if err := run(*dataDir, *scriptID, int32(*modelID), int32(*loops), int32(*difficulty), *sheet, *format, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dataDir, scriptID string, modelID, loops, difficulty int32, sheet, format, output string) error {
+	if scriptID == "" || modelID == 0 {
+		return fmt.Errorf("both -script and -model are required")
+	}
+
+	config, err := loader.LoadConfig(dataDir, scriptID, modelID)
+	if err != nil {
+		return err
+	}
+	if config.GetModel() == nil {
+		return fmt.Errorf("script model %d not found in script '%s'", modelID, scriptID)
+	}
+	if loops > 0 {
+		if err := config.SetDifficultySet(&v1.DifficultySet{NumberOfLoops: loops, Difficulty: difficulty}); err != nil {
+			return err
+		}
+	}
+
+	var sheets []string
+	switch sheet {
+	case "public":
+		sheets = []string{"public"}
+	case "private":
+		sheets = []string{"private"}
+	case "both":
+		sheets = []string{"public", "private"}
+	default:
+		return fmt.Errorf("unknown sheet %q", sheet)
+	}
+
+	var parts []string
+	for _, name := range sheets {
+		part, err := render(config, name, format)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, part)
+	}
+
+	content := strings.Join(parts, "\n")
+	if output == "" {
+		fmt.Print(content)
+		return nil
+	}
+	if err := os.WriteFile(output, []byte(content), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", output, err)
+	}
+	fmt.Fprintf(os.Stderr, "Script sheet saved to %s\n", output)
+	return nil
+}
+
+func render(config loader.ScriptConfig, sheet, format string) (string, error) {
+	switch format {
+	case "markdown", "md":
+		if sheet == "private" {
+			return loader.PrivateSheetMarkdown(config.PrivateSheet()), nil
+		}
+		return loader.PublicSheetMarkdown(config.PublicSheet()), nil
+	case "json":
+		marshaler := protojson.MarshalOptions{Multiline: true, Indent: "  ", UseProtoNames: true}
+		var data []byte
+		var err error
+		if sheet == "private" {
+			data, err = marshaler.Marshal(config.PrivateSheet())
+		} else {
+			data, err = marshaler.Marshal(config.PublicSheet())
+		}
+		if err != nil {
+			return "", fmt.Errorf("marshaling %s sheet: %w", sheet, err)
+		}
+		return string(data) + "\n", nil
+	default:
+		return "", fmt.Errorf("unknown format %q", format)
+	}
+}